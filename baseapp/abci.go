@@ -16,14 +16,17 @@ import (
 
 	coreheader "cosmossdk.io/core/header"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/historical"
 	"cosmossdk.io/store/rootmulti"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp/listeners"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
 // Supported ABCI Query prefixes and paths
@@ -214,6 +217,11 @@ func (app *BaseApp) ListSnapshots(req *abci.RequestListSnapshots) (*abci.Respons
 	}
 
 	for _, snapshot := range snapshots {
+		// incremental snapshots can only be restored on top of their base in the local snapshots
+		if snapshot.Format == snapshottypes.IncrementalFormat {
+			continue
+		}
+
 		abciSnapshot, err := snapshot.ToABCI()
 		if err != nil {
 			app.logger.Error("failed to convert ABCI snapshots", "err", err)
@@ -480,9 +488,15 @@ func (app *BaseApp) ProcessProposal(req *abci.RequestProcessProposal) (resp *abc
 	// again in a subsequent round. However, we only want to do this after we've
 	// processed the first block, as we want to avoid overwriting the finalizeState
 	// after state changes during InitChain.
-	if req.Height > app.initialHeight {
-		// abort any running OE
-		app.optimisticExec.Abort()
+	//
+	// Any optimistic execution of a previous proposal is rolled back along with
+	// that state, including the mempool removals it deferred, and is restarted
+	// below for this proposal if it is accepted. If OE is configured to start on
+	// the first ProcessProposal only and this proposal is already being
+	// executed, the execution and its state are kept.
+	keepOE := app.optimisticExec.Started(req)
+	if req.Height > app.initialHeight && !keepOE {
+		app.optimisticExec.Rollback()
 		app.setState(execModeFinalize, header)
 	}
 
@@ -532,7 +546,8 @@ func (app *BaseApp) ProcessProposal(req *abci.RequestProcessProposal) (resp *abc
 	// can have a response ready.
 	if resp.Status == abci.ResponseProcessProposal_ACCEPT &&
 		app.optimisticExec.Enabled() &&
-		req.Height > app.initialHeight {
+		req.Height > app.initialHeight &&
+		!keepOE {
 		app.optimisticExec.Execute(req)
 	}
 
@@ -780,39 +795,44 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 	gasMeter = app.getBlockGasMeter(app.finalizeBlockState.Context())
 	app.finalizeBlockState.SetContext(app.finalizeBlockState.Context().WithBlockGasMeter(gasMeter))
 
+	// Decode all raw transactions in the proposal upfront, resolving their
+	// messages and signers sequentially as they are cached by the decoded txs,
+	// so that the TxExecutor may run them concurrently.
+	decodedTxs := make([]sdk.Tx, len(req.Txs))
+	for i, rawTx := range req.Txs {
+		if decodedTxs[i] = app.decodeTx(rawTx); decodedTxs[i] != nil {
+			_, _ = decodedTxs[i].GetMsgsV2()
+		}
+	}
+
 	// Iterate over all raw transactions in the proposal and attempt to execute
 	// them, gathering the execution results.
 	//
-	// NOTE: Not all raw transactions may adhere to the sdk.Tx interface, e.g.
-	// vote extensions, so skip those.
-	txResults := make([]*abci.ExecTxResult, 0, len(req.Txs))
-	for _, rawTx := range req.Txs {
-		var response *abci.ExecTxResult
-
-		if _, err := app.txDecoder(rawTx); err == nil {
-			response = app.deliverTx(rawTx)
-		} else {
-			// In the case where a transaction included in a block proposal is malformed,
-			// we still want to return a default response to comet. This is because comet
-			// expects a response for each transaction included in a block proposal.
-			response = sdkerrors.ResponseExecTxResultWithEvents(
-				sdkerrors.ErrTxDecode,
-				0,
-				0,
-				nil,
-				false,
-			)
-		}
+	txResults, err := app.txExecutor.ExecuteTxs(
+		ctx,
+		req.Txs,
+		app.finalizeBlockState.ms,
+		app.storeKeys(),
+		app.finalizeBlockState.Context().BlockGasMeter(),
+		func(txIndex int, ms storetypes.MultiStore, blockGasMeter storetypes.GasMeter) *abci.ExecTxResult {
+			return app.deliverTx(req.Txs[txIndex], decodedTxs[txIndex], ms, blockGasMeter)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
 
-		// check after every tx if we should abort
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
-			// continue
+	// The TxExecutor may run a tx several times, so the effects of the txs
+	// outside of the block state are only applied to their final results: the
+	// telemetry is updated here, and the txs are removed from the mempool once
+	// the block is finalized, as its execution may still be rolled back.
+	for i, res := range txResults {
+		if decodedTxs[i] == nil {
+			continue
 		}
 
-		txResults = append(txResults, response)
+		emitTxTelemetry(res)
+		app.finalizeBlockState.deferMempoolRemoval(decodedTxs[i])
 	}
 
 	if app.finalizeBlockState.ms.TracingEnabled() {
@@ -859,6 +879,9 @@ func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (res *abci.Res
 		for _, streamingListener := range app.streamingManager.ABCIListeners {
 			if err := streamingListener.ListenFinalizeBlock(app.finalizeBlockState.Context(), *req, *res); err != nil {
 				app.logger.Error("ListenFinalizeBlock listening hook failed", "height", req.Height, "err", err)
+				if errors.Is(err, listeners.ErrHalt) {
+					panic(err)
+				}
 			}
 		}
 	}()
@@ -873,6 +896,7 @@ func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (res *abci.Res
 		if !aborted {
 			if res != nil {
 				res.AppHash = app.workingHash()
+				app.removeFinalizedTxs()
 			}
 
 			return res, err
@@ -887,11 +911,36 @@ func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (res *abci.Res
 	res, err = app.internalFinalizeBlock(context.Background(), req)
 	if res != nil {
 		res.AppHash = app.workingHash()
+		app.removeFinalizedTxs()
 	}
 
 	return res, err
 }
 
+// emitTxTelemetry emits the telemetry of a tx executed in FinalizeBlock.
+func emitTxTelemetry(res *abci.ExecTxResult) {
+	resultStr := "successful"
+	if !res.IsOK() {
+		resultStr = "failed"
+	}
+
+	telemetry.IncrCounter(1, "tx", "count")
+	telemetry.IncrCounter(1, "tx", resultStr)
+	telemetry.SetGauge(float32(res.GasUsed), "tx", "gas", "used")
+	telemetry.SetGauge(float32(res.GasWanted), "tx", "gas", "wanted")
+}
+
+// removeFinalizedTxs removes the txs of the finalized block from the mempool,
+// whatever their result. Their removal is deferred until the block is
+// finalized, as its execution may be rolled back.
+func (app *BaseApp) removeFinalizedTxs() {
+	for _, tx := range app.finalizeBlockState.takeMempoolRemovals() {
+		if err := app.mempool.Remove(tx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+			app.logger.Error("failed to remove tx from mempool", "err", err)
+		}
+	}
+}
+
 // checkHalt checkes if height or time exceeds halt-height or halt-time respectively.
 func (app *BaseApp) checkHalt(height int64, time time.Time) error {
 	var halt bool
@@ -945,6 +994,9 @@ func (app *BaseApp) Commit() (*abci.ResponseCommit, error) {
 		for _, abciListener := range abciListeners {
 			if err := abciListener.ListenCommit(ctx, *resp, changeSet); err != nil {
 				app.logger.Error("Commit listening hook failed", "height", blockHeight, "err", err)
+				if errors.Is(err, listeners.ErrHalt) {
+					panic(err)
+				}
 			}
 		}
 	}
@@ -1134,10 +1186,11 @@ func (app *BaseApp) getContextForProposal(ctx sdk.Context, height int64) sdk.Con
 }
 
 func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req *abci.RequestQuery) *abci.ResponseQuery {
-	ctx, err := app.CreateQueryContext(req.Height, req.Prove)
+	ctx, release, err := app.createQueryContext(req.Height, req.Prove)
 	if err != nil {
 		return sdkerrors.QueryResult(err, app.trace)
 	}
+	defer release()
 
 	resp, err := handler(ctx, req)
 	if err != nil {
@@ -1181,13 +1234,27 @@ func checkNegativeHeight(height int64) error {
 	return nil
 }
 
-// createQueryContext creates a new sdk.Context for a query, taking as args
-// the block height and whether the query needs a proof or not.
+// CreateQueryContext creates a new sdk.Context for a query, taking as args
+// the block height and whether the query needs a proof or not. The queries run
+// with such a context do not count towards the concurrent queries at a past
+// height of the historical queries, see SetHistoricalQueries.
 func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, error) {
-	if err := checkNegativeHeight(height); err != nil {
+	ctx, release, err := app.createQueryContext(height, prove)
+	if err != nil {
 		return sdk.Context{}, err
 	}
 
+	release()
+	return ctx, nil
+}
+
+// createQueryContext creates a new sdk.Context for a query, along with a function
+// to call once the query is done.
+func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, func(), error) {
+	if err := checkNegativeHeight(height); err != nil {
+		return sdk.Context{}, nil, err
+	}
+
 	// use custom query multi-store if provided
 	qms := app.qms
 	if qms == nil {
@@ -1196,11 +1263,11 @@ func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, e
 
 	lastBlockHeight := qms.LatestVersion()
 	if lastBlockHeight == 0 {
-		return sdk.Context{}, errorsmod.Wrapf(sdkerrors.ErrInvalidHeight, "%s is not ready; please wait for first block", app.Name())
+		return sdk.Context{}, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidHeight, "%s is not ready; please wait for first block", app.Name())
 	}
 
 	if height > lastBlockHeight {
-		return sdk.Context{},
+		return sdk.Context{}, nil,
 			errorsmod.Wrap(
 				sdkerrors.ErrInvalidHeight,
 				"cannot query with height in the future; please provide a valid height",
@@ -1213,16 +1280,29 @@ func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, e
 	}
 
 	if height <= 1 && prove {
-		return sdk.Context{},
+		return sdk.Context{}, nil,
 			errorsmod.Wrap(
 				sdkerrors.ErrInvalidRequest,
 				"cannot query with proof when height <= 1; please provide a valid height",
 			)
 	}
 
-	cacheMS, err := qms.CacheMultiStoreWithVersion(height)
+	var (
+		cacheMS storetypes.CacheMultiStore
+		release = func() {}
+		err     error
+	)
+	// past heights of the main multistore are served by the historical store, if set
+	if app.historicalStore != nil && app.qms == nil && height != lastBlockHeight {
+		cacheMS, release, err = app.historicalStore.CacheMultiStoreWithVersion(height)
+		if errors.Is(err, historical.ErrTooManyQueries) || errors.Is(err, historical.ErrHeightNotQueryable) {
+			return sdk.Context{}, nil, err
+		}
+	} else {
+		cacheMS, err = qms.CacheMultiStoreWithVersion(height)
+	}
 	if err != nil {
-		return sdk.Context{},
+		return sdk.Context{}, nil,
 			errorsmod.Wrapf(
 				sdkerrors.ErrInvalidRequest,
 				"failed to load state at height %d; %s (latest height: %d)", height, err, lastBlockHeight,
@@ -1247,7 +1327,7 @@ func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, e
 		}
 	}
 
-	return ctx, nil
+	return ctx, release, nil
 }
 
 // GetBlockRetentionHeight returns the height for which all blocks below this height
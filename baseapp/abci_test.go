@@ -11,6 +11,7 @@ import (
 	"math/rand"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -33,6 +34,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/oe"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
 	"github.com/cosmos/cosmos-sdk/baseapp/testutil/mock"
 	"github.com/cosmos/cosmos-sdk/testutil"
@@ -2391,6 +2393,180 @@ func TestOptimisticExecution(t *testing.T) {
 	require.Equal(t, int64(50), suite.baseApp.LastBlockHeight())
 }
 
+func TestOptimisticExecution_RollbackAndRestart(t *testing.T) {
+	pool := mempool.NewPriorityMempool[int64](mempool.PriorityNonceMempoolConfig[int64]{
+		TxPriority:      mempool.NewDefaultTxPriority(),
+		SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+	})
+	suite := NewBaseAppSuite(t, baseapp.SetMempool(pool), baseapp.SetOptimisticExecution())
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	// the counter message fails if a block is executed twice on the same state
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	for i := 0; i < 10; i++ {
+		tx := newTxCounter(t, suite.txConfig, int64(i), int64(i))
+		txBytes, err := suite.txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		require.NoError(t, pool.Insert(sdk.Context{}, tx))
+
+		// a first proposal is executed optimistically, then rolled back and
+		// restarted when another proposal with the same tx is processed
+		height := suite.baseApp.LastBlockHeight() + 1
+		for _, hash := range []string{"first-hash", "second-hash"} {
+			respProcProp, err := suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{
+				Txs:    [][]byte{txBytes},
+				Height: height,
+				Hash:   []byte(hash),
+			})
+			require.NoError(t, err)
+			require.Equal(t, abci.ResponseProcessProposal_ACCEPT, respProcProp.Status)
+		}
+
+		respFinalizeBlock, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+			Height: height,
+			Txs:    [][]byte{txBytes},
+			Hash:   []byte("second-hash"),
+		})
+		require.NoError(t, err)
+		require.Len(t, respFinalizeBlock.TxResults, 1)
+		require.Equal(t, uint32(0), respFinalizeBlock.TxResults[0].Code)
+
+		// the tx is only removed from the mempool once its block is finalized
+		require.Equal(t, 0, pool.CountTx())
+
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, int64(10), suite.baseApp.LastBlockHeight())
+}
+
+func TestOptimisticExecution_StartOnFirstProcessProposal(t *testing.T) {
+	pool := mempool.NewPriorityMempool[int64](mempool.PriorityNonceMempoolConfig[int64]{
+		TxPriority:      mempool.NewDefaultTxPriority(),
+		SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+	})
+	// the pre-blocker counts the executions of the block, optimistic or not
+	var executions atomic.Int64
+	suite := NewBaseAppSuite(
+		t,
+		baseapp.SetMempool(pool),
+		baseapp.SetOptimisticExecutionOptions(oe.WithStartOnFirstProcessProposal(true)),
+		baseapp.SetOptimisticExecution(),
+		func(app *baseapp.BaseApp) {
+			app.SetPreBlocker(func(sdk.Context, *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+				executions.Add(1)
+				return &sdk.ResponsePreBlock{}, nil
+			})
+		},
+	)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	// the counter message fails if a block is executed twice on the same state
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	// finalize the first block, which is not executed optimistically
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	for i, tc := range []struct {
+		hashes     []string
+		executions int64
+	}{
+		// the same proposal processed again keeps the running execution
+		{[]string{"first-hash", "first-hash"}, 1},
+		// another proposal rolls it back, and the execution is restarted when
+		// the first proposal is processed again
+		{[]string{"first-hash", "second-hash", "first-hash"}, 3},
+	} {
+		executions.Store(0)
+		tx := newTxCounter(t, suite.txConfig, int64(i), int64(i))
+		txBytes, err := suite.txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		require.NoError(t, pool.Insert(sdk.Context{}, tx))
+
+		height := suite.baseApp.LastBlockHeight() + 1
+		for _, hash := range tc.hashes {
+			respProcProp, err := suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{
+				Txs:    [][]byte{txBytes},
+				Height: height,
+				Hash:   []byte(hash),
+			})
+			require.NoError(t, err)
+			require.Equal(t, abci.ResponseProcessProposal_ACCEPT, respProcProp.Status)
+		}
+
+		respFinalizeBlock, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+			Height: height,
+			Txs:    [][]byte{txBytes},
+			Hash:   []byte("first-hash"),
+		})
+		require.NoError(t, err)
+		require.Len(t, respFinalizeBlock.TxResults, 1)
+		require.Equal(t, uint32(0), respFinalizeBlock.TxResults[0].Code)
+		require.Equal(t, tc.executions, executions.Load())
+		require.Equal(t, 0, pool.CountTx())
+
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+}
+
+func TestOptimisticExecution_RollbackKeepsMempool(t *testing.T) {
+	pool := mempool.NewPriorityMempool[int64](mempool.PriorityNonceMempoolConfig[int64]{
+		TxPriority:      mempool.NewDefaultTxPriority(),
+		SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+	})
+	suite := NewBaseAppSuite(t, baseapp.SetMempool(pool), baseapp.SetOptimisticExecution())
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), NoopCounterServerImpl{})
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	// finalize the first block, which is not executed optimistically
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	tx := newTxCounter(t, suite.txConfig, 0, 0)
+	txBytes, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+	require.NoError(t, pool.Insert(sdk.Context{}, tx))
+
+	respProcProp, err := suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{
+		Txs:    [][]byte{txBytes},
+		Height: 2,
+		Hash:   []byte("first-hash"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, respProcProp.Status)
+
+	// another block is decided: the optimistic execution of the first proposal
+	// is rolled back and its tx stays in the mempool
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: 2,
+		Hash:   []byte("second-hash"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, pool.CountTx())
+}
+
 func TestABCI_Proposal_FailReCheckTx(t *testing.T) {
 	pool := mempool.NewPriorityMempool[int64](mempool.PriorityNonceMempoolConfig[int64]{
 		TxPriority:      mempool.NewDefaultTxPriority(),
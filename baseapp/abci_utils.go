@@ -212,6 +212,7 @@ type (
 		txVerifier       ProposalTxVerifier
 		txSelector       TxSelector
 		signerExtAdapter mempool.SignerExtractionAdapter
+		lanes            []ProposalLane
 	}
 )
 
@@ -229,6 +230,16 @@ func (h *DefaultProposalHandler) SetTxSelector(ts TxSelector) {
 	h.txSelector = ts
 }
 
+// SetLanes sets the proposal lanes enforced by the DefaultProposalHandler. It
+// panics if the lanes are invalid.
+func (h *DefaultProposalHandler) SetLanes(lanes ...ProposalLane) {
+	if err := ValidateProposalLanes(lanes); err != nil {
+		panic(err)
+	}
+
+	h.lanes = lanes
+}
+
 // PrepareProposalHandler returns the default implementation for processing an
 // ABCI proposal. The application's mempool is enumerated and all valid
 // transactions are added to the proposal. Transactions are valid if they:
@@ -237,7 +248,8 @@ func (h *DefaultProposalHandler) SetTxSelector(ts TxSelector) {
 // 2) Are valid (i.e. pass runTx, AnteHandler only).
 //
 // Enumeration is halted once RequestPrepareProposal.MaxBytes of transactions is
-// reached or the mempool is exhausted.
+// reached or the mempool is exhausted. If proposal lanes are set, transactions
+// that would exceed the limits of their lane are skipped.
 //
 // Note:
 //
@@ -258,6 +270,18 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 
 		defer h.txSelector.Clear()
 
+		var lanes *laneTracker
+		if len(h.lanes) > 0 {
+			lanes = newLaneTracker(ctx, h.lanes)
+
+			// a TxSelector deferring its choice enforces the lanes on its
+			// final selection, its candidates are not accounted for
+			if ls, ok := h.txSelector.(laneTxSelector); ok {
+				ls.setLanes(lanes)
+				lanes = nil
+			}
+		}
+
 		// If the mempool is nil or NoOp we simply return the transactions
 		// requested from CometBFT, which, by default, should be in FIFO order.
 		//
@@ -270,7 +294,7 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 					return nil, err
 				}
 
-				stop := h.txSelector.SelectTxForProposal(ctx, uint64(req.MaxTxBytes), maxBlockGas, tx, txBz)
+				_, stop := h.selectTxForProposal(ctx, lanes, uint64(req.MaxTxBytes), maxBlockGas, tx, txBz)
 				if stop {
 					break
 				}
@@ -281,7 +305,6 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 
 		iterator := h.mempool.Select(ctx, req.Txs)
 		selectedTxsSignersSeqs := make(map[string]uint64)
-		var selectedTxsNums int
 		for iterator != nil {
 			memTx := iterator.Tx()
 			signerData, err := h.signerExtAdapter.GetSigners(memTx)
@@ -325,17 +348,16 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 					return nil, err
 				}
 			} else {
-				stop := h.txSelector.SelectTxForProposal(ctx, uint64(req.MaxTxBytes), maxBlockGas, memTx, txBz)
+				selected, stop := h.selectTxForProposal(ctx, lanes, uint64(req.MaxTxBytes), maxBlockGas, memTx, txBz)
 				if stop {
 					break
 				}
 
-				txsLen := len(h.txSelector.SelectedTxs(ctx))
 				for sender, seq := range txSignersSeqs {
-					// If txsLen != selectedTxsNums is true, it means that we've
-					// added a new tx to the selected txs, so we need to update
-					// the sequence of the sender.
-					if txsLen != selectedTxsNums {
+					// If selected is true, it means that we've added a new tx
+					// to the selected txs, so we need to update the sequence
+					// of the sender.
+					if selected {
 						selectedTxsSignersSeqs[sender] = seq
 					} else if _, ok := selectedTxsSignersSeqs[sender]; !ok {
 						// The transaction hasn't been added but it passed the
@@ -345,7 +367,6 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 						selectedTxsSignersSeqs[sender] = seq - 1
 					}
 				}
-				selectedTxsNums = txsLen
 			}
 
 			iterator = iterator.Next()
@@ -355,6 +376,44 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 	}
 }
 
+// selectTxForProposal offers the transaction to the TxSelector if it fits in
+// its proposal lane, and accounts for it in the lane once selected. It returns
+// whether the transaction was selected and whether selection should stop.
+func (h *DefaultProposalHandler) selectTxForProposal(
+	ctx sdk.Context, lanes *laneTracker, maxTxBytes, maxBlockGas uint64, tx sdk.Tx, txBz []byte,
+) (selected, stop bool) {
+	var (
+		lane  int
+		txGas uint64
+	)
+	if lanes != nil {
+		lane, txGas = lanes.laneOf(tx), txGasLimit(tx)
+		if !lanes.fits(lane, uint64(len(txBz)), txGas) {
+			return false, false
+		}
+	}
+
+	txsLen := h.selectedTxsLen(ctx)
+	stop = h.txSelector.SelectTxForProposal(ctx, maxTxBytes, maxBlockGas, tx, txBz)
+	selected = h.selectedTxsLen(ctx) != txsLen
+
+	if selected && lanes != nil {
+		lanes.add(lane, uint64(len(txBz)), txGas)
+	}
+
+	return selected, stop
+}
+
+// selectedTxsLen returns the number of transactions accepted by the TxSelector
+// so far.
+func (h *DefaultProposalHandler) selectedTxsLen(ctx context.Context) int {
+	if ts, ok := h.txSelector.(txCandidatesCounter); ok {
+		return ts.candidatesLen()
+	}
+
+	return len(h.txSelector.SelectedTxs(ctx))
+}
+
 // ProcessProposalHandler returns the default implementation for processing an
 // ABCI proposal. Every transaction in the proposal must pass 2 conditions:
 //
@@ -366,17 +425,43 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 // DefaultPrepareProposal. It is very important that the same validation logic
 // is used in both steps, and applications must ensure that this is the case in
 // non-default handlers.
+//
+// If proposal lanes are set, the proposal is also rejected if the transactions
+// of any lane exceed its limits.
 func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
-	// If the mempool is nil or NoOp we simply return ACCEPT,
+	// If the mempool is nil or NoOp we only check the proposal lanes, if any,
 	// because PrepareProposal may have included txs that could fail verification.
 	_, isNoOp := h.mempool.(mempool.NoOpMempool)
 	if h.mempool == nil || isNoOp {
-		return NoOpProcessProposal()
+		return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+			if len(h.lanes) == 0 {
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+			}
+
+			lanes := newLaneTracker(ctx, h.lanes)
+			for _, txBytes := range req.Txs {
+				tx, err := h.txVerifier.TxDecode(txBytes)
+				if err != nil {
+					return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+				}
+
+				if !lanes.addTx(tx, txBytes) {
+					return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+				}
+			}
+
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+		}
 	}
 
 	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
 		var totalTxGas uint64
 
+		var lanes *laneTracker
+		if len(h.lanes) > 0 {
+			lanes = newLaneTracker(ctx, h.lanes)
+		}
+
 		var maxBlockGas int64
 		if b := ctx.ConsensusParams().Block; b != nil {
 			maxBlockGas = b.MaxGas
@@ -398,6 +483,10 @@ func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHan
 					return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
 				}
 			}
+
+			if lanes != nil && !lanes.addTx(tx, txBytes) {
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+			}
 		}
 
 		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
@@ -20,6 +20,7 @@ import (
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
@@ -692,6 +693,226 @@ func (s *ABCIUtilsTestSuite) TestDefaultProposalHandler_PriorityNonceMempoolTxSe
 	}
 }
 
+func (s *ABCIUtilsTestSuite) TestFeeTxSelector() {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+
+	var (
+		secret1 = []byte("secret1")
+		secret2 = []byte("secret2")
+	)
+
+	// txs are offered in mempool order, the highest fee per gas being the
+	// second tx of secret1, which can only follow the first one
+	txs := []sdk.Tx{
+		buildFeeMsg(s.T(), txConfig, []byte(`0`), secret1, 1, 100, sdk.NewInt64Coin("stake", 100)),
+		buildFeeMsg(s.T(), txConfig, []byte(`1`), secret1, 2, 100, sdk.NewInt64Coin("stake", 900)),
+		buildFeeMsg(s.T(), txConfig, []byte(`2`), secret2, 1, 100, sdk.NewInt64Coin("stake", 500)),
+	}
+	txBzs := make([][]byte, len(txs))
+	for i, tx := range txs {
+		bz, err := txConfig.TxEncoder()(tx)
+		s.Require().NoError(err)
+		txBzs[i] = bz
+	}
+	txSize := uint64(len(txBzs[0]))
+	for _, bz := range txBzs {
+		s.Require().Equal(txSize, uint64(len(bz)))
+	}
+
+	testCases := map[string]struct {
+		maxTxBytes  uint64
+		maxBlockGas uint64
+		expectedTxs []int
+	}{
+		"one tx fits": {
+			// only twice the block capacity is collected, so the tx of
+			// secret2 is never offered
+			maxTxBytes:  txSize,
+			expectedTxs: []int{0},
+		},
+		"two txs fit": {
+			maxTxBytes:  2 * txSize,
+			expectedTxs: []int{2, 0},
+		},
+		"all txs fit": {
+			maxTxBytes:  3 * txSize,
+			expectedTxs: []int{2, 0, 1},
+		},
+		"gas limits the block": {
+			maxTxBytes:  3 * txSize,
+			maxBlockGas: 200,
+			expectedTxs: []int{2, 0},
+		},
+	}
+
+	gasPrices := sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1), sdk.NewInt64DecCoin("uatom", 100))
+	ts := baseapp.NewFeeTxSelector(gasPrices)
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			defer ts.Clear()
+
+			for i, tx := range txs {
+				if ts.SelectTxForProposal(s.ctx, tc.maxTxBytes, tc.maxBlockGas, tx, txBzs[i]) {
+					break
+				}
+			}
+
+			var expected [][]byte
+			for _, i := range tc.expectedTxs {
+				expected = append(expected, txBzs[i])
+			}
+			s.Require().Equal(expected, ts.SelectedTxs(s.ctx))
+		})
+	}
+
+	// fees are compared once converted with the gas prices: 900uatom are
+	// worth 9stake, and fees in denoms without a gas price are ignored
+	denomTxs := []sdk.Tx{
+		buildFeeMsg(s.T(), txConfig, []byte(`3`), []byte("secret3"), 1, 100, sdk.NewInt64Coin("uatom", 900)),
+		buildFeeMsg(s.T(), txConfig, []byte(`4`), []byte("secret4"), 1, 100, sdk.NewInt64Coin("foo", 10000)),
+		buildFeeMsg(s.T(), txConfig, []byte(`5`), []byte("secret5"), 1, 100, sdk.NewInt64Coin("stake", 10)),
+	}
+	var denomTxBzs [][]byte
+	for _, tx := range denomTxs {
+		bz, err := txConfig.TxEncoder()(tx)
+		s.Require().NoError(err)
+		s.Require().False(ts.SelectTxForProposal(s.ctx, 10*txSize, 0, tx, bz))
+		denomTxBzs = append(denomTxBzs, bz)
+	}
+	s.Require().Equal([][]byte{denomTxBzs[2], denomTxBzs[0], denomTxBzs[1]}, ts.SelectedTxs(s.ctx))
+	ts.Clear()
+
+	// lanes are enforced on the final selection: the lane only fits two txs,
+	// which are the highest paying ones rather than the first offered
+	app := baseapp.NewBaseApp(s.T().Name(), log.NewNopLogger(), dbm.NewMemDB(), txConfig.TxDecoder())
+	ctx := s.ctx.WithConsensusParams(cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxBytes: int64(4 * txSize)},
+	})
+	ph := baseapp.NewDefaultProposalHandler(mempool.NoOpMempool{}, app)
+	ph.SetTxSelector(ts)
+	ph.SetLanes(baseapp.ProposalLane{
+		Name:          "kv",
+		Match:         baseapp.MatchMsgTypes(sdk.MsgTypeURL(&baseapptestutil.MsgKeyValue{})),
+		MaxBlockSpace: sdkmath.LegacyNewDecWithPrec(5, 1),
+	})
+
+	prepareResp, err := ph.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{
+		Txs:        txBzs,
+		MaxTxBytes: int64(4 * txSize),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([][]byte{txBzs[2], txBzs[0]}, prepareResp.Txs)
+
+	processResp, err := ph.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Txs: prepareResp.Txs})
+	s.Require().NoError(err)
+	s.Require().Equal(abci.ResponseProcessProposal_ACCEPT, processResp.Status)
+
+	_, err = baseapp.NewTxSelectorFromName(baseapp.TxSelectorFee, 0, nil)
+	s.Require().Error(err)
+}
+
+func (s *ABCIUtilsTestSuite) TestSenderQuotaTxSelector() {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+
+	var (
+		secret1 = []byte("secret1")
+		secret2 = []byte("secret2")
+	)
+
+	txs := []sdk.Tx{
+		buildMsg(s.T(), txConfig, []byte(`0`), [][]byte{secret1}, []uint64{1}),
+		buildMsg(s.T(), txConfig, []byte(`1`), [][]byte{secret1}, []uint64{2}),
+		buildMsg(s.T(), txConfig, []byte(`2`), [][]byte{secret1}, []uint64{3}),
+		buildMsg(s.T(), txConfig, []byte(`3`), [][]byte{secret2}, []uint64{1}),
+	}
+
+	ts := baseapp.NewSenderQuotaTxSelector(2)
+	for i := 0; i < 2; i++ {
+		var expected [][]byte
+		for j, tx := range txs {
+			bz, err := txConfig.TxEncoder()(tx)
+			s.Require().NoError(err)
+			s.Require().False(ts.SelectTxForProposal(s.ctx, 1000, 0, tx, bz))
+			if j != 2 {
+				expected = append(expected, bz)
+			}
+		}
+
+		s.Require().Equal(expected, ts.SelectedTxs(s.ctx))
+
+		// the quota is reset along with the selection
+		ts.Clear()
+	}
+
+	_, err := baseapp.NewTxSelectorFromName(baseapp.TxSelectorSenderQuota, 0, nil)
+	s.Require().Error(err)
+	_, err = baseapp.NewTxSelectorFromName("unknown", 0, nil)
+	s.Require().Error(err)
+}
+
+func (s *ABCIUtilsTestSuite) TestDefaultProposalHandler_Lanes() {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+	app := baseapp.NewBaseApp(s.T().Name(), log.NewNopLogger(), dbm.NewMemDB(), txConfig.TxDecoder())
+
+	// key value txs go to the lane, counter txs to the unreserved space
+	var laneTxs, otherTxs [][]byte
+	for i := uint64(1); i <= 6; i++ {
+		bz, err := txConfig.TxEncoder()(buildMsg(s.T(), txConfig, []byte(`0`), [][]byte{[]byte("secret1")}, []uint64{i}))
+		s.Require().NoError(err)
+		laneTxs = append(laneTxs, bz)
+	}
+	for i := int64(0); i < 2; i++ {
+		builder := txConfig.NewTxBuilder()
+		s.Require().NoError(builder.SetMsgs(&baseapptestutil.MsgCounter{Counter: i, Signer: sdk.AccAddress("addr").String()}))
+		setTxSignature(s.T(), builder, 0)
+		bz, err := txConfig.TxEncoder()(builder.GetTx())
+		s.Require().NoError(err)
+		otherTxs = append(otherTxs, bz)
+	}
+
+	// the lane may use half of the block, i.e. four of its txs
+	txSize := int64(len(laneTxs[0]))
+	ctx := s.ctx.WithConsensusParams(cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxBytes: 8 * txSize},
+	})
+
+	ph := baseapp.NewDefaultProposalHandler(mempool.NoOpMempool{}, app)
+	ph.SetLanes(baseapp.ProposalLane{
+		Name:          "kv",
+		Match:         baseapp.MatchMsgTypes(sdk.MsgTypeURL(&baseapptestutil.MsgKeyValue{})),
+		MaxBlockSpace: sdkmath.LegacyNewDecWithPrec(5, 1),
+	})
+
+	prepareResp, err := ph.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{
+		Txs:        append(append([][]byte{}, laneTxs...), otherTxs...),
+		MaxTxBytes: 8 * txSize,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(append(append([][]byte{}, laneTxs[:4]...), otherTxs...), prepareResp.Txs)
+
+	processResp, err := ph.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Txs: prepareResp.Txs})
+	s.Require().NoError(err)
+	s.Require().Equal(abci.ResponseProcessProposal_ACCEPT, processResp.Status)
+
+	processResp, err = ph.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Txs: laneTxs})
+	s.Require().NoError(err)
+	s.Require().Equal(abci.ResponseProcessProposal_REJECT, processResp.Status)
+
+	s.Require().Panics(func() {
+		ph.SetLanes(baseapp.ProposalLane{
+			Name:          "kv",
+			Match:         baseapp.MatchMsgTypes(),
+			MaxBlockSpace: sdkmath.LegacyNewDec(2),
+		})
+	})
+}
+
 func marshalDelimitedFn(msg proto.Message) ([]byte, error) {
 	var buf bytes.Buffer
 	if err := protoio.NewDelimitedWriter(&buf).WriteMsg(msg); err != nil {
@@ -723,6 +944,22 @@ func buildMsg(t *testing.T, txConfig client.TxConfig, value []byte, secrets [][]
 	return builder.GetTx()
 }
 
+func buildFeeMsg(t *testing.T, txConfig client.TxConfig, value, secret []byte, nonce, gas uint64, fee sdk.Coin) sdk.Tx {
+	t.Helper()
+	builder := txConfig.NewTxBuilder()
+	_ = builder.SetMsgs(
+		&baseapptestutil.MsgKeyValue{Value: value},
+	)
+	builder.SetGasLimit(gas)
+	builder.SetFeeAmount(sdk.NewCoins(fee))
+	setTxSignatureWithSecret(t, builder, signingtypes.SignatureV2{
+		PubKey:   secp256k1.GenPrivKeyFromSecret(secret).PubKey(),
+		Sequence: nonce,
+		Data:     &signingtypes.SingleSignatureData{},
+	})
+	return builder.GetTx()
+}
+
 func setTxSignatureWithSecret(t *testing.T, builder client.TxBuilder, signatures ...signingtypes.SignatureV2) {
 	t.Helper()
 	err := builder.SetSignatures(
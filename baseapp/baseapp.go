@@ -3,6 +3,7 @@ package baseapp
 import (
 	"context"
 	"fmt"
+	"io"
 	"math"
 	"sort"
 	"strconv"
@@ -20,6 +21,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
+	"cosmossdk.io/store/historical"
 	storemetrics "cosmossdk.io/store/metrics"
 	"cosmossdk.io/store/snapshots"
 	storetypes "cosmossdk.io/store/types"
@@ -28,7 +30,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
-	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
@@ -66,6 +67,7 @@ type BaseApp struct {
 	db                dbm.DB                      // common DB backend
 	cms               storetypes.CommitMultiStore // Main (uncached) state
 	qms               storetypes.MultiStore       // Optional alternative multistore for querying only.
+	historicalStore   *historical.Store           // Optional cache of the versions loaded for queries at past heights.
 	storeLoader       StoreLoader                 // function to handle store loading, may be overridden with SetStoreLoader()
 	grpcQueryRouter   *GRPCQueryRouter            // router for redirecting gRPC query calls
 	msgServiceRouter  *MsgServiceRouter           // router for redirecting Msg service messages
@@ -73,9 +75,12 @@ type BaseApp struct {
 	txDecoder         sdk.TxDecoder // unmarshal []byte into sdk.Tx
 	txEncoder         sdk.TxEncoder // marshal sdk.Tx into []byte
 
-	mempool     mempool.Mempool // application side mempool
-	anteHandler sdk.AnteHandler // ante handler for fee and auth
-	postHandler sdk.PostHandler // post handler, optional
+	mempool       mempool.Mempool // application side mempool
+	txSelector    TxSelector      // TxSelector of the default proposal handler
+	proposalLanes []ProposalLane  // proposal lanes of the default proposal handler
+	txExecutor    TxExecutor      // executes the txs of a block in FinalizeBlock
+	anteHandler   sdk.AnteHandler // ante handler for fee and auth
+	postHandler   sdk.PostHandler // post handler, optional
 
 	initChainer        sdk.InitChainer                // ABCI InitChain handler
 	preBlocker         sdk.PreBlocker                 // logic to run before BeginBlocker
@@ -187,6 +192,9 @@ type BaseApp struct {
 	// including the goroutine handling.This is experimental and must be enabled
 	// by developers.
 	optimisticExec *oe.OptimisticExecution
+	// optimisticExecOpts are the options applied to optimisticExec when it is
+	// enabled.
+	optimisticExecOpts []func(*oe.OptimisticExecution)
 
 	// disableBlockGasMeter will disable the block gas meter if true, block gas meter is tricky to support
 	// when executing transactions in parallel.
@@ -224,7 +232,17 @@ func NewBaseApp(
 		app.SetMempool(mempool.NoOpMempool{})
 	}
 
+	if app.txExecutor == nil {
+		app.txExecutor = NewSequentialTxExecutor()
+	}
+
 	abciProposalHandler := NewDefaultProposalHandler(app.mempool, app)
+	if app.txSelector != nil {
+		abciProposalHandler.SetTxSelector(app.txSelector)
+	}
+	if len(app.proposalLanes) > 0 {
+		abciProposalHandler.SetLanes(app.proposalLanes...)
+	}
 
 	if app.prepareProposal == nil {
 		app.SetPrepareProposal(abciProposalHandler.PrepareProposalHandler())
@@ -752,41 +770,56 @@ func (app *BaseApp) beginBlock(_ *abci.RequestFinalizeBlock) (sdk.BeginBlock, er
 	return resp, nil
 }
 
-func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
-	gInfo := sdk.GasInfo{}
-	resultStr := "successful"
+// decodeTx decodes the transaction, returning nil if it is not a valid sdk.Tx.
+func (app *BaseApp) decodeTx(txBytes []byte) sdk.Tx {
+	tx, err := app.txDecoder(txBytes)
+	if err != nil {
+		return nil
+	}
 
-	var resp *abci.ExecTxResult
+	return tx
+}
 
-	defer func() {
-		telemetry.IncrCounter(1, "tx", "count")
-		telemetry.IncrCounter(1, "tx", resultStr)
-		telemetry.SetGauge(float32(gInfo.GasUsed), "tx", "gas", "used")
-		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
-	}()
+func (app *BaseApp) deliverTx(txBytes []byte, tx sdk.Tx, ms storetypes.MultiStore, blockGasMeter storetypes.GasMeter) *abci.ExecTxResult {
+	// Not all raw transactions may adhere to the sdk.Tx interface, e.g. vote
+	// extensions. In the case where a transaction included in a block proposal
+	// is malformed, we still want to return a default response to comet. This is
+	// because comet expects a response for each transaction included in a block
+	// proposal.
+	if tx == nil {
+		return sdkerrors.ResponseExecTxResultWithEvents(
+			sdkerrors.ErrTxDecode,
+			0,
+			0,
+			nil,
+			false,
+		)
+	}
 
-	gInfo, result, anteEvents, err := app.runTx(execModeFinalize, tx)
+	ctx := app.getContextForTx(execModeFinalize, txBytes).
+		WithMultiStore(ms).
+		WithBlockGasMeter(blockGasMeter)
+	// read the consensus params through ms, so that the read is tracked by the
+	// tx executor and the tx observes the params updated by the previous txs
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+	gInfo, result, anteEvents, err := app.runTxWithContext(ctx, execModeFinalize, txBytes, tx)
 	if err != nil {
-		resultStr = "failed"
-		resp = sdkerrors.ResponseExecTxResultWithEvents(
+		return sdkerrors.ResponseExecTxResultWithEvents(
 			err,
 			gInfo.GasWanted,
 			gInfo.GasUsed,
 			sdk.MarkEventsToIndex(anteEvents, app.indexEvents),
 			app.trace,
 		)
-		return resp
 	}
 
-	resp = &abci.ExecTxResult{
+	return &abci.ExecTxResult{
 		GasWanted: int64(gInfo.GasWanted),
 		GasUsed:   int64(gInfo.GasUsed),
 		Log:       result.Log,
 		Data:      result.Data,
 		Events:    sdk.MarkEventsToIndex(result.Events, app.indexEvents),
 	}
-
-	return resp
 }
 
 // endBlock is an application-defined function that is called after transactions
@@ -823,12 +856,17 @@ func (app *BaseApp) endBlock(_ context.Context) (sdk.EndBlock, error) {
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes, nil)
+}
+
+// runTxWithContext runs the transaction like runTx, with the given context
+// returned by getContextForTx. tx is the decoded txBytes, or nil to decode them.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -870,9 +908,11 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		defer consumeBlockGas()
 	}
 
-	tx, err := app.txDecoder(txBytes)
-	if err != nil {
-		return sdk.GasInfo{}, nil, nil, err
+	if tx == nil {
+		tx, err = app.txDecoder(txBytes)
+		if err != nil {
+			return sdk.GasInfo{}, nil, nil, err
+		}
 	}
 
 	msgs := tx.GetMsgs()
@@ -938,11 +978,12 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		if err != nil {
 			return gInfo, nil, anteEvents, err
 		}
-	} else if mode == execModeFinalize {
-		err = app.mempool.Remove(tx)
-		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
-			return gInfo, nil, anteEvents,
-				fmt.Errorf("failed to remove tx from mempool: %w", err)
+	} else if mode == execModeReCheck {
+		// if the mempool evicted the tx on its own, we want CometBFT to drop it too
+		if mp, ok := app.mempool.(mempool.EvictingMempool); ok {
+			if err := mp.CheckEvicted(ctx, tx); err != nil {
+				return gInfo, nil, anteEvents, err
+			}
 		}
 	}
 
@@ -1169,5 +1210,15 @@ func (app *BaseApp) Close() error {
 		}
 	}
 
+	// Close the streaming listeners, delivering the blocks buffered by the
+	// asynchronous ones
+	for _, abciListener := range app.streamingManager.ABCIListeners {
+		if closer, ok := abciListener.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
 	return errors.Join(errs...)
 }
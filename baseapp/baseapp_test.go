@@ -16,6 +16,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/store/historical"
 	"cosmossdk.io/store/metrics"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"cosmossdk.io/store/rootmulti"
@@ -734,6 +735,34 @@ func TestABCI_CreateQueryContext(t *testing.T) {
 	}
 }
 
+func TestABCI_CreateQueryContext_HistoricalQueries(t *testing.T) {
+	t.Parallel()
+
+	db := dbm.NewMemDB()
+	name := t.Name()
+	app := baseapp.NewBaseApp(name, log.NewTestLogger(t), db, nil,
+		baseapp.SetHistoricalQueries(historical.Options{CacheSize: 2, MinHeight: 2}))
+
+	for height := int64(1); height <= 3; height++ {
+		_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+		require.NoError(t, err)
+		_, err = app.Commit()
+		require.NoError(t, err)
+	}
+
+	_, err := app.CreateQueryContext(1, false)
+	require.ErrorIs(t, err, historical.ErrHeightNotQueryable)
+
+	for _, height := range []int64{2, 2, 3, 0} {
+		ctx, err := app.CreateQueryContext(height, false)
+		require.NoError(t, err)
+		if height == 0 {
+			height = 3
+		}
+		require.Equal(t, height, ctx.BlockHeight())
+	}
+}
+
 func TestSetMinGasPrices(t *testing.T) {
 	minGasPrices := sdk.DecCoins{sdk.NewInt64DecCoin("stake", 5000)}
 	suite := NewBaseAppSuite(t, baseapp.SetMinGasPrices(minGasPrices.String()))
@@ -13,7 +13,8 @@ var _ genesis.TxHandler = (*BaseApp)(nil)
 // ExecuteGenesisTx implements genesis.GenesisState from
 // cosmossdk.io/core/genesis to set initial state in genesis
 func (ba BaseApp) ExecuteGenesisTx(tx []byte) error {
-	res := ba.deliverTx(tx)
+	ctx := ba.finalizeBlockState.Context()
+	res := ba.deliverTx(tx, ba.decodeTx(tx), ctx.MultiStore(), ctx.BlockGasMeter())
 
 	if res.Code != types.CodeTypeOK {
 		return errors.New(res.Log)
@@ -0,0 +1,326 @@
+package listeners
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/hashicorp/go-metrics"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// BackpressurePolicy defines what an AsyncListener does when its buffer is
+// full.
+type BackpressurePolicy string
+
+const (
+	// BackpressureBlock blocks the commit until the listener caught up.
+	BackpressureBlock BackpressurePolicy = "block"
+	// BackpressureDropOldest drops the oldest buffered block.
+	BackpressureDropOldest BackpressurePolicy = "drop-oldest"
+	// BackpressureHalt halts the node before the next block is committed, see
+	// ErrHalt, so that CometBFT replays it on restart.
+	BackpressureHalt BackpressurePolicy = "halt"
+)
+
+// ErrHalt is returned by the listeners which require the node to halt.
+var ErrHalt = errors.New("streaming listener requires the node to halt")
+
+// ParseBackpressurePolicy parses a BackpressurePolicy.
+func ParseBackpressurePolicy(s string) (BackpressurePolicy, error) {
+	switch p := BackpressurePolicy(s); p {
+	case BackpressureBlock, BackpressureDropOldest, BackpressureHalt:
+		return p, nil
+	default:
+		return "", fmt.Errorf("invalid backpressure policy %q, expected one of %q, %q or %q",
+			s, BackpressureBlock, BackpressureDropOldest, BackpressureHalt)
+	}
+}
+
+// ConsensusListener is implemented by the ABCIListeners which must be called
+// synchronously, as their delivery affects consensus, e.g. because the node
+// must not commit a block they did not process.
+type ConsensusListener interface {
+	storetypes.ABCIListener
+
+	// ConsensusAffecting returns true if the listener must be called
+	// synchronously.
+	ConsensusAffecting() bool
+}
+
+// IsConsensusAffecting returns true if the listener must be called
+// synchronously.
+func IsConsensusAffecting(l storetypes.ABCIListener) bool {
+	cl, ok := l.(ConsensusListener)
+	return ok && cl.ConsensusAffecting()
+}
+
+// NewConsensusListener returns a ConsensusListener affecting consensus which
+// wraps the listener, e.g. a streaming plugin stopping the node on errors.
+func NewConsensusListener(l storetypes.ABCIListener) ConsensusListener {
+	return consensusListener{ABCIListener: l}
+}
+
+type consensusListener struct {
+	storetypes.ABCIListener
+}
+
+// ConsensusAffecting implements ConsensusListener.
+func (consensusListener) ConsensusAffecting() bool {
+	return true
+}
+
+// Close closes the wrapped listener if it implements io.Closer.
+func (l consensusListener) Close() error {
+	if closer, ok := l.ABCIListener.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
+}
+
+// AsyncListenerConfig defines the configuration of an AsyncListener.
+type AsyncListenerConfig struct {
+	// BufferSize is the maximum number of blocks buffered.
+	BufferSize int
+	// Policy is what the listener does when the buffer is full.
+	Policy BackpressurePolicy
+}
+
+var _ storetypes.ABCIListener = (*AsyncListener)(nil)
+
+// AsyncListener delivers the blocks to an ABCIListener in order from a
+// goroutine, so that a slow listener does not slow block production down
+// until its buffer is full.
+//
+// The errors of the listener are returned by the next ListenCommit. The
+// contexts passed to the listener must not be used to access the state, which
+// may have changed by the time the block is delivered.
+//
+// The buffer depth and the delivery lag, in blocks, are exported as the
+// streaming_queue_depth and streaming_lag gauges, and dropped blocks are
+// counted by the streaming_dropped counter, labeled by listener.
+type AsyncListener struct {
+	name     string
+	listener storetypes.ABCIListener
+	cfg      AsyncListenerConfig
+	logger   log.Logger
+	labels   []metrics.Label
+
+	finalizeBlock *asyncBlock
+
+	mtx       sync.Mutex
+	cond      *sync.Cond
+	queue     []*asyncBlock
+	closed    bool
+	err       error
+	enqueued  int64
+	delivered int64
+	done      chan struct{}
+}
+
+// asyncBlock holds the messages of a block to deliver.
+type asyncBlock struct {
+	finalizeCtx  context.Context
+	finalizeReq  abci.RequestFinalizeBlock
+	finalizeRes  abci.ResponseFinalizeBlock
+	commitCtx    context.Context
+	commitRes    abci.ResponseCommit
+	changeSet    []*storetypes.StoreKVPair
+	height       int64
+	hasFinalized bool
+}
+
+// NewAsyncListener returns an AsyncListener delivering the blocks to listener,
+// named name in the logs and metrics. It must be closed to deliver the
+// buffered blocks and stop.
+func NewAsyncListener(name string, listener storetypes.ABCIListener, cfg AsyncListenerConfig, logger log.Logger) (*AsyncListener, error) {
+	if cfg.BufferSize <= 0 {
+		return nil, fmt.Errorf("async listener buffer size must be positive, got %d", cfg.BufferSize)
+	}
+	if _, err := ParseBackpressurePolicy(string(cfg.Policy)); err != nil {
+		return nil, err
+	}
+
+	l := &AsyncListener{
+		name:     name,
+		listener: listener,
+		cfg:      cfg,
+		logger:   logger.With("module", "streaming", "listener", name),
+		labels:   []metrics.Label{telemetry.NewLabel("listener", name)},
+		done:     make(chan struct{}),
+	}
+	l.cond = sync.NewCond(&l.mtx)
+	go l.run()
+
+	return l, nil
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener, buffering the
+// FinalizeBlock messages until the block is committed. It returns ErrHalt if
+// the buffer is full and the policy is BackpressureHalt, so that the node
+// halts before committing the block.
+func (l *AsyncListener) ListenFinalizeBlock(ctx context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	if l.cfg.Policy == BackpressureHalt {
+		l.mtx.Lock()
+		full := len(l.queue) >= l.cfg.BufferSize
+		l.mtx.Unlock()
+		if full {
+			return fmt.Errorf("streaming buffer of listener %s full at height %d: %w", l.name, req.Height, ErrHalt)
+		}
+	}
+
+	l.finalizeBlock = &asyncBlock{
+		finalizeCtx:  ctx,
+		finalizeReq:  req,
+		finalizeRes:  res,
+		height:       req.Height,
+		hasFinalized: true,
+	}
+
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener, queuing the block for
+// delivery. It returns the first error of the listener since the previous
+// call.
+//
+// With the BackpressureHalt policy, the block is committed so it is always
+// queued, ListenFinalizeBlock having checked that the buffer had room. If it
+// had not, e.g. because ListenFinalizeBlock was not called, ErrHalt is
+// returned once the block is queued.
+func (l *AsyncListener) ListenCommit(ctx context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	b := l.finalizeBlock
+	if b == nil {
+		b = &asyncBlock{}
+	}
+	l.finalizeBlock = nil
+	b.commitCtx, b.commitRes, b.changeSet = ctx, res, changeSet
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	overflow := false
+	for len(l.queue) >= l.cfg.BufferSize && !l.closed && !overflow {
+		switch l.cfg.Policy {
+		case BackpressureDropOldest:
+			l.logger.Error("streaming buffer full, dropping block", "height", l.queue[0].height)
+			telemetry.IncrCounterWithLabels([]string{"streaming", "dropped"}, 1, l.labels)
+			l.queue[0] = nil
+			l.queue = l.queue[1:]
+
+		case BackpressureHalt:
+			overflow = true
+
+		default:
+			l.cond.Wait()
+		}
+	}
+
+	if l.closed {
+		return fmt.Errorf("streaming listener %s is closed", l.name)
+	}
+
+	l.queue = append(l.queue, b)
+	if b.height > 0 {
+		l.enqueued = b.height
+	}
+	l.emitMetrics()
+	l.cond.Broadcast()
+
+	if overflow {
+		return fmt.Errorf("streaming buffer of listener %s full at height %d: %w", l.name, b.height, ErrHalt)
+	}
+
+	err := l.err
+	l.err = nil
+	return err
+}
+
+// run delivers the queued blocks until the listener is closed.
+func (l *AsyncListener) run() {
+	defer close(l.done)
+
+	for {
+		l.mtx.Lock()
+		for len(l.queue) == 0 && !l.closed {
+			l.cond.Wait()
+		}
+		if len(l.queue) == 0 {
+			l.mtx.Unlock()
+			return
+		}
+		b := l.queue[0]
+		l.queue[0] = nil
+		l.queue = l.queue[1:]
+		l.cond.Broadcast()
+		l.mtx.Unlock()
+
+		err := l.deliver(b)
+
+		l.mtx.Lock()
+		if err != nil {
+			l.logger.Error("streaming listener failed", "height", b.height, "err", err)
+			if l.err == nil {
+				l.err = err
+			}
+		}
+		if b.height > 0 {
+			l.delivered = b.height
+		}
+		l.emitMetrics()
+		l.mtx.Unlock()
+	}
+}
+
+func (l *AsyncListener) deliver(b *asyncBlock) error {
+	if b.hasFinalized {
+		if err := l.listener.ListenFinalizeBlock(b.finalizeCtx, b.finalizeReq, b.finalizeRes); err != nil {
+			return fmt.Errorf("ListenFinalizeBlock failed: %w", err)
+		}
+	}
+	if err := l.listener.ListenCommit(b.commitCtx, b.commitRes, b.changeSet); err != nil {
+		return fmt.Errorf("ListenCommit failed: %w", err)
+	}
+
+	return nil
+}
+
+// emitMetrics exports the buffer depth and the delivery lag, with the mutex
+// held.
+func (l *AsyncListener) emitMetrics() {
+	telemetry.SetGaugeWithLabels([]string{"streaming", "queue_depth"}, float32(len(l.queue)), l.labels)
+	telemetry.SetGaugeWithLabels([]string{"streaming", "lag"}, float32(l.enqueued-l.delivered), l.labels)
+}
+
+// Len returns the number of buffered blocks.
+func (l *AsyncListener) Len() int {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	return len(l.queue)
+}
+
+// Close delivers the buffered blocks, then closes the listener if it
+// implements io.Closer.
+func (l *AsyncListener) Close() error {
+	l.mtx.Lock()
+	l.closed = true
+	l.cond.Broadcast()
+	l.mtx.Unlock()
+
+	<-l.done
+
+	if closer, ok := l.listener.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
+}
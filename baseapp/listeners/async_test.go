@@ -0,0 +1,197 @@
+package listeners
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// recordingListener records the messages it receives, waiting for gate to be
+// closed before every commit if set.
+type recordingListener struct {
+	gate    chan struct{}
+	failAt  int64
+	mtx     sync.Mutex
+	calls   []string
+	lastReq int64
+}
+
+func (l *recordingListener) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, _ abci.ResponseFinalizeBlock) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.calls = append(l.calls, fmt.Sprintf("finalize-%d", req.Height))
+	l.lastReq = req.Height
+	return nil
+}
+
+func (l *recordingListener) ListenCommit(context.Context, abci.ResponseCommit, []*storetypes.StoreKVPair) error {
+	if l.gate != nil {
+		<-l.gate
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.calls = append(l.calls, fmt.Sprintf("commit-%d", l.lastReq))
+	if l.lastReq == l.failAt {
+		return errors.New("listener failure")
+	}
+	return nil
+}
+
+func (l *recordingListener) commits() []string {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	var commits []string
+	for _, call := range l.calls {
+		if call[0] == 'c' {
+			commits = append(commits, call)
+		}
+	}
+	return commits
+}
+
+func newTestAsyncListener(t *testing.T, inner storetypes.ABCIListener, policy BackpressurePolicy) *AsyncListener {
+	t.Helper()
+
+	l, err := NewAsyncListener("test", inner, AsyncListenerConfig{BufferSize: 2, Policy: policy}, log.NewNopLogger())
+	require.NoError(t, err)
+	return l
+}
+
+// startDelivery streams the first block and waits for it to be taken from the
+// buffer, so that the listener is blocked delivering it.
+func startDelivery(t *testing.T, l *AsyncListener) {
+	t.Helper()
+
+	require.NoError(t, listenBlock(l, 1))
+	require.Eventually(t, func() bool { return l.Len() == 0 }, time.Second, time.Millisecond)
+}
+
+func TestAsyncListener_Order(t *testing.T) {
+	inner := &recordingListener{}
+	l := newTestAsyncListener(t, inner, BackpressureBlock)
+
+	for height := int64(1); height <= 5; height++ {
+		require.NoError(t, listenBlock(l, height))
+	}
+	require.NoError(t, l.Close())
+
+	var expected []string
+	for height := 1; height <= 5; height++ {
+		expected = append(expected, fmt.Sprintf("finalize-%d", height), fmt.Sprintf("commit-%d", height))
+	}
+	require.Equal(t, expected, inner.calls)
+
+	require.Error(t, listenBlock(l, 6), "closed listener")
+}
+
+func TestAsyncListener_Block(t *testing.T) {
+	inner := &recordingListener{gate: make(chan struct{})}
+	l := newTestAsyncListener(t, inner, BackpressureBlock)
+
+	startDelivery(t, l)
+	require.NoError(t, listenBlock(l, 2))
+	require.NoError(t, listenBlock(l, 3))
+
+	done := make(chan error)
+	go func() { done <- listenBlock(l, 4) }()
+	select {
+	case <-done:
+		t.Fatal("commit should block while the buffer is full")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(inner.gate)
+	require.NoError(t, <-done)
+	require.NoError(t, l.Close())
+	require.Equal(t, []string{"commit-1", "commit-2", "commit-3", "commit-4"}, inner.commits())
+}
+
+func TestAsyncListener_DropOldest(t *testing.T) {
+	inner := &recordingListener{gate: make(chan struct{})}
+	l := newTestAsyncListener(t, inner, BackpressureDropOldest)
+
+	startDelivery(t, l)
+	for height := int64(2); height <= 5; height++ {
+		require.NoError(t, listenBlock(l, height))
+	}
+	require.Equal(t, 2, l.Len())
+
+	close(inner.gate)
+	require.NoError(t, l.Close())
+	require.Equal(t, []string{"commit-1", "commit-4", "commit-5"}, inner.commits())
+}
+
+func TestAsyncListener_Halt(t *testing.T) {
+	inner := &recordingListener{gate: make(chan struct{})}
+	l := newTestAsyncListener(t, inner, BackpressureHalt)
+
+	startDelivery(t, l)
+	require.NoError(t, listenBlock(l, 2))
+	require.NoError(t, listenBlock(l, 3))
+
+	// the node halts before committing the block, which CometBFT replays on
+	// restart
+	require.ErrorIs(t, listenBlock(l, 4), ErrHalt)
+	require.Equal(t, 2, l.Len())
+
+	close(inner.gate)
+	require.Eventually(t, func() bool { return l.Len() == 0 }, time.Second, time.Millisecond)
+	require.NoError(t, listenBlock(l, 4))
+	require.NoError(t, l.Close())
+	require.Equal(t, []string{"commit-1", "commit-2", "commit-3", "commit-4"}, inner.commits())
+}
+
+func TestAsyncListener_HaltOnCommit(t *testing.T) {
+	inner := &recordingListener{gate: make(chan struct{})}
+	l := newTestAsyncListener(t, inner, BackpressureHalt)
+
+	startDelivery(t, l)
+	require.NoError(t, listenBlock(l, 2))
+	require.NoError(t, listenBlock(l, 3))
+
+	// a committed block is queued before halting
+	err := l.ListenCommit(context.Background(), abci.ResponseCommit{}, nil)
+	require.ErrorIs(t, err, ErrHalt)
+	require.Equal(t, 3, l.Len())
+
+	close(inner.gate)
+	require.NoError(t, l.Close())
+	require.Len(t, inner.commits(), 4)
+}
+
+func TestAsyncListener_Error(t *testing.T) {
+	inner := &recordingListener{failAt: 1}
+	l := newTestAsyncListener(t, inner, BackpressureBlock)
+
+	startDelivery(t, l)
+	require.Eventually(t, func() bool {
+		l.mtx.Lock()
+		defer l.mtx.Unlock()
+		return l.delivered == 1
+	}, time.Second, time.Millisecond)
+
+	// the error is returned by the next commit
+	require.ErrorContains(t, listenBlock(l, 2), "listener failure")
+	require.NoError(t, l.Close())
+}
+
+func TestNewAsyncListener_InvalidConfig(t *testing.T) {
+	_, err := NewAsyncListener("test", &recordingListener{}, AsyncListenerConfig{BufferSize: 0, Policy: BackpressureBlock}, log.NewNopLogger())
+	require.Error(t, err)
+
+	_, err = NewAsyncListener("test", &recordingListener{}, AsyncListenerConfig{BufferSize: 1, Policy: "drop-newest"}, log.NewNopLogger())
+	require.Error(t, err)
+}
@@ -0,0 +1,145 @@
+// Package listeners provides in-process storetypes.ABCIListener implementations
+// streaming the blocks of a node to a file or a Kafka topic.
+//
+// Both listeners deliver one Block per committed height, grouping the
+// ListenFinalizeBlock and ListenCommit messages of the height, and durably
+// track the last delivered height so that they resume where they stopped on
+// restart.
+//
+// The state changes passed to any listener can be restricted to some store key
+// prefixes with a FilteredListener, and decoded through the collections.Schema
+// of the modules with a CollectionDecoder.
+package listeners
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// Block groups the ListenFinalizeBlock and ListenCommit messages of a height.
+//
+// It is encoded as the protobuf message
+//
+//	message Block {
+//	  cosmos.store.streaming.abci.ListenFinalizeBlockRequest finalize_block = 1;
+//	  cosmos.store.streaming.abci.ListenCommitRequest commit = 2;
+//	}
+type Block struct {
+	FinalizeBlock *streamingabci.ListenFinalizeBlockRequest
+	Commit        *streamingabci.ListenCommitRequest
+}
+
+// Height returns the height of the block.
+func (b *Block) Height() int64 {
+	return b.Commit.BlockHeight
+}
+
+// Marshal encodes the block.
+func (b *Block) Marshal() ([]byte, error) {
+	finalizeBlock, err := b.FinalizeBlock.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	commit, err := b.Commit.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	var bz []byte
+	bz = protowire.AppendTag(bz, 1, protowire.BytesType)
+	bz = protowire.AppendBytes(bz, finalizeBlock)
+	bz = protowire.AppendTag(bz, 2, protowire.BytesType)
+	bz = protowire.AppendBytes(bz, commit)
+
+	return bz, nil
+}
+
+// UnmarshalBlock decodes a block encoded with Marshal.
+func UnmarshalBlock(bz []byte) (*Block, error) {
+	b := &Block{
+		FinalizeBlock: &streamingabci.ListenFinalizeBlockRequest{},
+		Commit:        &streamingabci.ListenCommitRequest{},
+	}
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		bz = bz[n:]
+
+		if typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, bz)
+			if n < 0 {
+				return nil, protowire.ParseError(n)
+			}
+			bz = bz[n:]
+			continue
+		}
+
+		field, n := protowire.ConsumeBytes(bz)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		bz = bz[n:]
+
+		var err error
+		switch num {
+		case 1:
+			err = b.FinalizeBlock.Unmarshal(field)
+		case 2:
+			err = b.Commit.Unmarshal(field)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return b, nil
+}
+
+// blockBuffer groups the ListenFinalizeBlock and ListenCommit messages of a
+// height into a Block.
+type blockBuffer struct {
+	mtx           sync.Mutex
+	finalizeBlock *streamingabci.ListenFinalizeBlockRequest
+}
+
+func (buf *blockBuffer) finalize(req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) {
+	buf.mtx.Lock()
+	defer buf.mtx.Unlock()
+
+	buf.finalizeBlock = &streamingabci.ListenFinalizeBlockRequest{Req: &req, Res: &res}
+}
+
+func (buf *blockBuffer) commit(res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) (*Block, error) {
+	buf.mtx.Lock()
+	defer buf.mtx.Unlock()
+
+	if buf.finalizeBlock == nil {
+		return nil, errors.New("commit without finalize block")
+	}
+
+	b := &Block{
+		FinalizeBlock: buf.finalizeBlock,
+		Commit: &streamingabci.ListenCommitRequest{
+			BlockHeight: buf.finalizeBlock.Req.Height,
+			Res:         &res,
+			ChangeSet:   changeSet,
+		},
+	}
+	buf.finalizeBlock = nil
+
+	return b, nil
+}
+
+// heightFileName returns the name of a file starting at height.
+func heightFileName(prefix string, height int64, ext string) string {
+	return fmt.Sprintf("%s%020d%s", prefix, height, ext)
+}
@@ -0,0 +1,229 @@
+package listeners
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// DecodedKVPair is a state change decoded through the collections.Schema of
+// its module.
+type DecodedKVPair struct {
+	// StoreKey is the name of the store.
+	StoreKey string `json:"store_key"`
+	// Collection is the name of the collection the key belongs to, empty if
+	// it does not belong to any collection of the schema of the store.
+	Collection string `json:"collection,omitempty"`
+	// Key is the JSON encoded key within the collection, if the codec of the
+	// keys of the collection is registered.
+	Key json.RawMessage `json:"key,omitempty"`
+	// RawKey is the key in the store.
+	RawKey []byte `json:"raw_key"`
+	// Value is the JSON encoded value, unless the key is deleted or does not
+	// belong to a collection.
+	Value json.RawMessage `json:"value,omitempty"`
+	// RawValue is the value of the keys not belonging to a collection.
+	RawValue []byte `json:"raw_value,omitempty"`
+	// Delete is true if the key is deleted.
+	Delete bool `json:"delete,omitempty"`
+}
+
+// decoderCollection is a collection known to a CollectionDecoder.
+type decoderCollection struct {
+	name   string
+	prefix []byte
+	value  collcodec.UntypedValueCodec
+	key    func(bz []byte) (json.RawMessage, error)
+}
+
+// CollectionDecoder decodes the state changes of the stores of the modules
+// through their collections.Schema.
+//
+// The collections only expose the codecs of their values, so the keys are
+// decoded only for the collections whose key codec is registered with
+// RegisterKeyCodec, and are otherwise only available as raw bytes.
+type CollectionDecoder struct {
+	collections map[string][]*decoderCollection
+}
+
+// NewCollectionDecoder returns a CollectionDecoder of the schemas of the
+// modules, by store key.
+func NewCollectionDecoder(schemas map[string]collections.Schema) *CollectionDecoder {
+	d := &CollectionDecoder{collections: make(map[string][]*decoderCollection, len(schemas))}
+	for storeKey, schema := range schemas {
+		for _, c := range schema.ListCollections() {
+			d.collections[storeKey] = append(d.collections[storeKey], &decoderCollection{
+				name:   c.GetName(),
+				prefix: c.GetPrefix(),
+				value:  c.ValueCodec(),
+			})
+		}
+	}
+
+	return d
+}
+
+// StoreKeys returns the keys of the stores whose schema is known, sorted.
+func (d *CollectionDecoder) StoreKeys() []string {
+	storeKeys := make([]string, 0, len(d.collections))
+	for storeKey := range d.collections {
+		storeKeys = append(storeKeys, storeKey)
+	}
+	sort.Strings(storeKeys)
+
+	return storeKeys
+}
+
+// Collections returns the names of the collections of the schema of a store.
+func (d *CollectionDecoder) Collections(storeKey string) []string {
+	names := make([]string, len(d.collections[storeKey]))
+	for i, c := range d.collections[storeKey] {
+		names[i] = c.name
+	}
+
+	return names
+}
+
+// RegisterKeyCodec registers the codec of the keys of a collection, e.g. the
+// KeyCodec of a collections.Map, so that its keys are decoded.
+func RegisterKeyCodec[K any](d *CollectionDecoder, storeKey, collection string, kc collcodec.KeyCodec[K]) error {
+	c, err := d.collection(storeKey, collection)
+	if err != nil {
+		return err
+	}
+
+	c.key = func(bz []byte) (json.RawMessage, error) {
+		n, key, err := kc.Decode(bz)
+		if err != nil {
+			return nil, err
+		}
+		if n != len(bz) {
+			return nil, fmt.Errorf("key of %d bytes decoded from %d bytes", n, len(bz))
+		}
+
+		return kc.EncodeJSON(key)
+	}
+
+	return nil
+}
+
+func (d *CollectionDecoder) collection(storeKey, name string) (*decoderCollection, error) {
+	for _, c := range d.collections[storeKey] {
+		if c.name == name {
+			return c, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown collection %s of store %s", name, storeKey)
+}
+
+// Filter returns the KVFilter matching the state changes of a collection.
+func (d *CollectionDecoder) Filter(storeKey, collection string) (KVFilter, error) {
+	c, err := d.collection(storeKey, collection)
+	if err != nil {
+		return KVFilter{}, err
+	}
+
+	return KVFilter{StoreKey: storeKey, Prefix: c.prefix}, nil
+}
+
+// Decode decodes a state change. Keys not belonging to any collection are
+// returned undecoded.
+func (d *CollectionDecoder) Decode(pair *storetypes.StoreKVPair) (*DecodedKVPair, error) {
+	decoded := &DecodedKVPair{
+		StoreKey: pair.StoreKey,
+		RawKey:   pair.Key,
+		Delete:   pair.Delete,
+	}
+
+	// the schemas reject prefixes of one another, so at most one matches
+	var c *decoderCollection
+	for _, candidate := range d.collections[pair.StoreKey] {
+		if bytes.HasPrefix(pair.Key, candidate.prefix) {
+			c = candidate
+			break
+		}
+	}
+	if c == nil {
+		if !pair.Delete {
+			decoded.RawValue = pair.Value
+		}
+		return decoded, nil
+	}
+	decoded.Collection = c.name
+
+	if c.key != nil {
+		key, err := c.key(pair.Key[len(c.prefix):])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode key %x of collection %s/%s: %w", pair.Key, pair.StoreKey, c.name, err)
+		}
+		decoded.Key = key
+	}
+
+	if !pair.Delete {
+		v, err := c.value.Decode(pair.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode value of key %x of collection %s/%s: %w", pair.Key, pair.StoreKey, c.name, err)
+		}
+		value, err := c.value.EncodeJSON(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode value of key %x of collection %s/%s: %w", pair.Key, pair.StoreKey, c.name, err)
+		}
+		decoded.Value = value
+	}
+
+	return decoded, nil
+}
+
+// DecodedListener is notified of the state changes decoded by a
+// CollectionDecoder, see NewDecodingListener.
+type DecodedListener interface {
+	// ListenFinalizeBlock updates the listener with the FinalizeBlock messages.
+	ListenFinalizeBlock(ctx context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error
+	// ListenDecodedCommit updates the listener with the Commit message and the
+	// decoded state changes of the block.
+	ListenDecodedCommit(ctx context.Context, res abci.ResponseCommit, changeSet []*DecodedKVPair) error
+}
+
+var _ storetypes.ABCIListener = (*decodingListener)(nil)
+
+type decodingListener struct {
+	listener DecodedListener
+	decoder  *CollectionDecoder
+}
+
+// NewDecodingListener returns an ABCIListener passing the state changes to
+// listener decoded by decoder. It may be wrapped in a FilteredListener to
+// decode only some of the state changes.
+func NewDecodingListener(listener DecodedListener, decoder *CollectionDecoder) storetypes.ABCIListener {
+	return &decodingListener{
+		listener: listener,
+		decoder:  decoder,
+	}
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener.
+func (l *decodingListener) ListenFinalizeBlock(ctx context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	return l.listener.ListenFinalizeBlock(ctx, req, res)
+}
+
+// ListenCommit implements storetypes.ABCIListener.
+func (l *decodingListener) ListenCommit(ctx context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	decoded := make([]*DecodedKVPair, len(changeSet))
+	for i, pair := range changeSet {
+		var err error
+		if decoded[i], err = l.decoder.Decode(pair); err != nil {
+			return err
+		}
+	}
+
+	return l.listener.ListenDecodedCommit(ctx, res, decoded)
+}
@@ -0,0 +1,127 @@
+package listeners
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// decodedListener records the decoded change sets it receives.
+type decodedListener struct {
+	changeSets [][]*DecodedKVPair
+}
+
+func (l *decodedListener) ListenFinalizeBlock(context.Context, abci.RequestFinalizeBlock, abci.ResponseFinalizeBlock) error {
+	return nil
+}
+
+func (l *decodedListener) ListenDecodedCommit(_ context.Context, _ abci.ResponseCommit, changeSet []*DecodedKVPair) error {
+	l.changeSets = append(l.changeSets, changeSet)
+	return nil
+}
+
+func newTestDecoder(t *testing.T) (*CollectionDecoder, collections.Map[collections.Pair[string, string], uint64]) {
+	t.Helper()
+
+	sb := collections.NewSchemaBuilderFromAccessor(func(context.Context) store.KVStore { return nil })
+	balances := collections.NewMap(sb, collections.NewPrefix(2), "balances",
+		collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.Uint64Value)
+	collections.NewItem(sb, collections.NewPrefix(3), "supply", collections.Uint64Value)
+	schema, err := sb.Build()
+	require.NoError(t, err)
+
+	d := NewCollectionDecoder(map[string]collections.Schema{"bank": schema})
+	require.NoError(t, RegisterKeyCodec(d, "bank", "balances", balances.KeyCodec()))
+	require.Error(t, RegisterKeyCodec(d, "bank", "unknown", balances.KeyCodec()))
+
+	return d, balances
+}
+
+func TestCollectionDecoder(t *testing.T) {
+	d, balances := newTestDecoder(t)
+	require.Equal(t, []string{"bank"}, d.StoreKeys())
+	require.Equal(t, []string{"balances", "supply"}, d.Collections("bank"))
+	require.Empty(t, d.Collections("acc"))
+
+	key := collections.Join("alice", "stake")
+	keyBz, err := collections.EncodeKeyWithPrefix(balances.GetPrefix(), balances.KeyCodec(), key)
+	require.NoError(t, err)
+	keyJSON, err := balances.KeyCodec().EncodeJSON(key)
+	require.NoError(t, err)
+	valueBz, err := collections.Uint64Value.Encode(100)
+	require.NoError(t, err)
+	valueJSON, err := collections.Uint64Value.EncodeJSON(100)
+	require.NoError(t, err)
+
+	decoded, err := d.Decode(&storetypes.StoreKVPair{StoreKey: "bank", Key: keyBz, Value: valueBz})
+	require.NoError(t, err)
+	require.Equal(t, &DecodedKVPair{
+		StoreKey:   "bank",
+		Collection: "balances",
+		Key:        keyJSON,
+		RawKey:     keyBz,
+		Value:      valueJSON,
+	}, decoded)
+
+	// deleted keys have no value
+	decoded, err = d.Decode(&storetypes.StoreKVPair{StoreKey: "bank", Key: keyBz, Delete: true})
+	require.NoError(t, err)
+	require.Equal(t, "balances", decoded.Collection)
+	require.Nil(t, decoded.Value)
+	require.True(t, decoded.Delete)
+
+	// the keys of the collections without key codec are not decoded
+	decoded, err = d.Decode(&storetypes.StoreKVPair{StoreKey: "bank", Key: []byte{3}, Value: valueBz})
+	require.NoError(t, err)
+	require.Equal(t, "supply", decoded.Collection)
+	require.Nil(t, decoded.Key)
+	require.Equal(t, json.RawMessage(valueJSON), decoded.Value)
+
+	// keys outside of the collections are returned raw
+	decoded, err = d.Decode(&storetypes.StoreKVPair{StoreKey: "acc", Key: []byte{1}, Value: []byte("raw")})
+	require.NoError(t, err)
+	require.Equal(t, &DecodedKVPair{StoreKey: "acc", RawKey: []byte{1}, RawValue: []byte("raw")}, decoded)
+
+	_, err = d.Decode(&storetypes.StoreKVPair{StoreKey: "bank", Key: keyBz, Value: []byte{1}})
+	require.ErrorContains(t, err, "failed to decode value")
+
+	_, err = d.Decode(&storetypes.StoreKVPair{StoreKey: "bank", Key: []byte{2, 1}, Value: valueBz})
+	require.ErrorContains(t, err, "failed to decode key")
+}
+
+func TestDecodingListener(t *testing.T) {
+	d, balances := newTestDecoder(t)
+
+	f, err := d.Filter("bank", "balances")
+	require.NoError(t, err)
+	require.Equal(t, KVFilter{StoreKey: "bank", Prefix: balances.GetPrefix()}, f)
+	_, err = d.Filter("bank", "unknown")
+	require.Error(t, err)
+
+	inner := &decodedListener{}
+	l := NewFilteredListener(NewDecodingListener(inner, d), []KVFilter{f})
+
+	keyBz, err := collections.EncodeKeyWithPrefix(balances.GetPrefix(), balances.KeyCodec(), collections.Join("bob", "stake"))
+	require.NoError(t, err)
+	valueBz, err := collections.Uint64Value.Encode(7)
+	require.NoError(t, err)
+
+	require.NoError(t, l.ListenCommit(context.Background(), abci.ResponseCommit{}, []*storetypes.StoreKVPair{
+		{StoreKey: "bank", Key: []byte{3}, Value: valueBz},
+		{StoreKey: "bank", Key: keyBz, Value: valueBz},
+	}))
+	require.Len(t, inner.changeSets, 1)
+	require.Len(t, inner.changeSets[0], 1)
+	require.Equal(t, "balances", inner.changeSets[0][0].Collection)
+
+	bz, err := json.Marshal(inner.changeSets[0][0])
+	require.NoError(t, err)
+	require.JSONEq(t, `{"store_key":"bank","collection":"balances","key":["bob","stake"],"raw_key":"AmJvYgBzdGFrZQ==","value":"7"}`, string(bz))
+}
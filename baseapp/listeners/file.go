@@ -0,0 +1,378 @@
+package listeners
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+const (
+	blockFilePrefix   = "blocks-"
+	blockFileExt      = ".pb"
+	pendingFilePrefix = "pending-"
+)
+
+var _ storetypes.ABCIListener = (*FileListener)(nil)
+
+// FileListenerConfig defines the configuration of a FileListener.
+type FileListenerConfig struct {
+	// Dir is the directory the block files are written to.
+	Dir string
+	// MaxFileSize is the size in bytes after which a new block file is
+	// started, 0 for no limit.
+	MaxFileSize uint64
+	// Fsync flushes every block to disk before it is considered delivered.
+	Fsync bool
+	// AllowGaps accepts blocks not following the last one received instead of
+	// failing with ErrMissedBlocks, e.g. when an AsyncListener with the
+	// BackpressureDropOldest policy may drop blocks.
+	AllowGaps bool
+}
+
+// FileListener is an ABCIListener writing the blocks to rotating files.
+//
+// Block files are named after the height of their first block and contain
+// the blocks one after another, each prefixed by its length as a uvarint. The
+// blocks that could not be written are kept in pending files of the directory
+// and retried on the next commit, including after a restart.
+type FileListener struct {
+	cfg FileListenerConfig
+	buf blockBuffer
+
+	mtx     sync.Mutex
+	offset  *offset
+	file    *os.File
+	size    int64
+	pending []*Block
+}
+
+// NewFileListener returns a FileListener writing to cfg.Dir. It resumes after
+// the last block written to the directory, discarding a block partially
+// written when the node stopped, and writes the pending blocks first.
+func NewFileListener(cfg FileListenerConfig) (*FileListener, error) {
+	if cfg.Dir == "" {
+		return nil, errors.New("file listener directory cannot be empty")
+	}
+	if err := os.MkdirAll(cfg.Dir, 0o700); err != nil {
+		return nil, err
+	}
+
+	offset, err := loadOffset(cfg.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &FileListener{cfg: cfg, offset: offset}
+	if err := l.recover(); err != nil {
+		return nil, err
+	}
+	if err := l.loadPending(); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// recover truncates the last block file after its last complete block and
+// opens it for appending.
+func (l *FileListener) recover() error {
+	files, err := BlockFiles(l.cfg.Dir)
+	if err != nil || len(files) == 0 {
+		return err
+	}
+
+	path := files[len(files)-1]
+	var height int64
+	size, err := readBlockFile(path, func(b *Block) error {
+		height = b.Height()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if err := f.Truncate(size); err != nil {
+		f.Close() // ignore error; Truncate error takes precedence
+		return err
+	}
+	if _, err := f.Seek(size, io.SeekStart); err != nil {
+		f.Close() // ignore error; Seek error takes precedence
+		return err
+	}
+	l.file, l.size = f, size
+
+	// the offset is saved after the block is written
+	if height > l.offset.height {
+		return l.offset.save(height)
+	}
+
+	return nil
+}
+
+// loadPending loads the pending blocks not written yet, and removes the
+// others.
+func (l *FileListener) loadPending() error {
+	files, err := filepath.Glob(filepath.Join(l.cfg.Dir, pendingFilePrefix+"*"+blockFileExt))
+	if err != nil {
+		return err
+	}
+	// file names are zero padded, so this sorts them by height
+	sort.Strings(files)
+	for _, path := range files {
+		bz, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		b, err := UnmarshalBlock(bz)
+		if err != nil {
+			return fmt.Errorf("invalid pending block file %s: %w", path, err)
+		}
+
+		if b.Height() <= l.offset.height {
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+			continue
+		}
+		l.pending = append(l.pending, b)
+	}
+
+	return nil
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener.
+func (l *FileListener) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	l.buf.finalize(req, res)
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener, writing the pending blocks
+// and the block. It fails with ErrMissedBlocks if the block does not follow
+// the last one received, and with ErrHalt if the block can neither be written
+// nor kept pending.
+func (l *FileListener) ListenCommit(_ context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	b, err := l.buf.commit(res, changeSet)
+	if err != nil {
+		return err
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	last := l.offset.height
+	if n := len(l.pending); n > 0 {
+		last = l.pending[n-1].Height()
+	}
+	// blocks replayed by CometBFT were already received
+	if b.Height() <= last {
+		return nil
+	}
+	if err := l.offset.checkNext(last, b.Height(), l.cfg.AllowGaps); err != nil {
+		return err
+	}
+
+	l.pending = append(l.pending, b)
+	for len(l.pending) > 0 {
+		if err := l.write(l.pending[0]); err != nil {
+			err = fmt.Errorf("failed to write block %d: %w", l.pending[0].Height(), err)
+			if pendingErr := l.savePending(b); pendingErr != nil {
+				return fmt.Errorf("%w, failed to keep block %d pending: %w: %w", err, b.Height(), pendingErr, ErrHalt)
+			}
+			return err
+		}
+		// only the blocks received before this one may have a pending file
+		if l.pending[0] != b {
+			if err := l.removePending(l.pending[0]); err != nil {
+				return err
+			}
+		}
+		l.pending = l.pending[1:]
+	}
+
+	return nil
+}
+
+// savePending writes the block to a pending file, so that it is written
+// after a restart.
+func (l *FileListener) savePending(b *Block) error {
+	bz, err := b.Marshal()
+	if err != nil {
+		return err
+	}
+
+	path := l.pendingPath(b)
+	if err := writeFileSync(path+".tmp", bz); err != nil {
+		return err
+	}
+
+	return os.Rename(path+".tmp", path)
+}
+
+// removePending removes the pending file of the block, if any.
+func (l *FileListener) removePending(b *Block) error {
+	if err := os.Remove(l.pendingPath(b)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (l *FileListener) pendingPath(b *Block) string {
+	return filepath.Join(l.cfg.Dir, heightFileName(pendingFilePrefix, b.Height(), blockFileExt))
+}
+
+// LastDeliveredHeight returns the height of the last block written.
+func (l *FileListener) LastDeliveredHeight() int64 {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	return l.offset.height
+}
+
+// Close closes the current block file.
+func (l *FileListener) Close() error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if l.file == nil {
+		return nil
+	}
+
+	err := l.file.Close()
+	l.file = nil
+	return err
+}
+
+func (l *FileListener) write(b *Block) error {
+	bz, err := b.Marshal()
+	if err != nil {
+		return err
+	}
+	frame := binary.AppendUvarint(make([]byte, 0, binary.MaxVarintLen64+len(bz)), uint64(len(bz)))
+	frame = append(frame, bz...)
+
+	if l.file == nil || (l.cfg.MaxFileSize > 0 && l.size > 0 && uint64(l.size)+uint64(len(frame)) > l.cfg.MaxFileSize) {
+		if err := l.rotate(b.Height()); err != nil {
+			return err
+		}
+	}
+
+	if _, err := l.file.Write(frame); err != nil {
+		// drop the partially written block, so that it is rewritten whole
+		_ = l.file.Truncate(l.size)
+		_, _ = l.file.Seek(l.size, io.SeekStart)
+		return err
+	}
+	if l.cfg.Fsync {
+		if err := l.file.Sync(); err != nil {
+			return err
+		}
+	}
+	l.size += int64(len(frame))
+
+	return l.offset.save(b.Height())
+}
+
+// rotate closes the current block file and starts a new one at height.
+func (l *FileListener) rotate(height int64) error {
+	if l.file != nil {
+		if err := l.file.Close(); err != nil {
+			return err
+		}
+		l.file = nil
+	}
+
+	path := filepath.Join(l.cfg.Dir, heightFileName(blockFilePrefix, height, blockFileExt))
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	l.file, l.size = f, 0
+
+	return nil
+}
+
+// BlockFiles returns the block files written by a FileListener to dir, in
+// height order.
+func BlockFiles(dir string) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, blockFilePrefix+"*"+blockFileExt))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	return files, nil
+}
+
+// ReadBlockFile calls fn with every complete block of a block file written by
+// a FileListener, in order.
+func ReadBlockFile(path string, fn func(*Block) error) error {
+	_, err := readBlockFile(path, fn)
+	return err
+}
+
+// readBlockFile reads the complete blocks of the file and returns their size.
+func readBlockFile(path string, fn func(*Block) error) (int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		r    = bufio.NewReader(f)
+		size int64
+	)
+	for {
+		n, err := binary.ReadUvarint(r)
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return size, nil
+		} else if err != nil {
+			return size, err
+		}
+
+		frameSize := int64(protowire.SizeVarint(n)) + int64(n)
+		if size+frameSize > info.Size() {
+			// partially written block
+			return size, nil
+		}
+
+		bz := make([]byte, n)
+		if _, err := io.ReadFull(r, bz); errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return size, nil
+		} else if err != nil {
+			return size, err
+		}
+
+		b, err := UnmarshalBlock(bz)
+		if err != nil {
+			return size, fmt.Errorf("invalid block in %s at offset %d: %w", path, size, err)
+		}
+		if err := fn(b); err != nil {
+			return size, err
+		}
+
+		size += frameSize
+	}
+}
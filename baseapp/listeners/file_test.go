@@ -0,0 +1,170 @@
+package listeners
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// listenBlock streams a block at height to the listener.
+func listenBlock(l storetypes.ABCIListener, height int64) error {
+	ctx := context.Background()
+	req := abci.RequestFinalizeBlock{Height: height, Txs: [][]byte{[]byte(fmt.Sprintf("tx-%d", height))}}
+	res := abci.ResponseFinalizeBlock{AppHash: []byte(fmt.Sprintf("hash-%d", height))}
+	if err := l.ListenFinalizeBlock(ctx, req, res); err != nil {
+		return err
+	}
+
+	changeSet := []*storetypes.StoreKVPair{{
+		StoreKey: "bank",
+		Key:      []byte(fmt.Sprintf("key-%d", height)),
+		Value:    []byte(fmt.Sprintf("value-%d", height)),
+	}}
+	return l.ListenCommit(ctx, abci.ResponseCommit{}, changeSet)
+}
+
+func requireBlock(t *testing.T, b *Block, height int64) {
+	t.Helper()
+
+	require.Equal(t, height, b.Height())
+	require.Equal(t, height, b.FinalizeBlock.Req.Height)
+	require.Equal(t, [][]byte{[]byte(fmt.Sprintf("tx-%d", height))}, b.FinalizeBlock.Req.Txs)
+	require.Equal(t, []byte(fmt.Sprintf("hash-%d", height)), b.FinalizeBlock.Res.AppHash)
+	require.Len(t, b.Commit.ChangeSet, 1)
+	require.Equal(t, []byte(fmt.Sprintf("value-%d", height)), b.Commit.ChangeSet[0].Value)
+}
+
+func readBlockHeights(t *testing.T, dir string) []int64 {
+	t.Helper()
+
+	files, err := BlockFiles(dir)
+	require.NoError(t, err)
+
+	var heights []int64
+	for _, file := range files {
+		require.NoError(t, ReadBlockFile(file, func(b *Block) error {
+			requireBlock(t, b, b.Height())
+			heights = append(heights, b.Height())
+			return nil
+		}))
+	}
+
+	return heights
+}
+
+func TestFileListener(t *testing.T) {
+	dir := t.TempDir()
+
+	l, err := NewFileListener(FileListenerConfig{Dir: dir, MaxFileSize: 256, Fsync: true})
+	require.NoError(t, err)
+	for height := int64(1); height <= 5; height++ {
+		require.NoError(t, listenBlock(l, height))
+	}
+	require.Equal(t, int64(5), l.LastDeliveredHeight())
+	require.NoError(t, l.Close())
+
+	files, err := BlockFiles(dir)
+	require.NoError(t, err)
+	require.Greater(t, len(files), 1, "block files should be rotated")
+	require.Equal(t, []int64{1, 2, 3, 4, 5}, readBlockHeights(t, dir))
+
+	// simulate a block partially written when the node stopped
+	f, err := os.OpenFile(files[len(files)-1], os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{100, 1, 2})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	l, err = NewFileListener(FileListenerConfig{Dir: dir, MaxFileSize: 256})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), l.LastDeliveredHeight())
+
+	// blocks replayed by CometBFT are not written twice
+	require.NoError(t, listenBlock(l, 5))
+	require.NoError(t, listenBlock(l, 6))
+	require.NoError(t, l.Close())
+
+	require.Equal(t, []int64{1, 2, 3, 4, 5, 6}, readBlockHeights(t, dir))
+}
+
+func TestFileListener_RecoverOffset(t *testing.T) {
+	dir := t.TempDir()
+
+	l, err := NewFileListener(FileListenerConfig{Dir: dir})
+	require.NoError(t, err)
+	for height := int64(1); height <= 3; height++ {
+		require.NoError(t, listenBlock(l, height))
+	}
+	require.NoError(t, l.Close())
+
+	// the node stopped after writing a block but before saving the offset
+	require.NoError(t, os.WriteFile(dir+"/"+offsetFileName, []byte("2"), 0o600))
+
+	l, err = NewFileListener(FileListenerConfig{Dir: dir})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), l.LastDeliveredHeight())
+	require.NoError(t, l.Close())
+}
+
+func TestFileListener_CommitWithoutFinalizeBlock(t *testing.T) {
+	l, err := NewFileListener(FileListenerConfig{Dir: t.TempDir()})
+	require.NoError(t, err)
+
+	require.Error(t, l.ListenCommit(context.Background(), abci.ResponseCommit{}, nil))
+}
+
+func TestFileListener_Pending(t *testing.T) {
+	dir := t.TempDir()
+
+	l, err := NewFileListener(FileListenerConfig{Dir: dir})
+	require.NoError(t, err)
+	require.NoError(t, listenBlock(l, 1))
+
+	// blocks failing to be written are kept pending on disk
+	require.NoError(t, l.file.Close())
+	require.Error(t, listenBlock(l, 2))
+	require.Error(t, listenBlock(l, 3))
+	require.Equal(t, int64(1), l.LastDeliveredHeight())
+
+	// and are written on restart, before the next block
+	l, err = NewFileListener(FileListenerConfig{Dir: dir})
+	require.NoError(t, err)
+	require.NoError(t, listenBlock(l, 3))
+	require.NoError(t, listenBlock(l, 4))
+	require.Equal(t, int64(4), l.LastDeliveredHeight())
+	require.NoError(t, l.Close())
+
+	require.Equal(t, []int64{1, 2, 3, 4}, readBlockHeights(t, dir))
+	pending, err := filepath.Glob(filepath.Join(dir, pendingFilePrefix+"*"))
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
+
+func TestFileListener_MissedBlocks(t *testing.T) {
+	dir := t.TempDir()
+
+	l, err := NewFileListener(FileListenerConfig{Dir: dir})
+	require.NoError(t, err)
+	require.NoError(t, listenBlock(l, 1))
+
+	err = listenBlock(l, 4)
+	require.ErrorIs(t, err, ErrMissedBlocks)
+	require.ErrorIs(t, err, ErrHalt)
+	require.ErrorContains(t, err, "2 to 3")
+	require.Equal(t, int64(1), l.LastDeliveredHeight())
+	require.NoError(t, l.Close())
+
+	l, err = NewFileListener(FileListenerConfig{Dir: dir, AllowGaps: true})
+	require.NoError(t, err)
+	require.NoError(t, listenBlock(l, 4))
+	require.NoError(t, l.Close())
+
+	require.Equal(t, []int64{1, 4}, readBlockHeights(t, dir))
+}
@@ -0,0 +1,128 @@
+package listeners
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// KVFilter matches the state changes of a store whose key starts with a
+// prefix.
+type KVFilter struct {
+	// StoreKey is the name of the store.
+	StoreKey string
+	// Prefix is the prefix of the keys, all the keys match if empty.
+	Prefix []byte
+}
+
+// ParseKVFilter parses a KVFilter of the form "<store key>" or
+// "<store key>/<hex key prefix>", e.g. "bank/02" for the bank balances.
+func ParseKVFilter(s string) (KVFilter, error) {
+	storeKey, prefix, hasPrefix := strings.Cut(strings.TrimSpace(s), "/")
+	if storeKey == "" {
+		return KVFilter{}, fmt.Errorf("invalid state change filter %q: empty store key", s)
+	}
+
+	f := KVFilter{StoreKey: storeKey}
+	if hasPrefix {
+		bz, err := hex.DecodeString(prefix)
+		if err != nil {
+			return KVFilter{}, fmt.Errorf("invalid state change filter %q: %w", s, err)
+		}
+		f.Prefix = bz
+	}
+
+	return f, nil
+}
+
+// ParseKVFilters parses a list of KVFilter, see ParseKVFilter.
+func ParseKVFilters(ss []string) ([]KVFilter, error) {
+	filters := make([]KVFilter, 0, len(ss))
+	for _, s := range ss {
+		f, err := ParseKVFilter(s)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, f)
+	}
+
+	return filters, nil
+}
+
+// Match returns true if the state change matches the filter.
+func (f KVFilter) Match(pair *storetypes.StoreKVPair) bool {
+	return pair.StoreKey == f.StoreKey && bytes.HasPrefix(pair.Key, f.Prefix)
+}
+
+// String implements fmt.Stringer, in the format parsed by ParseKVFilter.
+func (f KVFilter) String() string {
+	if len(f.Prefix) == 0 {
+		return f.StoreKey
+	}
+
+	return fmt.Sprintf("%s/%x", f.StoreKey, f.Prefix)
+}
+
+var _ storetypes.ABCIListener = (*FilteredListener)(nil)
+
+// FilteredListener passes to an ABCIListener only the state changes matching
+// any of its filters. The FinalizeBlock messages are passed unchanged.
+type FilteredListener struct {
+	listener storetypes.ABCIListener
+	filters  []KVFilter
+}
+
+// NewFilteredListener returns a FilteredListener passing to listener the state
+// changes matching any of filters.
+func NewFilteredListener(listener storetypes.ABCIListener, filters []KVFilter) *FilteredListener {
+	return &FilteredListener{
+		listener: listener,
+		filters:  filters,
+	}
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener.
+func (l *FilteredListener) ListenFinalizeBlock(ctx context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	return l.listener.ListenFinalizeBlock(ctx, req, res)
+}
+
+// ListenCommit implements storetypes.ABCIListener, filtering the change set.
+func (l *FilteredListener) ListenCommit(ctx context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	return l.listener.ListenCommit(ctx, res, l.filter(changeSet))
+}
+
+func (l *FilteredListener) filter(changeSet []*storetypes.StoreKVPair) []*storetypes.StoreKVPair {
+	filtered := make([]*storetypes.StoreKVPair, 0, len(changeSet))
+	for _, pair := range changeSet {
+		for _, f := range l.filters {
+			if f.Match(pair) {
+				filtered = append(filtered, pair)
+				break
+			}
+		}
+	}
+
+	return filtered
+}
+
+// ConsensusAffecting implements ConsensusListener, reporting whether the
+// filtered listener affects consensus.
+func (l *FilteredListener) ConsensusAffecting() bool {
+	return IsConsensusAffecting(l.listener)
+}
+
+// Close closes the filtered listener if it implements io.Closer.
+func (l *FilteredListener) Close() error {
+	if closer, ok := l.listener.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package listeners
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// changeSetListener records the change sets it receives.
+type changeSetListener struct {
+	changeSets [][]*storetypes.StoreKVPair
+}
+
+func (l *changeSetListener) ListenFinalizeBlock(context.Context, abci.RequestFinalizeBlock, abci.ResponseFinalizeBlock) error {
+	return nil
+}
+
+func (l *changeSetListener) ListenCommit(_ context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	l.changeSets = append(l.changeSets, changeSet)
+	return nil
+}
+
+func TestParseKVFilter(t *testing.T) {
+	testCases := []struct {
+		filter   string
+		expected KVFilter
+		expErr   bool
+	}{
+		{"bank", KVFilter{StoreKey: "bank"}, false},
+		{" bank/02 ", KVFilter{StoreKey: "bank", Prefix: []byte{2}}, false},
+		{"staking/2101", KVFilter{StoreKey: "staking", Prefix: []byte{0x21, 0x01}}, false},
+		{"bank/", KVFilter{StoreKey: "bank", Prefix: []byte{}}, false},
+		{"", KVFilter{}, true},
+		{"/02", KVFilter{}, true},
+		{"bank/balances", KVFilter{}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.filter, func(t *testing.T) {
+			f, err := ParseKVFilter(tc.filter)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, f)
+		})
+	}
+
+	f, err := ParseKVFilter("bank/02ff")
+	require.NoError(t, err)
+	require.Equal(t, "bank/02ff", f.String())
+}
+
+func TestFilteredListener(t *testing.T) {
+	inner := &changeSetListener{}
+	filters, err := ParseKVFilters([]string{"bank/02", "staking"})
+	require.NoError(t, err)
+	l := NewFilteredListener(inner, filters)
+
+	changeSet := []*storetypes.StoreKVPair{
+		{StoreKey: "bank", Key: []byte{1, 1}},
+		{StoreKey: "bank", Key: []byte{2, 1}},
+		{StoreKey: "bank", Key: []byte{2, 2}, Delete: true},
+		{StoreKey: "acc", Key: []byte{2, 1}},
+		{StoreKey: "staking", Key: []byte{0x21}},
+	}
+	require.NoError(t, l.ListenCommit(context.Background(), abci.ResponseCommit{}, changeSet))
+	require.Equal(t, [][]*storetypes.StoreKVPair{{changeSet[1], changeSet[2], changeSet[4]}}, inner.changeSets)
+
+	require.False(t, IsConsensusAffecting(l))
+	require.True(t, IsConsensusAffecting(NewFilteredListener(NewConsensusListener(inner), filters)))
+	require.NoError(t, l.Close())
+}
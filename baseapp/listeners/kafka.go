@@ -0,0 +1,214 @@
+package listeners
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+const (
+	spoolFilePrefix = "block-"
+	spoolFileExt    = ".pb"
+)
+
+var _ storetypes.ABCIListener = (*KafkaListener)(nil)
+
+// KafkaListenerConfig defines the configuration of a KafkaListener.
+type KafkaListenerConfig struct {
+	// Topic is the topic the blocks are published to.
+	Topic string
+	// Partition is the partition of the topic the blocks are published to.
+	Partition int32
+	// SpoolDir is the directory the blocks are kept in until the broker
+	// acknowledged them.
+	SpoolDir string
+	// AllowGaps accepts blocks not following the last one received instead of
+	// failing with ErrMissedBlocks, e.g. when an AsyncListener with the
+	// BackpressureDropOldest policy may drop blocks.
+	AllowGaps bool
+}
+
+// KafkaListener is an ABCIListener publishing the blocks to a Kafka topic, one
+// message per block keyed by its big endian height.
+//
+// Blocks are spooled to disk before being published, and the ones the broker
+// did not acknowledge, including before the node restarted, are published in
+// order before the next block.
+type KafkaListener struct {
+	cfg      KafkaListenerConfig
+	producer Producer
+	buf      blockBuffer
+
+	mtx     sync.Mutex
+	offset  *offset
+	spooled int64
+}
+
+// NewKafkaListener returns a KafkaListener publishing the blocks with the
+// producer.
+func NewKafkaListener(producer Producer, cfg KafkaListenerConfig) (*KafkaListener, error) {
+	if cfg.Topic == "" {
+		return nil, errors.New("kafka listener topic cannot be empty")
+	}
+	if cfg.SpoolDir == "" {
+		return nil, errors.New("kafka listener spool directory cannot be empty")
+	}
+	if err := os.MkdirAll(cfg.SpoolDir, 0o700); err != nil {
+		return nil, err
+	}
+
+	offset, err := loadOffset(cfg.SpoolDir)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &KafkaListener{
+		cfg:      cfg,
+		producer: producer,
+		offset:   offset,
+	}
+	files, err := l.spoolFiles()
+	if err != nil {
+		return nil, err
+	}
+	if n := len(files); n > 0 {
+		l.spooled = files[n-1].height
+	}
+
+	return l, nil
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener.
+func (l *KafkaListener) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	l.buf.finalize(req, res)
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener, spooling the block and
+// publishing the spooled blocks. It fails with ErrMissedBlocks if the block
+// does not follow the last one received.
+func (l *KafkaListener) ListenCommit(ctx context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	b, err := l.buf.commit(res, changeSet)
+	if err != nil {
+		return err
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	last := max(l.offset.height, l.spooled)
+	// blocks replayed by CometBFT were already received
+	if b.Height() <= last {
+		return nil
+	}
+	if err := l.offset.checkNext(last, b.Height(), l.cfg.AllowGaps); err != nil {
+		return err
+	}
+
+	bz, err := b.Marshal()
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(l.cfg.SpoolDir, heightFileName(spoolFilePrefix, b.Height(), spoolFileExt))
+	if err := writeFileSync(path+".tmp", bz); err != nil {
+		return err
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return err
+	}
+	l.spooled = b.Height()
+
+	return l.publish(ctx)
+}
+
+// Flush publishes the spooled blocks.
+func (l *KafkaListener) Flush(ctx context.Context) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	return l.publish(ctx)
+}
+
+// publish publishes the spooled blocks in order, removing them once
+// acknowledged.
+func (l *KafkaListener) publish(ctx context.Context) error {
+	files, err := l.spoolFiles()
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if file.height > l.offset.height {
+			bz, err := os.ReadFile(file.path)
+			if err != nil {
+				return err
+			}
+
+			key := binary.BigEndian.AppendUint64(nil, uint64(file.height))
+			if err := l.producer.Produce(ctx, l.cfg.Topic, l.cfg.Partition, key, bz); err != nil {
+				return fmt.Errorf("failed to publish block %d: %w", file.height, err)
+			}
+			if err := l.offset.save(file.height); err != nil {
+				return err
+			}
+		}
+
+		if err := os.Remove(file.path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// spoolFile is a block spooled to disk.
+type spoolFile struct {
+	path   string
+	height int64
+}
+
+// spoolFiles returns the spooled blocks in height order.
+func (l *KafkaListener) spoolFiles() ([]spoolFile, error) {
+	paths, err := filepath.Glob(filepath.Join(l.cfg.SpoolDir, spoolFilePrefix+"*"+spoolFileExt))
+	if err != nil {
+		return nil, err
+	}
+	// file names are zero padded, so this sorts them by height
+	sort.Strings(paths)
+
+	files := make([]spoolFile, len(paths))
+	for i, path := range paths {
+		name := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(path), spoolFilePrefix), spoolFileExt)
+		height, err := strconv.ParseInt(name, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid spooled block file %s: %w", path, err)
+		}
+		files[i] = spoolFile{path: path, height: height}
+	}
+
+	return files, nil
+}
+
+// LastDeliveredHeight returns the height of the last block acknowledged by the
+// broker.
+func (l *KafkaListener) LastDeliveredHeight() int64 {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	return l.offset.height
+}
+
+// Close closes the producer.
+func (l *KafkaListener) Close() error {
+	return l.producer.Close()
+}
@@ -0,0 +1,71 @@
+package listeners
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+// Producer publishes messages to a partition of a Kafka topic.
+type Producer interface {
+	// Produce publishes the message and returns once the broker acknowledged
+	// it.
+	Produce(ctx context.Context, topic string, partition int32, key, value []byte) error
+	// Close closes the connections to the brokers.
+	Close() error
+}
+
+var _ Producer = (*kafkaProducer)(nil)
+
+// kafkaProducer is a Producer backed by a franz-go client, which discovers
+// the brokers of the cluster from the seed brokers and sends every message to
+// the leader of its partition.
+type kafkaProducer struct {
+	client *kgo.Client
+}
+
+// NewKafkaProducer returns a Producer publishing to the Kafka cluster the
+// seed brokers belong to. Messages not acknowledged by the leader of their
+// partition and all its in-sync replicas within timeout fail.
+func NewKafkaProducer(brokers []string, clientID string, timeout time.Duration) (Producer, error) {
+	if len(brokers) == 0 {
+		return nil, errors.New("kafka producer requires at least one broker")
+	}
+
+	opts := []kgo.Opt{
+		kgo.SeedBrokers(brokers...),
+		kgo.RecordPartitioner(kgo.ManualPartitioner()),
+		kgo.RequiredAcks(kgo.AllISRAcks()),
+	}
+	if clientID != "" {
+		opts = append(opts, kgo.ClientID(clientID))
+	}
+	if timeout > 0 {
+		opts = append(opts, kgo.RecordDeliveryTimeout(timeout))
+	}
+
+	client, err := kgo.NewClient(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &kafkaProducer{client: client}, nil
+}
+
+// Produce implements Producer.
+func (p *kafkaProducer) Produce(ctx context.Context, topic string, partition int32, key, value []byte) error {
+	return p.client.ProduceSync(ctx, &kgo.Record{
+		Topic:     topic,
+		Partition: partition,
+		Key:       key,
+		Value:     value,
+	}).FirstErr()
+}
+
+// Close implements Producer.
+func (p *kafkaProducer) Close() error {
+	p.client.Close()
+	return nil
+}
@@ -0,0 +1,176 @@
+package listeners
+
+import (
+	"context"
+	"encoding/binary"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+// testCluster is an in-memory Kafka cluster whose Produce requests can be
+// made to fail.
+type testCluster struct {
+	*kfake.Cluster
+	failProduce atomic.Bool
+}
+
+func newTestCluster(t *testing.T, topic string) *testCluster {
+	t.Helper()
+
+	c := &testCluster{Cluster: kfake.MustCluster(kfake.NumBrokers(3), kfake.SeedTopics(2, topic))}
+	t.Cleanup(c.Close)
+
+	c.ControlKey(int16(kmsg.Produce), func(req kmsg.Request) (kmsg.Response, error, bool) {
+		c.KeepControl()
+		if !c.failProduce.Load() {
+			return nil, nil, false
+		}
+
+		produceReq := req.(*kmsg.ProduceRequest)
+		res := produceReq.ResponseKind().(*kmsg.ProduceResponse)
+		for _, reqTopic := range produceReq.Topics {
+			resTopic := kmsg.NewProduceResponseTopic()
+			resTopic.Topic = reqTopic.Topic
+			for _, reqPartition := range reqTopic.Partitions {
+				resPartition := kmsg.NewProduceResponseTopicPartition()
+				resPartition.Partition = reqPartition.Partition
+				resPartition.ErrorCode = kerr.TopicAuthorizationFailed.Code
+				resTopic.Partitions = append(resTopic.Partitions, resPartition)
+			}
+			res.Topics = append(res.Topics, resTopic)
+		}
+		return res, nil, true
+	})
+
+	return c
+}
+
+func (c *testCluster) newProducer(t *testing.T) Producer {
+	t.Helper()
+
+	p, err := NewKafkaProducer(c.ListenAddrs()[:1], "test", 5*time.Second)
+	require.NoError(t, err)
+	return p
+}
+
+// topicRecords consumes the records of the partition, expecting n of them.
+func (c *testCluster) topicRecords(t *testing.T, topic string, partition int32, n int) []*kgo.Record {
+	t.Helper()
+
+	client, err := kgo.NewClient(
+		kgo.SeedBrokers(c.ListenAddrs()...),
+		kgo.ConsumePartitions(map[string]map[int32]kgo.Offset{topic: {partition: kgo.NewOffset().AtStart()}}),
+		kgo.FetchMaxWait(100*time.Millisecond),
+	)
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var records []*kgo.Record
+	for len(records) < n && ctx.Err() == nil {
+		records = append(records, client.PollFetches(ctx).Records()...)
+	}
+
+	// check that no other record follows
+	ctx, cancel = context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	records = append(records, client.PollFetches(ctx).Records()...)
+	require.Len(t, records, n)
+
+	return records
+}
+
+func TestKafkaProducer(t *testing.T) {
+	c := newTestCluster(t, "blocks")
+	p := c.newProducer(t)
+
+	require.NoError(t, p.Produce(context.Background(), "blocks", 1, []byte("key-1"), []byte("value-1")))
+	require.NoError(t, p.Produce(context.Background(), "blocks", 1, nil, []byte("value-2")))
+	records := c.topicRecords(t, "blocks", 1, 2)
+	require.Equal(t, []byte("key-1"), records[0].Key)
+	require.Equal(t, []byte("value-1"), records[0].Value)
+	require.Empty(t, records[1].Key)
+	require.Equal(t, []byte("value-2"), records[1].Value)
+
+	c.failProduce.Store(true)
+	err := p.Produce(context.Background(), "blocks", 1, []byte("key-3"), []byte("value-3"))
+	require.ErrorIs(t, err, kerr.TopicAuthorizationFailed)
+	require.NoError(t, p.Close())
+
+	c.failProduce.Store(false)
+	c.topicRecords(t, "blocks", 1, 2)
+}
+
+func TestNewKafkaProducer_NoBrokers(t *testing.T) {
+	_, err := NewKafkaProducer(nil, "test", time.Second)
+	require.Error(t, err)
+}
+
+func TestKafkaListener(t *testing.T) {
+	cfg := KafkaListenerConfig{Topic: "blocks", Partition: 1, SpoolDir: t.TempDir()}
+	c := newTestCluster(t, cfg.Topic)
+
+	requirePublished := func(heights ...int64) {
+		t.Helper()
+
+		records := c.topicRecords(t, cfg.Topic, cfg.Partition, len(heights))
+		for i, height := range heights {
+			require.Equal(t, height, int64(binary.BigEndian.Uint64(records[i].Key)))
+
+			b, err := UnmarshalBlock(records[i].Value)
+			require.NoError(t, err)
+			requireBlock(t, b, height)
+		}
+	}
+
+	l, err := NewKafkaListener(c.newProducer(t), cfg)
+	require.NoError(t, err)
+	for height := int64(1); height <= 3; height++ {
+		require.NoError(t, listenBlock(l, height))
+	}
+	requirePublished(1, 2, 3)
+	require.Equal(t, int64(3), l.LastDeliveredHeight())
+
+	// blocks the brokers fail to acknowledge stay spooled
+	c.failProduce.Store(true)
+	require.Error(t, listenBlock(l, 4))
+	require.Error(t, listenBlock(l, 5))
+	require.Equal(t, int64(3), l.LastDeliveredHeight())
+	require.NoError(t, l.Close())
+
+	// and are published on restart, before the next block
+	c.failProduce.Store(false)
+	l, err = NewKafkaListener(c.newProducer(t), cfg)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), l.LastDeliveredHeight())
+
+	// blocks replayed by CometBFT are not published twice
+	require.NoError(t, listenBlock(l, 3))
+	require.NoError(t, listenBlock(l, 5))
+	requirePublished(1, 2, 3)
+
+	require.NoError(t, listenBlock(l, 6))
+	requirePublished(1, 2, 3, 4, 5, 6)
+	require.Equal(t, int64(6), l.LastDeliveredHeight())
+
+	// missed blocks halt the node
+	err = listenBlock(l, 8)
+	require.ErrorIs(t, err, ErrMissedBlocks)
+	require.ErrorIs(t, err, ErrHalt)
+	require.NoError(t, l.Close())
+
+	cfg.AllowGaps = true
+	l, err = NewKafkaListener(c.newProducer(t), cfg)
+	require.NoError(t, err)
+	require.NoError(t, listenBlock(l, 8))
+	requirePublished(1, 2, 3, 4, 5, 6, 8)
+	require.NoError(t, l.Close())
+}
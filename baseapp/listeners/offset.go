@@ -0,0 +1,91 @@
+package listeners
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// offsetFileName is the name of the file storing the last delivered height in
+// the directory of a listener.
+const offsetFileName = "offset"
+
+// ErrMissedBlocks is returned by the listeners receiving a block which does
+// not follow the last one they received, e.g. because the node committed
+// blocks while they were disabled or crashed before delivering a block. The
+// missed blocks cannot be recovered, as their state changes are not stored by
+// the node, so it also wraps ErrHalt.
+var ErrMissedBlocks = errors.New("streaming listener missed blocks")
+
+// offset durably tracks the last height delivered by a listener.
+type offset struct {
+	path   string
+	height int64
+}
+
+// loadOffset loads the last delivered height stored in dir, or 0 if none was.
+func loadOffset(dir string) (*offset, error) {
+	o := &offset{path: filepath.Join(dir, offsetFileName)}
+
+	bz, err := os.ReadFile(o.path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return o, nil
+	case err != nil:
+		return nil, err
+	}
+
+	o.height, err = strconv.ParseInt(strings.TrimSpace(string(bz)), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid offset file %s: %w", o.path, err)
+	}
+
+	return o, nil
+}
+
+// checkNext returns an error wrapping ErrMissedBlocks and ErrHalt if height
+// does not directly follow last, the last height received by the listener,
+// unless no height was ever delivered or allowGaps is set.
+func (o *offset) checkNext(last, height int64, allowGaps bool) error {
+	if allowGaps || o.height == 0 || height == last+1 {
+		return nil
+	}
+
+	return fmt.Errorf("%w %d to %d after height %d: %w", ErrMissedBlocks, last+1, height-1, last, ErrHalt)
+}
+
+// save atomically stores height as the last delivered height.
+func (o *offset) save(height int64) error {
+	tmp := o.path + ".tmp"
+	if err := writeFileSync(tmp, []byte(strconv.FormatInt(height, 10))); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, o.path); err != nil {
+		return err
+	}
+
+	o.height = height
+	return nil
+}
+
+// writeFileSync writes the file and flushes it to disk.
+func writeFileSync(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(data); err != nil {
+		f.Close() // ignore error; Write error takes precedence
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close() // ignore error; Sync error takes precedence
+		return err
+	}
+
+	return f.Close()
+}
@@ -11,6 +11,8 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
 // FinalizeBlockFunc is the function that is called by the OE to finalize the
@@ -31,10 +33,48 @@ type OptimisticExecution struct {
 	cancelFunc  func() // cancel function for the context
 	initialized bool   // A boolean value indicating whether the struct has been initialized
 
+	// state of the current execution, used to compute the statistics
+	start    time.Time     // time at which the execution started
+	duration time.Duration // duration of the execution, once finished
+	finished bool          // whether the execution finished
+	settled  bool          // whether the execution was either used or aborted
+	aborted  bool          // whether the execution was aborted
+
+	stats Stats
+
+	// options
+	telemetry                   bool // whether the statistics are emitted as metrics
+	startOnFirstProcessProposal bool // whether an execution is kept across ProcessProposal calls for the same proposal
+
 	// debugging/testing options
 	abortRate int // number from 0 to 100 that determines the percentage of OE that should be aborted
 }
 
+// Stats holds the statistics of the optimistic executions.
+type Stats struct {
+	// Executions is the number of optimistic executions started.
+	Executions uint64
+	// Hits is the number of optimistic executions whose result was used by
+	// FinalizeBlock.
+	Hits uint64
+	// Aborts is the number of optimistic executions aborted, either because
+	// FinalizeBlock was called for another block or because a new proposal was
+	// processed.
+	Aborts uint64
+	// WastedTime is the time spent running the aborted optimistic executions.
+	WastedTime time.Duration
+}
+
+// HitRate returns the ratio of the settled optimistic executions whose result
+// was used, or zero if none was settled.
+func (s Stats) HitRate() float64 {
+	if s.Hits+s.Aborts == 0 {
+		return 0
+	}
+
+	return float64(s.Hits) / float64(s.Hits+s.Aborts)
+}
+
 // NewOptimisticExecution initializes the Optimistic Execution context but does not start it.
 func NewOptimisticExecution(logger log.Logger, fn FinalizeBlockFunc, opts ...func(*OptimisticExecution)) *OptimisticExecution {
 	logger = logger.With(log.ModuleKey, "oe")
@@ -54,6 +94,26 @@ func WithAbortRate(rate int) func(*OptimisticExecution) {
 	}
 }
 
+// WithTelemetry sets whether the OE emits its statistics as telemetry metrics:
+// the number of executions, hits and aborts, the hit rate and the time wasted
+// by aborted executions. Metrics are only emitted if telemetry is enabled.
+func WithTelemetry(enabled bool) func(*OptimisticExecution) {
+	return func(oe *OptimisticExecution) {
+		oe.telemetry = enabled
+	}
+}
+
+// WithStartOnFirstProcessProposal sets whether the OE is started on the first
+// ProcessProposal call for a proposal only. When enabled, a later
+// ProcessProposal call for the same proposal, as happens when the same block is
+// proposed again in a later round, keeps the running or finished execution
+// instead of rolling it back and restarting it.
+func WithStartOnFirstProcessProposal(enabled bool) func(*OptimisticExecution) {
+	return func(oe *OptimisticExecution) {
+		oe.startOnFirstProcessProposal = enabled
+	}
+}
+
 // Reset resets the OE context. Must be called whenever we want to invalidate
 // the current OE.
 func (oe *OptimisticExecution) Reset() {
@@ -81,6 +141,31 @@ func (oe *OptimisticExecution) Initialized() bool {
 	return oe.initialized
 }
 
+// Started returns true if the OE is configured to start on the first
+// ProcessProposal call only, and was already started for the given proposal
+// without being aborted. The running or finished execution can then be kept.
+func (oe *OptimisticExecution) Started(req *abci.RequestProcessProposal) bool {
+	if oe == nil || !oe.startOnFirstProcessProposal {
+		return false
+	}
+	oe.mtx.Lock()
+	defer oe.mtx.Unlock()
+
+	return oe.initialized && !oe.aborted &&
+		oe.request.Height == req.Height && bytes.Equal(oe.request.Hash, req.Hash)
+}
+
+// Stats returns the statistics of the optimistic executions.
+func (oe *OptimisticExecution) Stats() Stats {
+	if oe == nil {
+		return Stats{}
+	}
+	oe.mtx.Lock()
+	defer oe.mtx.Unlock()
+
+	return oe.stats
+}
+
 // Execute initializes the OE and starts it in a goroutine.
 func (oe *OptimisticExecution) Execute(req *abci.RequestProcessProposal) {
 	oe.mtx.Lock()
@@ -103,15 +188,24 @@ func (oe *OptimisticExecution) Execute(req *abci.RequestProcessProposal) {
 	oe.cancelFunc = cancel
 	oe.initialized = true
 
+	oe.start = time.Now()
+	oe.duration = 0
+	oe.finished, oe.settled, oe.aborted = false, false, false
+	oe.stats.Executions++
+	oe.incrCounter(1, "executions")
+
 	go func() {
-		start := time.Now()
 		resp, err := oe.finalizeBlockFunc(ctx, oe.request)
 
 		oe.mtx.Lock()
 
-		executionTime := time.Since(start)
+		executionTime := time.Since(oe.start)
 		oe.logger.Debug("OE finished", "duration", executionTime.String(), "height", oe.request.Height, "hash", hex.EncodeToString(oe.request.Hash))
 		oe.response, oe.err = resp, err
+		oe.duration, oe.finished = executionTime, true
+		if oe.aborted {
+			oe.addWastedTime(executionTime)
+		}
 
 		close(oe.stopCh)
 		oe.mtx.Unlock()
@@ -130,27 +224,89 @@ func (oe *OptimisticExecution) AbortIfNeeded(reqHash []byte) bool {
 
 	if !bytes.Equal(oe.request.Hash, reqHash) {
 		oe.logger.Error("OE aborted due to hash mismatch", "oe_hash", hex.EncodeToString(oe.request.Hash), "req_hash", hex.EncodeToString(reqHash), "oe_height", oe.request.Height, "req_height", oe.request.Height)
-		oe.cancelFunc()
+		oe.abort()
 		return true
 	} else if oe.abortRate > 0 && rand.Intn(100) < oe.abortRate {
 		// this is for test purposes only, we can emulate a certain percentage of
 		// OE needed to be aborted.
-		oe.cancelFunc()
+		oe.abort()
 		oe.logger.Error("OE aborted due to test abort rate")
 		return true
 	}
 
+	if !oe.settled {
+		oe.settled = true
+		oe.stats.Hits++
+		oe.incrCounter(1, "hits")
+		oe.setHitRate()
+	}
+
 	return false
 }
 
+// Rollback aborts the OE unconditionally, waits for it to finish and resets it,
+// so that its result is never used. The caller is responsible for discarding
+// the state the execution wrote to, before restarting it with Execute.
+func (oe *OptimisticExecution) Rollback() {
+	if oe == nil {
+		return
+	}
+
+	oe.Abort()
+	oe.Reset()
+}
+
 // Abort aborts the OE unconditionally and waits for it to finish.
 func (oe *OptimisticExecution) Abort() {
-	if oe == nil || oe.cancelFunc == nil {
+	if oe == nil {
+		return
+	}
+
+	oe.mtx.Lock()
+	if oe.cancelFunc == nil {
+		oe.mtx.Unlock()
 		return
 	}
+	oe.abort()
+	stopCh := oe.stopCh
+	oe.mtx.Unlock()
 
+	<-stopCh
+}
+
+// abort cancels the execution and accounts for it as aborted unless its
+// result was already used. It must be called with the mutex held.
+func (oe *OptimisticExecution) abort() {
 	oe.cancelFunc()
-	<-oe.stopCh
+
+	if !oe.initialized || oe.settled {
+		return
+	}
+
+	oe.settled, oe.aborted = true, true
+	oe.stats.Aborts++
+	oe.incrCounter(1, "aborts")
+	oe.setHitRate()
+	if oe.finished {
+		oe.addWastedTime(oe.duration)
+	}
+}
+
+func (oe *OptimisticExecution) addWastedTime(d time.Duration) {
+	oe.stats.WastedTime += d
+	oe.incrCounter(float32(d.Milliseconds()), "wasted_time_ms")
+}
+
+func (oe *OptimisticExecution) incrCounter(val float32, key string) {
+	if oe.telemetry {
+		telemetry.IncrCounter(val, "oe", key)
+	}
+}
+
+func (oe *OptimisticExecution) setHitRate() {
+	if oe.telemetry {
+		telemetry.SetGauge(float32(oe.stats.HitRate()), "oe", "hit_rate")
+	}
 }
 
 // WaitResult waits for the OE to finish and returns the result.
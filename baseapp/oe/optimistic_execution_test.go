@@ -32,3 +32,69 @@ func TestOptimisticExecution(t *testing.T) {
 
 	oe.Reset()
 }
+
+func TestOptimisticExecution_Stats(t *testing.T) {
+	oe := NewOptimisticExecution(log.NewNopLogger(), testFinalizeBlock, WithTelemetry(true))
+
+	// hit
+	oe.Execute(&abci.RequestProcessProposal{Height: 1, Hash: []byte("hash1")})
+	assert.False(t, oe.AbortIfNeeded([]byte("hash1")))
+	_, _ = oe.WaitResult()
+	// the result was used, so a later abort is not accounted for
+	oe.Abort()
+
+	// abort due to a hash mismatch
+	oe.Execute(&abci.RequestProcessProposal{Height: 2, Hash: []byte("hash2")})
+	_, _ = oe.WaitResult()
+	assert.True(t, oe.AbortIfNeeded([]byte("other")))
+	oe.Reset()
+
+	// abort due to a new proposal
+	oe.Execute(&abci.RequestProcessProposal{Height: 3, Hash: []byte("hash3")})
+	oe.Abort()
+
+	stats := oe.Stats()
+	assert.Equal(t, uint64(3), stats.Executions)
+	assert.Equal(t, uint64(1), stats.Hits)
+	assert.Equal(t, uint64(2), stats.Aborts)
+	assert.InDelta(t, 1.0/3, stats.HitRate(), 1e-9)
+
+	var nilOE *OptimisticExecution
+	assert.Equal(t, Stats{}, nilOE.Stats())
+	assert.Zero(t, Stats{}.HitRate())
+}
+
+func TestOptimisticExecution_Rollback(t *testing.T) {
+	oe := NewOptimisticExecution(log.NewNopLogger(), testFinalizeBlock)
+	oe.Execute(&abci.RequestProcessProposal{Height: 1, Hash: []byte("hash")})
+	oe.Rollback()
+
+	// the result of a rolled back execution is never used, even for the same
+	// proposal
+	assert.False(t, oe.Initialized())
+	assert.Equal(t, uint64(1), oe.Stats().Aborts)
+
+	var nilOE *OptimisticExecution
+	nilOE.Rollback()
+}
+
+func TestOptimisticExecution_StartOnFirstProcessProposal(t *testing.T) {
+	req := &abci.RequestProcessProposal{Height: 1, Hash: []byte("hash")}
+
+	oe := NewOptimisticExecution(log.NewNopLogger(), testFinalizeBlock)
+	oe.Execute(req)
+	assert.False(t, oe.Started(req))
+
+	oe = NewOptimisticExecution(log.NewNopLogger(), testFinalizeBlock, WithStartOnFirstProcessProposal(true))
+	assert.False(t, oe.Started(req))
+	oe.Execute(req)
+	assert.True(t, oe.Started(req))
+	assert.False(t, oe.Started(&abci.RequestProcessProposal{Height: 1, Hash: []byte("other")}))
+	assert.False(t, oe.Started(&abci.RequestProcessProposal{Height: 2, Hash: []byte("hash")}))
+
+	oe.Rollback()
+	assert.False(t, oe.Started(req))
+
+	var nilOE *OptimisticExecution
+	assert.False(t, nilOE.Started(req))
+}
@@ -7,8 +7,10 @@ import (
 
 	dbm "github.com/cosmos/cosmos-db"
 
+	"cosmossdk.io/store/historical"
 	"cosmossdk.io/store/metrics"
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
@@ -28,6 +30,18 @@ func SetPruning(opts pruningtypes.PruningOptions) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetPruning(opts) }
 }
 
+// SetStorePruning sets the pruning option of a single store on the multistore
+// associated with the app, overriding the one set with SetPruning.
+func SetStorePruning(storeName string, opts pruningtypes.PruningOptions) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.cms.SetStorePruning(storeName, opts) }
+}
+
+// SetHistoricalQueries returns an option serving the queries at past heights
+// from a cache of the versions loaded, see BaseApp.SetHistoricalQueries.
+func SetHistoricalQueries(opts historical.Options) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetHistoricalQueries(opts) }
+}
+
 // SetMinGasPrices returns an option that sets the minimum gas prices on the app.
 func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	gasPrices, err := sdk.ParseDecCoins(gasPricesStr)
@@ -100,6 +114,22 @@ func SetMempool(mempool mempool.Mempool) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetMempool(mempool) }
 }
 
+// SetTxSelector sets the TxSelector used by the default proposal handler.
+func SetTxSelector(ts TxSelector) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetTxSelector(ts) }
+}
+
+// SetTxExecutor sets the TxExecutor executing the transactions of a block.
+func SetTxExecutor(executor TxExecutor) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetTxExecutor(executor) }
+}
+
+// SetProposalLanes sets the proposal lanes enforced by the default proposal
+// handler.
+func SetProposalLanes(lanes ...ProposalLane) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetProposalLanes(lanes...) }
+}
+
 // SetChainID sets the chain ID in BaseApp.
 func SetChainID(chainID string) func(*BaseApp) {
 	return func(app *BaseApp) { app.chainID = chainID }
@@ -110,13 +140,30 @@ func SetStoreLoader(loader StoreLoader) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetStoreLoader(loader) }
 }
 
-// SetOptimisticExecution enables optimistic execution.
+// SetOptimisticExecution enables optimistic execution. The options set with
+// SetOptimisticExecutionOptions are applied before opts.
 func SetOptimisticExecution(opts ...func(*oe.OptimisticExecution)) func(*BaseApp) {
 	return func(app *BaseApp) {
+		opts = append(append([]func(*oe.OptimisticExecution){}, app.optimisticExecOpts...), opts...)
 		app.optimisticExec = oe.NewOptimisticExecution(app.logger, app.internalFinalizeBlock, opts...)
 	}
 }
 
+// SetOptimisticExecutionOptions sets options applied to optimistic execution,
+// whether it is enabled before or after this option. It does not enable
+// optimistic execution, which allows node operators to configure it while
+// applications decide whether to enable it.
+func SetOptimisticExecutionOptions(opts ...func(*oe.OptimisticExecution)) func(*BaseApp) {
+	return func(app *BaseApp) {
+		app.optimisticExecOpts = append(app.optimisticExecOpts, opts...)
+		if app.optimisticExec != nil {
+			for _, opt := range opts {
+				opt(app.optimisticExec)
+			}
+		}
+	}
+}
+
 // DisableBlockGasMeter disables the block gas meter.
 func DisableBlockGasMeter() func(*BaseApp) {
 	return func(app *BaseApp) { app.SetDisableBlockGasMeter(true) }
@@ -318,6 +365,26 @@ func (app *BaseApp) SetQueryMultiStore(ms storetypes.MultiStore) {
 	app.qms = ms
 }
 
+// SetHistoricalQueries serves the queries at past heights from a cache of the
+// versions loaded, bounded by the options, instead of loading the version for
+// every query. It requires the main multistore to be a rootmulti.Store, and has
+// no effect if a query multistore is set with SetQueryMultiStore.
+func (app *BaseApp) SetHistoricalQueries(opts historical.Options) {
+	if app.sealed {
+		panic("SetHistoricalQueries() on sealed BaseApp")
+	}
+
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		panic(fmt.Errorf("historical queries require a rootmulti.Store, got %T", app.cms))
+	}
+	store, err := historical.NewStore(rms, opts)
+	if err != nil {
+		panic(err)
+	}
+	app.historicalStore = store
+}
+
 // SetMempool sets the mempool for the BaseApp and is required for the app to start up.
 func (app *BaseApp) SetMempool(mempool mempool.Mempool) {
 	if app.sealed {
@@ -326,6 +393,37 @@ func (app *BaseApp) SetMempool(mempool mempool.Mempool) {
 	app.mempool = mempool
 }
 
+// SetTxSelector sets the TxSelector used by the default proposal handler. It
+// has no effect if custom PrepareProposal and ProcessProposal handlers are set.
+func (app *BaseApp) SetTxSelector(ts TxSelector) {
+	if app.sealed {
+		panic("SetTxSelector() on sealed BaseApp")
+	}
+	app.txSelector = ts
+}
+
+// SetTxExecutor sets the TxExecutor executing the transactions of a block in
+// FinalizeBlock. It defaults to the sequential TxExecutor.
+func (app *BaseApp) SetTxExecutor(executor TxExecutor) {
+	if app.sealed {
+		panic("SetTxExecutor() on sealed BaseApp")
+	}
+	app.txExecutor = executor
+}
+
+// SetProposalLanes sets the proposal lanes enforced by the default proposal
+// handler. It has no effect if custom PrepareProposal and ProcessProposal
+// handlers are set.
+func (app *BaseApp) SetProposalLanes(lanes ...ProposalLane) {
+	if app.sealed {
+		panic("SetProposalLanes() on sealed BaseApp")
+	}
+	if err := ValidateProposalLanes(lanes); err != nil {
+		panic(err)
+	}
+	app.proposalLanes = lanes
+}
+
 // SetProcessProposal sets the process proposal function for the BaseApp.
 func (app *BaseApp) SetProcessProposal(handler sdk.ProcessProposalHandler) {
 	if app.sealed {
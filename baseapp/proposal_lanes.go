@@ -0,0 +1,200 @@
+package baseapp
+
+import (
+	"errors"
+	"fmt"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProposalLane reserves a share of the block space for the transactions matched
+// by Match. The lane's transactions may use at most MaxBlockSpace of the block
+// bytes and gas, while the transactions that match no lane share whatever space
+// is not reserved by any lane.
+//
+// Lane limits are enforced by both the default PrepareProposal and
+// ProcessProposal handlers, so every validator must be configured with the same
+// lanes.
+type ProposalLane struct {
+	// Name identifies the lane.
+	Name string
+	// Match returns true if the transaction belongs to the lane. A transaction
+	// belongs to the first lane that matches it.
+	Match func(tx sdk.Tx) bool
+	// MaxBlockSpace is the share of the block reserved for the lane, in (0, 1].
+	MaxBlockSpace sdkmath.LegacyDec
+}
+
+// MatchMsgTypes returns a ProposalLane match function accepting the
+// transactions whose messages all have one of the given type URLs.
+func MatchMsgTypes(msgTypeURLs ...string) func(tx sdk.Tx) bool {
+	types := make(map[string]struct{}, len(msgTypeURLs))
+	for _, typeURL := range msgTypeURLs {
+		types[typeURL] = struct{}{}
+	}
+
+	return func(tx sdk.Tx) bool {
+		msgs := tx.GetMsgs()
+		if len(msgs) == 0 {
+			return false
+		}
+
+		for _, msg := range msgs {
+			if _, ok := types[sdk.MsgTypeURL(msg)]; !ok {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// ValidateProposalLanes returns an error if the lanes have empty or duplicate
+// names, no match function, or reserve more than the whole block.
+func ValidateProposalLanes(lanes []ProposalLane) error {
+	total := sdkmath.LegacyZeroDec()
+	names := make(map[string]struct{}, len(lanes))
+	for _, lane := range lanes {
+		if lane.Name == "" {
+			return errors.New("proposal lane name cannot be empty")
+		}
+		if _, ok := names[lane.Name]; ok {
+			return fmt.Errorf("duplicate proposal lane %q", lane.Name)
+		}
+		names[lane.Name] = struct{}{}
+
+		if lane.Match == nil {
+			return fmt.Errorf("proposal lane %q has no match function", lane.Name)
+		}
+		if lane.MaxBlockSpace.IsNil() || !lane.MaxBlockSpace.IsPositive() || lane.MaxBlockSpace.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("proposal lane %q max block space must be in (0, 1], got %s", lane.Name, lane.MaxBlockSpace)
+		}
+		total = total.Add(lane.MaxBlockSpace)
+	}
+
+	if total.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("proposal lanes reserve more than the whole block: %s", total)
+	}
+
+	return nil
+}
+
+// laneTracker accounts for the block space used by each proposal lane. The last
+// slot tracks the transactions that match no lane.
+type laneTracker struct {
+	lanes     []ProposalLane
+	maxBytes  []uint64
+	maxGas    []uint64
+	usedBytes []uint64
+	usedGas   []uint64
+	limitGas  bool
+}
+
+// newLaneTracker computes the lane limits from the block consensus params so
+// that PrepareProposal and ProcessProposal derive the same limits.
+func newLaneTracker(ctx sdk.Context, lanes []ProposalLane) *laneTracker {
+	var (
+		blockMaxBytes int64
+		blockMaxGas   int64
+	)
+	if b := ctx.ConsensusParams().Block; b != nil {
+		blockMaxBytes = b.MaxBytes
+		blockMaxGas = b.MaxGas
+	}
+	if blockMaxBytes <= 0 {
+		blockMaxBytes = cmttypes.MaxBlockSizeBytes
+	}
+
+	n := len(lanes) + 1
+	t := &laneTracker{
+		lanes:     lanes,
+		maxBytes:  make([]uint64, n),
+		maxGas:    make([]uint64, n),
+		usedBytes: make([]uint64, n),
+		usedGas:   make([]uint64, n),
+		limitGas:  blockMaxGas > 0,
+	}
+
+	reserved := sdkmath.LegacyZeroDec()
+	share := func(ratio sdkmath.LegacyDec, limit int64) uint64 {
+		if limit <= 0 {
+			return 0
+		}
+		return ratio.MulInt64(limit).TruncateInt().Uint64()
+	}
+	for i, lane := range lanes {
+		reserved = reserved.Add(lane.MaxBlockSpace)
+		t.maxBytes[i] = share(lane.MaxBlockSpace, blockMaxBytes)
+		t.maxGas[i] = share(lane.MaxBlockSpace, blockMaxGas)
+	}
+
+	unreserved := sdkmath.LegacyOneDec().Sub(reserved)
+	t.maxBytes[n-1] = share(unreserved, blockMaxBytes)
+	t.maxGas[n-1] = share(unreserved, blockMaxGas)
+
+	return t
+}
+
+// laneOf returns the index of the lane the transaction belongs to.
+func (t *laneTracker) laneOf(tx sdk.Tx) int {
+	for i, lane := range t.lanes {
+		if lane.Match(tx) {
+			return i
+		}
+	}
+
+	return t.unreservedLane()
+}
+
+// unreservedLane returns the index of the transactions matching no lane.
+func (t *laneTracker) unreservedLane() int {
+	return len(t.lanes)
+}
+
+// reset clears the block space used by the lanes.
+func (t *laneTracker) reset() {
+	for i := range t.usedBytes {
+		t.usedBytes[i], t.usedGas[i] = 0, 0
+	}
+}
+
+// fits returns true if a transaction of the given size and gas can be added to
+// the lane without exceeding its limits.
+func (t *laneTracker) fits(lane int, txSize, txGas uint64) bool {
+	if t.usedBytes[lane]+txSize > t.maxBytes[lane] {
+		return false
+	}
+
+	return !t.limitGas || t.usedGas[lane]+txGas <= t.maxGas[lane]
+}
+
+// add accounts for a transaction added to the lane.
+func (t *laneTracker) add(lane int, txSize, txGas uint64) {
+	t.usedBytes[lane] += txSize
+	t.usedGas[lane] += txGas
+}
+
+// addTx accounts for a proposed transaction in its lane. It returns false if
+// the transaction exceeds the limits of the lane.
+func (t *laneTracker) addTx(tx sdk.Tx, txBz []byte) bool {
+	lane, txSize, txGas := t.laneOf(tx), uint64(len(txBz)), txGasLimit(tx)
+	if !t.fits(lane, txSize, txGas) {
+		return false
+	}
+
+	t.add(lane, txSize, txGas)
+	return true
+}
+
+// txGasLimit returns the gas limit of the transaction, or zero if it has none.
+func txGasLimit(tx sdk.Tx) uint64 {
+	if gasTx, ok := tx.(GasTx); ok {
+		return gasTx.GetGas()
+	}
+
+	return 0
+}
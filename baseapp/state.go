@@ -13,6 +13,10 @@ type state struct {
 
 	mtx sync.RWMutex
 	ctx sdk.Context
+
+	// mempoolRemovals are the txs to remove from the mempool once the block
+	// executed on the state is finalized.
+	mempoolRemovals []sdk.Tx
 }
 
 // CacheMultiStore calls and returns a CacheMultiStore on the state's underling
@@ -34,3 +38,20 @@ func (st *state) Context() sdk.Context {
 	defer st.mtx.RUnlock()
 	return st.ctx
 }
+
+// deferMempoolRemoval records a tx to remove from the mempool once the block is
+// finalized. It is safe for concurrent use.
+func (st *state) deferMempoolRemoval(tx sdk.Tx) {
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+	st.mempoolRemovals = append(st.mempoolRemovals, tx)
+}
+
+// takeMempoolRemovals returns and forgets the txs to remove from the mempool.
+func (st *state) takeMempoolRemovals() []sdk.Tx {
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+	txs := st.mempoolRemovals
+	st.mempoolRemovals = nil
+	return txs
+}
@@ -2,14 +2,17 @@ package baseapp
 
 import (
 	"fmt"
+	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/spf13/cast"
 
 	"cosmossdk.io/store/streaming"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp/listeners"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 )
@@ -20,10 +23,40 @@ const (
 	StreamingABCIPluginTomlKey        = "plugin"
 	StreamingABCIKeysTomlKey          = "keys"
 	StreamingABCIStopNodeOnErrTomlKey = "stop-node-on-err"
+
+	StreamingFileTomlKey            = "file"
+	StreamingFileEnableTomlKey      = "enable"
+	StreamingFileDirTomlKey         = "dir"
+	StreamingFileMaxFileSizeTomlKey = "max-file-size"
+	StreamingFileFsyncTomlKey       = "fsync"
+	StreamingFileAllowGapsTomlKey   = "allow-gaps"
+
+	StreamingKafkaTomlKey          = "kafka"
+	StreamingKafkaEnableTomlKey    = "enable"
+	StreamingKafkaBrokersTomlKey   = "brokers"
+	StreamingKafkaTopicTomlKey     = "topic"
+	StreamingKafkaPartitionTomlKey = "partition"
+	StreamingKafkaClientIDTomlKey  = "client-id"
+	StreamingKafkaTimeoutTomlKey   = "timeout"
+	StreamingKafkaSpoolDirTomlKey  = "spool-dir"
+	StreamingKafkaAllowGapsTomlKey = "allow-gaps"
+
+	// StreamingFiltersTomlKey is the key of the state change filters of the
+	// plugins and built-in listeners, within their section.
+	StreamingFiltersTomlKey = "filters"
+	// StreamingListenerAsyncTomlKey is the key enabling the asynchronous
+	// delivery to the plugins and built-in listeners, within their section.
+	StreamingListenerAsyncTomlKey = "async"
+
+	StreamingAsyncTomlKey           = "async"
+	StreamingAsyncBufferSizeTomlKey = "buffer-size"
+	StreamingAsyncPolicyTomlKey     = "policy"
 )
 
 // RegisterStreamingServices registers streaming services with the BaseApp.
 func (app *BaseApp) RegisterStreamingServices(appOpts servertypes.AppOptions, keys map[string]*storetypes.KVStoreKey) error {
+	var abciListeners []storetypes.ABCIListener
+
 	// register streaming services
 	streamingCfg := cast.ToStringMap(appOpts.Get(StreamingTomlKey))
 	for service := range streamingCfg {
@@ -35,35 +68,190 @@ func (app *BaseApp) RegisterStreamingServices(appOpts servertypes.AppOptions, ke
 			if err != nil {
 				return fmt.Errorf("failed to load streaming plugin: %w", err)
 			}
-			if err := app.registerStreamingPlugin(appOpts, keys, plugin); err != nil {
+			abciListener, err := streamingPluginListener(plugin)
+			if err != nil {
+				return fmt.Errorf("failed to register streaming plugin %w", err)
+			}
+			// the node must not commit a block a plugin stopping it on errors
+			// did not process
+			stopNodeOnErrKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingABCITomlKey, StreamingABCIStopNodeOnErrTomlKey)
+			if cast.ToBool(appOpts.Get(stopNodeOnErrKey)) {
+				abciListener = listeners.NewConsensusListener(abciListener)
+			}
+			filtersKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, service, StreamingFiltersTomlKey)
+			abciListener, err = filteredABCIListener(appOpts, filtersKey, abciListener)
+			if err != nil {
+				return fmt.Errorf("failed to register streaming plugin %w", err)
+			}
+			abciListener, err = app.asyncABCIListener(appOpts, service, abciListener)
+			if err != nil {
 				return fmt.Errorf("failed to register streaming plugin %w", err)
 			}
+			abciListeners = append(abciListeners, abciListener)
 		}
 	}
 
+	builtinListeners, err := app.newBuiltinABCIListeners(appOpts)
+	if err != nil {
+		return fmt.Errorf("failed to register streaming listener: %w", err)
+	}
+	abciListeners = append(abciListeners, builtinListeners...)
+
+	if len(abciListeners) > 0 {
+		app.registerABCIListeners(appOpts, keys, abciListeners)
+	}
+
 	return nil
 }
 
-// registerStreamingPlugin registers streaming plugins with the BaseApp.
-func (app *BaseApp) registerStreamingPlugin(
-	appOpts servertypes.AppOptions,
-	keys map[string]*storetypes.KVStoreKey,
-	streamingPlugin interface{},
-) error {
+// streamingPluginListener returns the ABCIListener implemented by a streaming
+// plugin.
+func streamingPluginListener(streamingPlugin interface{}) (storetypes.ABCIListener, error) {
 	v, ok := streamingPlugin.(storetypes.ABCIListener)
 	if !ok {
-		return fmt.Errorf("unexpected plugin type %T", v)
+		return nil, fmt.Errorf("unexpected plugin type %T", streamingPlugin)
 	}
 
-	app.registerABCIListenerPlugin(appOpts, keys, v)
-	return nil
+	return v, nil
 }
 
-// registerABCIListenerPlugin registers plugins that implement the ABCIListener interface.
-func (app *BaseApp) registerABCIListenerPlugin(
+// newBuiltinABCIListeners returns the built-in ABCIListeners enabled in the
+// streaming configuration.
+func (app *BaseApp) newBuiltinABCIListeners(appOpts servertypes.AppOptions) ([]storetypes.ABCIListener, error) {
+	var abciListeners []storetypes.ABCIListener
+	add := func(name string, l storetypes.ABCIListener) error {
+		filtersKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, name, StreamingFiltersTomlKey)
+		l, err := filteredABCIListener(appOpts, filtersKey, l)
+		if err != nil {
+			return err
+		}
+		l, err = app.asyncABCIListener(appOpts, name, l)
+		if err != nil {
+			return err
+		}
+
+		abciListeners = append(abciListeners, l)
+		return nil
+	}
+
+	fileKey := func(key string) string {
+		return fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingFileTomlKey, key)
+	}
+	if cast.ToBool(appOpts.Get(fileKey(StreamingFileEnableTomlKey))) {
+		l, err := listeners.NewFileListener(listeners.FileListenerConfig{
+			Dir:         homeRelativePath(appOpts, cast.ToString(appOpts.Get(fileKey(StreamingFileDirTomlKey)))),
+			MaxFileSize: cast.ToUint64(appOpts.Get(fileKey(StreamingFileMaxFileSizeTomlKey))),
+			Fsync:       cast.ToBool(appOpts.Get(fileKey(StreamingFileFsyncTomlKey))),
+			AllowGaps:   cast.ToBool(appOpts.Get(fileKey(StreamingFileAllowGapsTomlKey))) || asyncDropsBlocks(appOpts, StreamingFileTomlKey),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := add(StreamingFileTomlKey, l); err != nil {
+			return nil, err
+		}
+	}
+
+	kafkaKey := func(key string) string {
+		return fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingKafkaTomlKey, key)
+	}
+	if cast.ToBool(appOpts.Get(kafkaKey(StreamingKafkaEnableTomlKey))) {
+		producer, err := listeners.NewKafkaProducer(
+			cast.ToStringSlice(appOpts.Get(kafkaKey(StreamingKafkaBrokersTomlKey))),
+			cast.ToString(appOpts.Get(kafkaKey(StreamingKafkaClientIDTomlKey))),
+			time.Duration(cast.ToUint(appOpts.Get(kafkaKey(StreamingKafkaTimeoutTomlKey))))*time.Second,
+		)
+		if err != nil {
+			return nil, err
+		}
+		l, err := listeners.NewKafkaListener(producer, listeners.KafkaListenerConfig{
+			Topic:     cast.ToString(appOpts.Get(kafkaKey(StreamingKafkaTopicTomlKey))),
+			Partition: cast.ToInt32(appOpts.Get(kafkaKey(StreamingKafkaPartitionTomlKey))),
+			SpoolDir:  homeRelativePath(appOpts, cast.ToString(appOpts.Get(kafkaKey(StreamingKafkaSpoolDirTomlKey)))),
+			AllowGaps: cast.ToBool(appOpts.Get(kafkaKey(StreamingKafkaAllowGapsTomlKey))) || asyncDropsBlocks(appOpts, StreamingKafkaTomlKey),
+		})
+		if err != nil {
+			producer.Close() // ignore error; NewKafkaListener error takes precedence
+			return nil, err
+		}
+		if err := add(StreamingKafkaTomlKey, l); err != nil {
+			return nil, err
+		}
+	}
+
+	return abciListeners, nil
+}
+
+// filteredABCIListener wraps the listener in a FilteredListener if state
+// change filters are configured under filtersKey.
+func filteredABCIListener(
 	appOpts servertypes.AppOptions,
-	keys map[string]*storetypes.KVStoreKey,
+	filtersKey string,
+	abciListener storetypes.ABCIListener,
+) (storetypes.ABCIListener, error) {
+	filters, err := listeners.ParseKVFilters(cast.ToStringSlice(appOpts.Get(filtersKey)))
+	if err != nil {
+		return nil, err
+	}
+	if len(filters) == 0 {
+		return abciListener, nil
+	}
+
+	return listeners.NewFilteredListener(abciListener, filters), nil
+}
+
+// asyncABCIListener wraps the listener in an AsyncListener if asynchronous
+// delivery is enabled in its section. Listeners affecting consensus cannot be
+// called asynchronously.
+func (app *BaseApp) asyncABCIListener(
+	appOpts servertypes.AppOptions,
+	name string,
 	abciListener storetypes.ABCIListener,
+) (storetypes.ABCIListener, error) {
+	asyncKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, name, StreamingListenerAsyncTomlKey)
+	if !cast.ToBool(appOpts.Get(asyncKey)) {
+		return abciListener, nil
+	}
+	if listeners.IsConsensusAffecting(abciListener) {
+		return nil, fmt.Errorf("%s cannot be enabled for a listener affecting consensus", asyncKey)
+	}
+
+	policy, err := listeners.ParseBackpressurePolicy(cast.ToString(appOpts.Get(streamingAsyncKey(StreamingAsyncPolicyTomlKey))))
+	if err != nil {
+		return nil, err
+	}
+
+	return listeners.NewAsyncListener(name, abciListener, listeners.AsyncListenerConfig{
+		BufferSize: cast.ToInt(appOpts.Get(streamingAsyncKey(StreamingAsyncBufferSizeTomlKey))),
+		Policy:     policy,
+	}, app.logger)
+}
+
+// asyncDropsBlocks returns true if the listener named name is called
+// asynchronously with the drop-oldest policy, so that it may miss blocks.
+func asyncDropsBlocks(appOpts servertypes.AppOptions, name string) bool {
+	return cast.ToBool(appOpts.Get(fmt.Sprintf("%s.%s.%s", StreamingTomlKey, name, StreamingListenerAsyncTomlKey))) &&
+		cast.ToString(appOpts.Get(streamingAsyncKey(StreamingAsyncPolicyTomlKey))) == string(listeners.BackpressureDropOldest)
+}
+
+func streamingAsyncKey(key string) string {
+	return fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingAsyncTomlKey, key)
+}
+
+// homeRelativePath resolves a path relative to the node home.
+func homeRelativePath(appOpts servertypes.AppOptions, path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), path)
+}
+
+// registerABCIListeners registers the ABCIListeners with the BaseApp.
+func (app *BaseApp) registerABCIListeners(
+	appOpts servertypes.AppOptions,
+	keys map[string]*storetypes.KVStoreKey,
+	abciListeners []storetypes.ABCIListener,
 ) {
 	stopNodeOnErrKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingABCITomlKey, StreamingABCIStopNodeOnErrTomlKey)
 	stopNodeOnErr := cast.ToBool(appOpts.Get(stopNodeOnErrKey))
@@ -73,7 +261,7 @@ func (app *BaseApp) registerABCIListenerPlugin(
 	app.cms.AddListeners(exposedKeys)
 	app.SetStreamingManager(
 		storetypes.StreamingManager{
-			ABCIListeners: []storetypes.ABCIListener{abciListener},
+			ABCIListeners: abciListeners,
 			StopNodeOnErr: stopNodeOnErr,
 		},
 	)
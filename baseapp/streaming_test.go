@@ -12,7 +12,9 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/listeners"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 )
 
 var _ storetypes.ABCIListener = (*MockABCIListener)(nil)
@@ -144,3 +146,112 @@ func Test_Ctx_with_StreamingManager(t *testing.T) {
 		suite.baseApp.Commit()
 	}
 }
+
+func TestRegisterStreamingServices_FileListener(t *testing.T) {
+	dir := t.TempDir()
+	appOpts := simtestutil.AppOptionsMap{
+		"streaming.abci.keys":   []string{"*"},
+		"streaming.file.enable": true,
+		"streaming.file.dir":    dir,
+	}
+
+	suite := NewBaseAppSuite(t)
+	require.NoError(t, suite.baseApp.RegisterStreamingServices(appOpts, map[string]*storetypes.KVStoreKey{capKey1.Name(): capKey1}))
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	ctx := getFinalizeBlockStateCtx(suite.baseApp)
+	sm := ctx.StreamingManager()
+	require.Len(t, sm.ABCIListeners, 1)
+	fileListener, ok := sm.ABCIListeners[0].(*listeners.FileListener)
+	require.True(t, ok)
+
+	for height := int64(1); height <= 2; height++ {
+		_, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+	require.Equal(t, int64(2), fileListener.LastDeliveredHeight())
+
+	files, err := listeners.BlockFiles(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	var heights []int64
+	require.NoError(t, listeners.ReadBlockFile(files[0], func(b *listeners.Block) error {
+		heights = append(heights, b.Height())
+		return nil
+	}))
+	require.Equal(t, []int64{1, 2}, heights)
+}
+
+func TestRegisterStreamingServices_AsyncListener(t *testing.T) {
+	dir := t.TempDir()
+	appOpts := simtestutil.AppOptionsMap{
+		"streaming.file.enable":       true,
+		"streaming.file.dir":          dir,
+		"streaming.file.async":        true,
+		"streaming.async.buffer-size": 10,
+		"streaming.async.policy":      "block",
+	}
+
+	suite := NewBaseAppSuite(t)
+	require.NoError(t, suite.baseApp.RegisterStreamingServices(appOpts, map[string]*storetypes.KVStoreKey{capKey1.Name(): capKey1}))
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	sm := getFinalizeBlockStateCtx(suite.baseApp).StreamingManager()
+	require.Len(t, sm.ABCIListeners, 1)
+	require.IsType(t, &listeners.AsyncListener{}, sm.ABCIListeners[0])
+
+	for height := int64(1); height <= 3; height++ {
+		_, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	// closing the app delivers the buffered blocks
+	require.NoError(t, suite.baseApp.Close())
+
+	files, err := listeners.BlockFiles(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	var heights []int64
+	require.NoError(t, listeners.ReadBlockFile(files[0], func(b *listeners.Block) error {
+		heights = append(heights, b.Height())
+		return nil
+	}))
+	require.Equal(t, []int64{1, 2, 3}, heights)
+}
+
+func TestRegisterStreamingServices_Filters(t *testing.T) {
+	appOpts := simtestutil.AppOptionsMap{
+		"streaming.file.enable":  true,
+		"streaming.file.dir":     t.TempDir(),
+		"streaming.file.filters": []string{"key1/02"},
+	}
+
+	suite := NewBaseAppSuite(t)
+	require.NoError(t, suite.baseApp.RegisterStreamingServices(appOpts, map[string]*storetypes.KVStoreKey{capKey1.Name(): capKey1}))
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	sm := getFinalizeBlockStateCtx(suite.baseApp).StreamingManager()
+	require.Len(t, sm.ABCIListeners, 1)
+	require.IsType(t, &listeners.FilteredListener{}, sm.ABCIListeners[0])
+
+	appOpts["streaming.file.filters"] = []string{"key1/zz"}
+	suite = NewBaseAppSuite(t)
+	require.Error(t, suite.baseApp.RegisterStreamingServices(appOpts, map[string]*storetypes.KVStoreKey{capKey1.Name(): capKey1}))
+}
@@ -0,0 +1,97 @@
+package baseapp
+
+import (
+	"context"
+	"sort"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// DeliverTxFunc runs the transaction at txIndex in the block in FinalizeBlock.
+// It reads and writes state through ms, a branch of the block state, and
+// consumes block gas from blockGasMeter.
+type DeliverTxFunc func(txIndex int, ms storetypes.MultiStore, blockGasMeter storetypes.GasMeter) *abci.ExecTxResult
+
+// TxExecutor executes the transactions of a block in FinalizeBlock.
+//
+// Whatever the strategy, the results and the resulting block state must be the
+// same as calling deliverTx on each transaction in order, with the block state
+// and block gas meter.
+type TxExecutor interface {
+	// ExecuteTxs executes txs on ms, the block state, and returns their results
+	// in order. storeKeys are the keys of the stores mounted in ms, sorted by
+	// name, or nil if they are unknown. ExecuteTxs must return ctx.Err() if ctx
+	// is cancelled, as happens when an optimistic execution is aborted.
+	ExecuteTxs(
+		ctx context.Context,
+		txs [][]byte,
+		ms storetypes.MultiStore,
+		storeKeys []storetypes.StoreKey,
+		blockGasMeter storetypes.GasMeter,
+		deliverTx DeliverTxFunc,
+	) ([]*abci.ExecTxResult, error)
+}
+
+var _ TxExecutor = sequentialTxExecutor{}
+
+// sequentialTxExecutor runs the transactions one after another.
+type sequentialTxExecutor struct{}
+
+// NewSequentialTxExecutor returns the default TxExecutor, which runs the
+// transactions one after another on the block state.
+func NewSequentialTxExecutor() TxExecutor {
+	return sequentialTxExecutor{}
+}
+
+func (sequentialTxExecutor) ExecuteTxs(
+	ctx context.Context,
+	txs [][]byte,
+	ms storetypes.MultiStore,
+	_ []storetypes.StoreKey,
+	blockGasMeter storetypes.GasMeter,
+	deliverTx DeliverTxFunc,
+) ([]*abci.ExecTxResult, error) {
+	txResults := make([]*abci.ExecTxResult, 0, len(txs))
+	for i := range txs {
+		response := deliverTx(i, ms, blockGasMeter)
+
+		// check after every tx if we should abort
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+			// continue
+		}
+
+		txResults = append(txResults, response)
+	}
+
+	return txResults, nil
+}
+
+// storeKeys returns the keys of the stores mounted in the commit multistore,
+// sorted by name, or nil if the commit multistore does not expose them.
+func (app *BaseApp) storeKeys() []storetypes.StoreKey {
+	cms, ok := app.cms.(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	if !ok {
+		return nil
+	}
+
+	keysByName := cms.StoreKeysByName()
+	names := make([]string, 0, len(keysByName))
+	for name := range keysByName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	keys := make([]storetypes.StoreKey, len(names))
+	for i, name := range names {
+		keys[i] = keysByName[name]
+	}
+
+	return keys
+}
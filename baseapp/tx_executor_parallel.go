@@ -0,0 +1,345 @@
+package baseapp
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/tracekv"
+	storetypes "cosmossdk.io/store/types"
+)
+
+var _ TxExecutor = parallelTxExecutor{}
+
+// parallelTxExecutor runs the transactions speculatively in parallel and
+// commits them in order, re-executing the ones that conflict.
+type parallelTxExecutor struct {
+	workers int
+}
+
+// NewParallelTxExecutor returns a TxExecutor running the transactions of a
+// block in parallel with the given number of workers, or GOMAXPROCS workers if
+// workers <= 0.
+//
+// Every transaction is first executed speculatively on its own branch of the
+// block state as of the beginning of the block, tracking the keys it reads and
+// the ranges it iterates per StoreKey. The transactions are then committed in
+// order: a transaction is re-executed on the current block state if it read a
+// key written by an earlier transaction of the block, or if its block gas does
+// not fit in the block anymore. The results and the app hash are thus always
+// the same as with sequential execution.
+//
+// Speculative execution requires that transactions only depend on each other
+// through the stores: the block gas meter seen by a speculative execution is
+// not the block one, and the keepers, the ante and post handlers must be safe
+// for concurrent use. The effects of the transactions outside of the stores,
+// such as their removal from the mempool, are applied by BaseApp to the final
+// results only. Conflicting transactions are executed twice, so blocks of mostly
+// conflicting transactions are slower than with sequential execution.
+func NewParallelTxExecutor(workers int) TxExecutor {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	return parallelTxExecutor{workers: workers}
+}
+
+func (e parallelTxExecutor) ExecuteTxs(
+	ctx context.Context,
+	txs [][]byte,
+	ms storetypes.MultiStore,
+	storeKeys []storetypes.StoreKey,
+	blockGasMeter storetypes.GasMeter,
+	deliverTx DeliverTxFunc,
+) ([]*abci.ExecTxResult, error) {
+	if len(storeKeys) == 0 || len(txs) < 2 || e.workers < 2 {
+		return sequentialTxExecutor{}.ExecuteTxs(ctx, txs, ms, storeKeys, blockGasMeter, deliverTx)
+	}
+
+	// execute every transaction speculatively on the block state, which is not
+	// written to until all of them completed
+	var (
+		execs   = make([]*txExecution, len(txs))
+		baseMtx sync.Mutex
+		next    atomic.Int64
+		wg      sync.WaitGroup
+	)
+	for w := 0; w < min(e.workers, len(txs)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := int(next.Add(1) - 1); i < len(txs) && ctx.Err() == nil; i = int(next.Add(1) - 1) {
+				execs[i] = executeTx(i, ms, storeKeys, &baseMtx, storetypes.NewInfiniteGasMeter(), deliverTx)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// commit the transactions in order, re-executing the ones whose
+	// speculative execution is not valid anymore
+	written := make(map[storetypes.StoreKey]map[string]struct{})
+	txResults := make([]*abci.ExecTxResult, 0, len(txs))
+	for i := range txs {
+		exec := execs[i]
+		if exec.conflicts(written) || blockGasMeter.IsOutOfGas() || exec.blockGas > blockGasMeter.GasRemaining() {
+			exec = executeTx(i, ms, storeKeys, &baseMtx, blockGasMeter, deliverTx)
+		} else {
+			blockGasMeter.ConsumeGas(exec.blockGas, "block gas meter")
+		}
+		exec.commit(written)
+
+		// check after every tx if we should abort
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+			// continue
+		}
+
+		txResults = append(txResults, exec.result)
+	}
+
+	return txResults, nil
+}
+
+// txExecution is the execution of a transaction on a branch of the block state.
+type txExecution struct {
+	ms       storetypes.CacheMultiStore
+	stores   []*trackedKVStore
+	result   *abci.ExecTxResult
+	blockGas storetypes.Gas
+}
+
+// executeTx runs the transaction on a branch of ms tracking its reads, with the
+// given block gas meter.
+func executeTx(
+	txIndex int,
+	ms storetypes.MultiStore,
+	storeKeys []storetypes.StoreKey,
+	baseMtx *sync.Mutex,
+	blockGasMeter storetypes.GasMeter,
+	deliverTx DeliverTxFunc,
+) *txExecution {
+	exec := &txExecution{stores: make([]*trackedKVStore, len(storeKeys))}
+
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(storeKeys))
+	keys := make(map[string]storetypes.StoreKey, len(storeKeys))
+	for i, key := range storeKeys {
+		exec.stores[i] = newTrackedKVStore(key, ms.GetKVStore(key), baseMtx)
+		stores[key] = exec.stores[i]
+		keys[key.Name()] = key
+	}
+	exec.ms = cachemulti.NewStore(nil, stores, keys, nil, nil)
+
+	consumed := blockGasMeter.GasConsumed()
+	exec.result = deliverTx(txIndex, exec.ms, blockGasMeter)
+	exec.blockGas = blockGasMeter.GasConsumed() - consumed
+
+	return exec
+}
+
+// conflicts returns true if the transaction read a key written by the
+// committed transactions.
+func (exec *txExecution) conflicts(written map[storetypes.StoreKey]map[string]struct{}) bool {
+	for _, store := range exec.stores {
+		if store.readsAny(written[store.key]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// commit writes the transaction's changes to the block state and records the
+// written keys.
+func (exec *txExecution) commit(written map[storetypes.StoreKey]map[string]struct{}) {
+	exec.ms.Write()
+
+	for _, store := range exec.stores {
+		if len(store.writes) == 0 {
+			continue
+		}
+
+		keys, ok := written[store.key]
+		if !ok {
+			keys = make(map[string]struct{}, len(store.writes))
+			written[store.key] = keys
+		}
+		for key := range store.writes {
+			keys[key] = struct{}{}
+		}
+	}
+}
+
+var _ storetypes.KVStore = (*trackedKVStore)(nil)
+
+// trackedKVStore wraps a store of the block state, recording the keys read and
+// the ranges iterated by a transaction as well as the keys it writes. Accesses
+// to the parent store are serialized with the mutex shared by all the
+// executions of the block.
+type trackedKVStore struct {
+	key    storetypes.StoreKey
+	parent storetypes.KVStore
+	mtx    *sync.Mutex
+
+	reads  map[string]struct{}
+	ranges []keyRange
+	writes map[string]struct{}
+}
+
+// keyRange is the range of keys [start, end) of an iteration, where nil means
+// unbounded.
+type keyRange struct {
+	start, end []byte
+}
+
+func newTrackedKVStore(key storetypes.StoreKey, parent storetypes.KVStore, mtx *sync.Mutex) *trackedKVStore {
+	return &trackedKVStore{
+		key:    key,
+		parent: parent,
+		mtx:    mtx,
+		reads:  make(map[string]struct{}),
+		writes: make(map[string]struct{}),
+	}
+}
+
+// readsAny returns true if the store read or iterated any of the keys.
+func (s *trackedKVStore) readsAny(keys map[string]struct{}) bool {
+	if len(keys) == 0 {
+		return false
+	}
+
+	for key := range s.reads {
+		if _, ok := keys[key]; ok {
+			return true
+		}
+	}
+
+	for _, r := range s.ranges {
+		for key := range keys {
+			if r.contains([]byte(key)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (r keyRange) contains(key []byte) bool {
+	return (r.start == nil || bytes.Compare(key, r.start) >= 0) &&
+		(r.end == nil || bytes.Compare(key, r.end) < 0)
+}
+
+func (s *trackedKVStore) GetStoreType() storetypes.StoreType {
+	return s.parent.GetStoreType()
+}
+
+func (s *trackedKVStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+func (s *trackedKVStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+func (s *trackedKVStore) Get(key []byte) []byte {
+	s.reads[string(key)] = struct{}{}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.parent.Get(key)
+}
+
+func (s *trackedKVStore) Has(key []byte) bool {
+	s.reads[string(key)] = struct{}{}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.parent.Has(key)
+}
+
+func (s *trackedKVStore) Set(key, value []byte) {
+	s.writes[string(key)] = struct{}{}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.parent.Set(key, value)
+}
+
+func (s *trackedKVStore) Delete(key []byte) {
+	s.writes[string(key)] = struct{}{}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.parent.Delete(key)
+}
+
+func (s *trackedKVStore) Iterator(start, end []byte) storetypes.Iterator {
+	s.ranges = append(s.ranges, keyRange{start: bytes.Clone(start), end: bytes.Clone(end)})
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return &lockedIterator{Iterator: s.parent.Iterator(start, end), mtx: s.mtx}
+}
+
+func (s *trackedKVStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	s.ranges = append(s.ranges, keyRange{start: bytes.Clone(start), end: bytes.Clone(end)})
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return &lockedIterator{Iterator: s.parent.ReverseIterator(start, end), mtx: s.mtx}
+}
+
+// lockedIterator serializes the accesses to an iterator of the block state.
+type lockedIterator struct {
+	storetypes.Iterator
+	mtx *sync.Mutex
+}
+
+func (it *lockedIterator) Valid() bool {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.Iterator.Valid()
+}
+
+func (it *lockedIterator) Next() {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	it.Iterator.Next()
+}
+
+func (it *lockedIterator) Key() []byte {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.Iterator.Key()
+}
+
+func (it *lockedIterator) Value() []byte {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.Iterator.Value()
+}
+
+func (it *lockedIterator) Error() error {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.Iterator.Error()
+}
+
+func (it *lockedIterator) Close() error {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.Iterator.Close()
+}
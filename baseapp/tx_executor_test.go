@@ -0,0 +1,267 @@
+package baseapp_test
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/client"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+// counterServer increments the counter stored under key if it matches the
+// message counter. Unlike CounterServerImpl, it does not assert on mismatches,
+// which speculative executions may run into.
+type counterServer struct {
+	key []byte
+}
+
+func (s counterServer) IncrementCounter(ctx context.Context, msg *baseapptestutil.MsgCounter) (*baseapptestutil.MsgCreateCounterResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := sdkCtx.KVStore(capKey1)
+
+	counter := readCounter(store, s.key)
+	if counter != msg.Counter {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidSequence, "expected counter %d, got %d", counter, msg.Counter)
+	}
+
+	setIntOnStore(store, s.key, counter+1)
+	sdkCtx.EventManager().EmitEvents(counterEvent(sdk.EventTypeMessage, counter))
+
+	return &baseapptestutil.MsgCreateCounterResponse{}, nil
+}
+
+// counterAnteHandler increments the counter stored under key for every tx, so
+// that all txs conflict with each other.
+func counterAnteHandler(key []byte) sdk.AnteHandler {
+	return func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		store := ctx.KVStore(capKey1)
+		counter := readCounter(store, key)
+		setIntOnStore(store, key, counter+1)
+		ctx.EventManager().EmitEvents(counterEvent("ante_handler", counter))
+
+		return ctx, nil
+	}
+}
+
+func readCounter(store storetypes.KVStore, key []byte) int64 {
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return 0
+	}
+
+	counter, _ := binary.Varint(bz)
+	return counter
+}
+
+func TestParallelTxExecutor(t *testing.T) {
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+
+	newSuite := func(t *testing.T, withAnte bool, opts ...func(*baseapp.BaseApp)) *BaseAppSuite {
+		t.Helper()
+
+		if withAnte {
+			opts = append(opts, func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(counterAnteHandler(anteKey)) })
+		}
+		suite := NewBaseAppSuite(t, opts...)
+		baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), counterServer{deliverKey})
+		baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), MsgKeyValueImpl{})
+
+		_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+			ConsensusParams: &cmtproto.ConsensusParams{},
+		})
+		require.NoError(t, err)
+
+		return suite
+	}
+
+	testCases := map[string]struct {
+		// withAnte makes every tx increment the same counter, so that all of
+		// them conflict
+		withAnte bool
+	}{
+		"mostly independent txs": {withAnte: false},
+		"conflicting txs":        {withAnte: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sequential := newSuite(t, tc.withAnte)
+			parallel := newSuite(t, tc.withAnte, baseapp.SetTxExecutor(baseapp.NewParallelTxExecutor(4)))
+
+			_, _, addr := testdata.KeyTestPubAddr()
+			var deliverCounter int64
+			for height := int64(1); height <= 5; height++ {
+				var txs [][]byte
+				for i := 0; i < 20; i++ {
+					var tx sdk.Tx
+					switch i % 5 {
+					case 0:
+						// counter txs conflict with each other
+						tx = newTxCounter(t, sequential.txConfig, 0, deliverCounter)
+						deliverCounter++
+					case 1:
+						// a counter tx out of order fails
+						tx = newTxCounter(t, sequential.txConfig, 0, deliverCounter+10)
+					default:
+						// key value txs are independent, except for
+						// overwriting a key of the previous block
+						builder := sequential.txConfig.NewTxBuilder()
+						require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgKeyValue{
+							Key:    []byte(fmt.Sprintf("key-%d-%d", height%2, i)),
+							Value:  []byte(fmt.Sprintf("value-%d-%d", height, i)),
+							Signer: addr.String(),
+						}))
+						setTxSignature(t, builder, 0)
+						tx = builder.GetTx()
+					}
+
+					txBytes, err := sequential.txConfig.TxEncoder()(tx)
+					require.NoError(t, err)
+					txs = append(txs, txBytes)
+				}
+				txs = append(txs, []byte("not a tx"))
+
+				req := &abci.RequestFinalizeBlock{Height: height, Txs: txs}
+				expected, err := sequential.baseApp.FinalizeBlock(req)
+				require.NoError(t, err)
+				actual, err := parallel.baseApp.FinalizeBlock(req)
+				require.NoError(t, err)
+
+				require.Equal(t, expected.TxResults, actual.TxResults)
+				require.Equal(t, expected.AppHash, actual.AppHash)
+
+				_, err = sequential.baseApp.Commit()
+				require.NoError(t, err)
+				_, err = parallel.baseApp.Commit()
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, sequential.baseApp.LastCommitID(), parallel.baseApp.LastCommitID())
+		})
+	}
+}
+
+// kvParamStore stores the consensus params in the state of capKey2, like the
+// x/consensus module does in its own store.
+type kvParamStore struct{}
+
+var _ baseapp.ParamStore = kvParamStore{}
+
+func (kvParamStore) Set(ctx context.Context, value cmtproto.ConsensusParams) error {
+	bz, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).KVStore(capKey2).Set(ParamStoreKey, bz)
+	return nil
+}
+
+func (kvParamStore) Has(ctx context.Context) (bool, error) {
+	return sdk.UnwrapSDKContext(ctx).KVStore(capKey2).Has(ParamStoreKey), nil
+}
+
+func (kvParamStore) Get(ctx context.Context) (cmtproto.ConsensusParams, error) {
+	var params cmtproto.ConsensusParams
+	err := json.Unmarshal(sdk.UnwrapSDKContext(ctx).KVStore(capKey2).Get(ParamStoreKey), &params)
+	return params, err
+}
+
+// consensusParamsAnteHandler emits the max block bytes of the consensus params
+// of the tx context.
+func consensusParamsAnteHandler(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+	var maxBytes int64
+	if block := ctx.ConsensusParams().Block; block != nil {
+		maxBytes = block.MaxBytes
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent("consensus_params", sdk.NewAttribute("max_bytes", fmt.Sprintf("%d", maxBytes))))
+
+	return ctx, nil
+}
+
+func TestParallelTxExecutor_ConsensusParams(t *testing.T) {
+	newApp := func(t *testing.T, opts ...func(*baseapp.BaseApp)) (*baseapp.BaseApp, client.TxConfig) {
+		t.Helper()
+
+		cdc := codectestutil.CodecOptions{}.NewCodec()
+		baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+		txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+
+		app := baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), txConfig.TxDecoder(), opts...)
+		app.SetInterfaceRegistry(cdc.InterfaceRegistry())
+		app.MsgServiceRouter().SetInterfaceRegistry(cdc.InterfaceRegistry())
+		app.MountStores(capKey1, capKey2)
+		app.SetParamStore(kvParamStore{})
+		app.SetAnteHandler(consensusParamsAnteHandler)
+		baseapptestutil.RegisterKeyValueServer(app.MsgServiceRouter(), MsgKeyValueImpl{})
+		require.NoError(t, app.LoadLatestVersion())
+
+		_, err := app.InitChain(&abci.RequestInitChain{
+			ConsensusParams: &cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxBytes: 1000}},
+		})
+		require.NoError(t, err)
+
+		return app, txConfig
+	}
+
+	sequential, txConfig := newApp(t)
+	parallel, _ := newApp(t, baseapp.SetTxExecutor(baseapp.NewParallelTxExecutor(4)))
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	newTx := func(key, value []byte) []byte {
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgKeyValue{Key: key, Value: value, Signer: addr.String()}))
+		setTxSignature(t, builder, 0)
+		txBytes, err := txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	// a tx in the middle of the block updates the consensus params, which the
+	// following txs must observe
+	params, err := json.Marshal(cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxBytes: 2000}})
+	require.NoError(t, err)
+	var txs [][]byte
+	for i := 0; i < 10; i++ {
+		if i == 4 {
+			txs = append(txs, newTx(ParamStoreKey, params))
+			continue
+		}
+		txs = append(txs, newTx([]byte(fmt.Sprintf("key-%d", i)), []byte("value")))
+	}
+
+	req := &abci.RequestFinalizeBlock{Height: 1, Txs: txs}
+	expected, err := sequential.FinalizeBlock(req)
+	require.NoError(t, err)
+	actual, err := parallel.FinalizeBlock(req)
+	require.NoError(t, err)
+
+	require.Equal(t, expected.TxResults, actual.TxResults)
+	require.Equal(t, expected.AppHash, actual.AppHash)
+	for i, res := range actual.TxResults {
+		maxBytes := "1000"
+		if i > 4 {
+			maxBytes = "2000"
+		}
+		require.Equal(t, maxBytes, string(res.Events[0].Attributes[0].Value), "tx %d", i)
+	}
+}
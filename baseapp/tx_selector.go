@@ -0,0 +1,370 @@
+package baseapp
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// Names of the built-in TxSelector strategies, as used in app.toml.
+const (
+	TxSelectorDefault     = "default"
+	TxSelectorFee         = "fee"
+	TxSelectorSenderQuota = "sender-quota"
+)
+
+// feeTxSelectorLookahead is the multiple of the block capacity the fee
+// TxSelector collects as candidates before choosing the transactions to
+// propose.
+const feeTxSelectorLookahead = 2
+
+// NewTxSelectorFromName returns the built-in TxSelector registered under the
+// given name. An empty name returns the default TxSelector. maxTxsPerSender is
+// only used by the sender quota TxSelector, and gasPrices by the fee
+// TxSelector, which requires them to compare the fees of different
+// denominations.
+func NewTxSelectorFromName(name string, maxTxsPerSender int, gasPrices sdk.DecCoins) (TxSelector, error) {
+	switch name {
+	case "", TxSelectorDefault:
+		return NewDefaultTxSelector(), nil
+
+	case TxSelectorFee:
+		if !gasPrices.IsAllPositive() {
+			return nil, fmt.Errorf("tx selector %q requires minimum gas prices to compare the fees of different denominations", name)
+		}
+		return NewFeeTxSelector(gasPrices), nil
+
+	case TxSelectorSenderQuota:
+		if maxTxsPerSender <= 0 {
+			return nil, fmt.Errorf("tx selector %q requires a positive max txs per sender, got %d", name, maxTxsPerSender)
+		}
+		return NewSenderQuotaTxSelector(maxTxsPerSender), nil
+
+	default:
+		return nil, fmt.Errorf("unknown tx selector %q", name)
+	}
+}
+
+// txCandidatesCounter is implemented by TxSelectors that defer the final choice
+// of transactions until SelectedTxs is called. It reports how many transactions
+// have been accepted as candidates so far, which allows the proposal handler to
+// track signer sequences without recomputing the selection on every step.
+type txCandidatesCounter interface {
+	candidatesLen() int
+}
+
+// laneTxSelector is implemented by TxSelectors deferring the final choice of
+// transactions, which must then enforce the limits of the proposal lanes
+// themselves, on the transactions they finally select rather than on their
+// candidates.
+type laneTxSelector interface {
+	txCandidatesCounter
+
+	// setLanes sets the lanes enforced until the next call to Clear.
+	setLanes(lanes *laneTracker)
+}
+
+// isFull returns true if the selected transactions reached either the byte or
+// the gas capacity of the block.
+func (ts *defaultTxSelector) isFull(maxTxBytes, maxBlockGas uint64) bool {
+	return ts.totalTxBytes >= maxTxBytes || (maxBlockGas > 0 && (ts.totalTxGas >= maxBlockGas))
+}
+
+type senderQuotaTxSelector struct {
+	defaultTxSelector
+
+	maxTxsPerSender  int
+	signerExtAdapter mempool.SignerExtractionAdapter
+	senderTxs        map[string]int
+}
+
+// NewSenderQuotaTxSelector returns a TxSelector that fills the block in mempool
+// iteration order, like the default TxSelector, but selects at most
+// maxTxsPerSender transactions signed by any single signer.
+func NewSenderQuotaTxSelector(maxTxsPerSender int) TxSelector {
+	return &senderQuotaTxSelector{
+		maxTxsPerSender:  maxTxsPerSender,
+		signerExtAdapter: mempool.NewDefaultSignerExtractionAdapter(),
+		senderTxs:        make(map[string]int),
+	}
+}
+
+func (ts *senderQuotaTxSelector) Clear() {
+	ts.defaultTxSelector.Clear()
+	ts.senderTxs = make(map[string]int)
+}
+
+func (ts *senderQuotaTxSelector) SelectTxForProposal(ctx context.Context, maxTxBytes, maxBlockGas uint64, memTx sdk.Tx, txBz []byte) bool {
+	var senders []string
+	if memTx != nil {
+		signers, err := ts.signerExtAdapter.GetSigners(memTx)
+		if err != nil {
+			return ts.isFull(maxTxBytes, maxBlockGas)
+		}
+
+		for _, signer := range signers {
+			sender := signer.Signer.String()
+			if ts.senderTxs[sender] >= ts.maxTxsPerSender {
+				return ts.isFull(maxTxBytes, maxBlockGas)
+			}
+			senders = append(senders, sender)
+		}
+	}
+
+	selected := len(ts.selectedTxs)
+	stop := ts.defaultTxSelector.SelectTxForProposal(ctx, maxTxBytes, maxBlockGas, memTx, txBz)
+	if len(ts.selectedTxs) != selected {
+		for _, sender := range senders {
+			ts.senderTxs[sender]++
+		}
+	}
+
+	return stop
+}
+
+// feeCandidate is a transaction collected by the fee TxSelector.
+type feeCandidate struct {
+	txBz      []byte
+	size      uint64
+	gas       uint64
+	feePerGas sdkmath.LegacyDec
+	lane      int
+
+	// parents is the number of earlier candidates sharing a signer with this
+	// one, and children the indexes of the later candidates depending on it.
+	parents  int
+	children []int
+}
+
+type feeTxSelector struct {
+	signerExtAdapter mempool.SignerExtractionAdapter
+	gasPrices        sdk.DecCoins
+	lanes            *laneTracker
+
+	maxTxBytes   uint64
+	maxBlockGas  uint64
+	totalTxBytes uint64
+	totalTxGas   uint64
+	candidates   []*feeCandidate
+	lastBySigner map[string]int
+	selectedTxs  [][]byte
+}
+
+// NewFeeTxSelector returns a TxSelector that collects candidate transactions
+// up to twice the block capacity and then fills the block greedily by fee per
+// gas, highest first. Transactions sharing a signer are always selected in
+// mempool iteration order, and a transaction is only selected if every earlier
+// candidate of its signers was selected too, so that signer sequences remain
+// contiguous within the proposal.
+//
+// Fees of different denominations are compared once converted with gasPrices,
+// typically the minimum gas prices of the node: the fee per gas of a
+// transaction is the sum, across the denominations it pays fees in, of the fee
+// amount per unit of gas divided by the gas price of the denomination. Fees in
+// denominations without a gas price are ignored.
+//
+// If proposal lanes are set, they are enforced on the final selection.
+func NewFeeTxSelector(gasPrices sdk.DecCoins) TxSelector {
+	return &feeTxSelector{
+		signerExtAdapter: mempool.NewDefaultSignerExtractionAdapter(),
+		gasPrices:        gasPrices,
+		lastBySigner:     make(map[string]int),
+	}
+}
+
+func (ts *feeTxSelector) SelectedTxs(_ context.Context) [][]byte {
+	if ts.selectedTxs == nil {
+		ts.selectedTxs = ts.selectTxs()
+	}
+
+	txs := make([][]byte, len(ts.selectedTxs))
+	copy(txs, ts.selectedTxs)
+	return txs
+}
+
+func (ts *feeTxSelector) Clear() {
+	ts.maxTxBytes = 0
+	ts.maxBlockGas = 0
+	ts.totalTxBytes = 0
+	ts.totalTxGas = 0
+	ts.candidates = nil
+	ts.lastBySigner = make(map[string]int)
+	ts.selectedTxs = nil
+	ts.lanes = nil
+}
+
+func (ts *feeTxSelector) setLanes(lanes *laneTracker) {
+	ts.lanes = lanes
+	ts.selectedTxs = nil
+}
+
+func (ts *feeTxSelector) SelectTxForProposal(_ context.Context, maxTxBytes, maxBlockGas uint64, memTx sdk.Tx, txBz []byte) bool {
+	ts.maxTxBytes = maxTxBytes
+	ts.maxBlockGas = maxBlockGas
+
+	c := &feeCandidate{
+		txBz:      txBz,
+		size:      uint64(len(txBz)),
+		feePerGas: sdkmath.LegacyZeroDec(),
+	}
+	if memTx != nil {
+		if gasTx, ok := memTx.(GasTx); ok {
+			c.gas = gasTx.GetGas()
+		}
+		c.feePerGas = txFeePerGas(memTx, ts.gasPrices)
+	}
+	if ts.lanes != nil {
+		c.lane = ts.lanes.unreservedLane()
+		if memTx != nil {
+			c.lane = ts.lanes.laneOf(memTx)
+		}
+	}
+
+	// a transaction that can never fit in the block is not a candidate
+	if c.size <= maxTxBytes && (maxBlockGas == 0 || c.gas <= maxBlockGas) {
+		idx := len(ts.candidates)
+
+		if memTx != nil {
+			signers, err := ts.signerExtAdapter.GetSigners(memTx)
+			if err != nil {
+				return ts.isFull()
+			}
+
+			seen := make(map[int]bool)
+			for _, signer := range signers {
+				sender := signer.Signer.String()
+				if parent, ok := ts.lastBySigner[sender]; ok && !seen[parent] {
+					seen[parent] = true
+					c.parents++
+					ts.candidates[parent].children = append(ts.candidates[parent].children, idx)
+				}
+				ts.lastBySigner[sender] = idx
+			}
+		}
+
+		ts.candidates = append(ts.candidates, c)
+		ts.totalTxBytes += c.size
+		ts.totalTxGas += c.gas
+		ts.selectedTxs = nil
+	}
+
+	return ts.isFull()
+}
+
+func (ts *feeTxSelector) candidatesLen() int {
+	return len(ts.candidates)
+}
+
+// isFull returns true once enough candidates were collected.
+func (ts *feeTxSelector) isFull() bool {
+	return ts.totalTxBytes >= feeTxSelectorLookahead*ts.maxTxBytes ||
+		(ts.maxBlockGas > 0 && ts.totalTxGas >= feeTxSelectorLookahead*ts.maxBlockGas)
+}
+
+// selectTxs greedily fills the block with the candidates whose parents were
+// all selected, highest fee per gas first, within the limits of their lanes.
+func (ts *feeTxSelector) selectTxs() [][]byte {
+	if ts.lanes != nil {
+		ts.lanes.reset()
+	}
+
+	parents := make([]int, len(ts.candidates))
+	ready := &feeCandidateHeap{candidates: ts.candidates}
+	for i, c := range ts.candidates {
+		parents[i] = c.parents
+		if c.parents == 0 {
+			ready.indexes = append(ready.indexes, i)
+		}
+	}
+	heap.Init(ready)
+
+	var (
+		totalTxBytes uint64
+		totalTxGas   uint64
+		selected     = [][]byte{}
+	)
+	for ready.Len() > 0 {
+		c := ts.candidates[heap.Pop(ready).(int)]
+		if totalTxBytes+c.size > ts.maxTxBytes || (ts.maxBlockGas > 0 && totalTxGas+c.gas > ts.maxBlockGas) ||
+			(ts.lanes != nil && !ts.lanes.fits(c.lane, c.size, c.gas)) {
+			// the children of a skipped candidate can never be selected
+			continue
+		}
+
+		totalTxBytes += c.size
+		totalTxGas += c.gas
+		if ts.lanes != nil {
+			ts.lanes.add(c.lane, c.size, c.gas)
+		}
+		selected = append(selected, c.txBz)
+
+		for _, child := range c.children {
+			parents[child]--
+			if parents[child] == 0 {
+				heap.Push(ready, child)
+			}
+		}
+	}
+
+	return selected
+}
+
+// txFeePerGas returns the fee of the transaction per unit of gas, in multiples
+// of gasPrices. Each fee coin is converted with the gas price of its denom, fee
+// coins without a gas price are ignored. It returns zero if the transaction
+// does not pay fees.
+func txFeePerGas(tx sdk.Tx, gasPrices sdk.DecCoins) sdkmath.LegacyDec {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return sdkmath.LegacyZeroDec()
+	}
+
+	gas := sdkmath.NewIntFromUint64(feeTx.GetGas())
+	if gas.IsZero() {
+		gas = sdkmath.OneInt()
+	}
+
+	fee := sdkmath.LegacyZeroDec()
+	for _, coin := range feeTx.GetFee() {
+		price := gasPrices.AmountOf(coin.Denom)
+		if !price.IsPositive() {
+			continue
+		}
+		fee = fee.Add(sdkmath.LegacyNewDecFromInt(coin.Amount).Quo(price))
+	}
+
+	return fee.QuoInt(gas)
+}
+
+// feeCandidateHeap orders candidate indexes by fee per gas, highest first,
+// falling back to mempool iteration order.
+type feeCandidateHeap struct {
+	candidates []*feeCandidate
+	indexes    []int
+}
+
+func (h *feeCandidateHeap) Len() int { return len(h.indexes) }
+
+func (h *feeCandidateHeap) Less(i, j int) bool {
+	a, b := h.candidates[h.indexes[i]], h.candidates[h.indexes[j]]
+	if !a.feePerGas.Equal(b.feePerGas) {
+		return a.feePerGas.GT(b.feePerGas)
+	}
+	return h.indexes[i] < h.indexes[j]
+}
+
+func (h *feeCandidateHeap) Swap(i, j int) { h.indexes[i], h.indexes[j] = h.indexes[j], h.indexes[i] }
+
+func (h *feeCandidateHeap) Push(x any) { h.indexes = append(h.indexes, x.(int)) }
+
+func (h *feeCandidateHeap) Pop() any {
+	n := len(h.indexes)
+	x := h.indexes[n-1]
+	h.indexes = h.indexes[:n-1]
+	return x
+}
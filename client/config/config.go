@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 )
 
 func DefaultConfig() *ClientConfig {
@@ -19,11 +20,14 @@ func DefaultConfig() *ClientConfig {
 }
 
 type ClientConfig struct {
-	ChainID        string `mapstructure:"chain-id" json:"chain-id"`
-	KeyringBackend string `mapstructure:"keyring-backend" json:"keyring-backend"`
-	Output         string `mapstructure:"output" json:"output"`
-	Node           string `mapstructure:"node" json:"node"`
-	BroadcastMode  string `mapstructure:"broadcast-mode" json:"broadcast-mode"`
+	ChainID             string `mapstructure:"chain-id" json:"chain-id"`
+	KeyringBackend      string `mapstructure:"keyring-backend" json:"keyring-backend"`
+	RemoteSignerTLSCert string `mapstructure:"remote-signer-tls-cert" json:"remote-signer-tls-cert"`
+	RemoteSignerTLSKey  string `mapstructure:"remote-signer-tls-key" json:"remote-signer-tls-key"`
+	RemoteSignerTLSCA   string `mapstructure:"remote-signer-tls-ca" json:"remote-signer-tls-ca"`
+	Output              string `mapstructure:"output" json:"output"`
+	Node                string `mapstructure:"node" json:"node"`
+	BroadcastMode       string `mapstructure:"broadcast-mode" json:"broadcast-mode"`
 }
 
 func (c *ClientConfig) SetChainID(chainID string) {
@@ -96,12 +100,27 @@ func ReadFromClientConfig(ctx client.Context) (client.Context, error) {
 		WithChainID(conf.ChainID).
 		WithKeyringDir(ctx.HomeDir)
 
-	keyring, err := client.NewKeyringFromBackend(ctx, conf.KeyringBackend)
+	if conf.RemoteSignerTLSCert != "" || conf.RemoteSignerTLSKey != "" || conf.RemoteSignerTLSCA != "" {
+		tlsConfig, err := keyring.NewRemoteSignerClientTLSConfig(
+			configFile(ctx.HomeDir, conf.RemoteSignerTLSCert),
+			configFile(ctx.HomeDir, conf.RemoteSignerTLSKey),
+			configFile(ctx.HomeDir, conf.RemoteSignerTLSCA),
+		)
+		if err != nil {
+			return ctx, fmt.Errorf("couldn't load remote signer TLS config: %w", err)
+		}
+
+		ctx = ctx.WithKeyringOptions(append(ctx.KeyringOptions, func(options *keyring.Options) {
+			options.RemoteSignerTLS = tlsConfig
+		})...)
+	}
+
+	kr, err := client.NewKeyringFromBackend(ctx, conf.KeyringBackend)
 	if err != nil {
 		return ctx, fmt.Errorf("couldn't get keyring: %w", err)
 	}
 
-	ctx = ctx.WithKeyring(keyring)
+	ctx = ctx.WithKeyring(kr)
 
 	// https://github.com/cosmos/cosmos-sdk/issues/8986
 	client, err := client.NewClientFromNode(conf.Node)
@@ -115,3 +134,13 @@ func ReadFromClientConfig(ctx client.Context) (client.Context, error) {
 
 	return ctx, nil
 }
+
+// configFile returns the path of a file of the client configuration, relative
+// to the home directory unless absolute.
+func configFile(homeDir, file string) string {
+	if file == "" || filepath.IsAbs(file) {
+		return file
+	}
+
+	return filepath.Join(homeDir, file)
+}
@@ -17,8 +17,15 @@ const defaultConfigTemplate = `# This is a TOML config file.
 
 # The network chain ID
 chain-id = "{{ .ChainID }}"
-# The keyring's backend, where the keys are stored (os|file|kwallet|pass|test|memory)
+# The keyring's backend, where the keys are stored (os|file|kwallet|pass|test|memory|remote)
 keyring-backend = "{{ .KeyringBackend }}"
+# The TLS certificate and key authenticating to the remote signers of the remote keys,
+# the connections to the remote signers being insecure if none of the remote-signer-tls
+# options is set
+remote-signer-tls-cert = "{{ .RemoteSignerTLSCert }}"
+remote-signer-tls-key = "{{ .RemoteSignerTLSKey }}"
+# The certificate authorities of the remote signers, the system ones if empty
+remote-signer-tls-ca = "{{ .RemoteSignerTLSCA }}"
 # CLI output format (text|json)
 output = "{{ .Output }}"
 # <host>:<port> to CometBFT RPC interface for this chain
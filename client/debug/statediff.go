@@ -0,0 +1,295 @@
+package debug
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/listeners"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagFrom  = "from"
+	flagTo    = "to"
+	flagStore = "store"
+)
+
+// Changes of the keys between two heights, see KVDiff.
+const (
+	ChangeAdded   = "added"
+	ChangeRemoved = "removed"
+	ChangeChanged = "changed"
+)
+
+// KVDiff is a key added, removed or changed in a store between two heights.
+type KVDiff struct {
+	// Change is either ChangeAdded, ChangeRemoved or ChangeChanged.
+	Change string `json:"change"`
+	// From is the key at the first height, unless added.
+	From *listeners.DecodedKVPair `json:"from,omitempty"`
+	// To is the key at the second height, unless removed.
+	To *listeners.DecodedKVPair `json:"to,omitempty"`
+}
+
+// StateDiffCmd returns a command printing the keys added, removed and changed
+// in the stores of the application between two heights.
+func StateDiffCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-diff",
+		Short: "Print the keys added, removed and changed in the stores between two heights",
+		Long: `Print the keys added, removed and changed in the IAVL stores of the application between two
+heights, e.g. to review the changes of an upgrade migration. Both heights must not be pruned.
+
+The keys and values are decoded through the collections.Schema of the modules if the application
+provides a collection decoder, and printed in hex otherwise.`,
+		Example: fmt.Sprintf("$ %s debug state-diff --from 100 --to 101 --store bank --store staking", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := server.GetServerContextFromCmd(cmd)
+
+			from, _ := cmd.Flags().GetInt64(flagFrom)
+			to, _ := cmd.Flags().GetInt64(flagTo)
+			if from <= 0 || to <= 0 {
+				return fmt.Errorf("--%s and --%s must be positive heights", flagFrom, flagTo)
+			}
+			storeNames, _ := cmd.Flags().GetStringSlice(flagStore)
+			output, _ := cmd.Flags().GetString(flags.FlagOutput)
+			if output != flags.OutputFormatText && output != flags.OutputFormatJSON {
+				return fmt.Errorf("unsupported output format %q", output)
+			}
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(ctx.Viper), filepath.Join(ctx.Config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			app := appCreator(log.NewNopLogger(), db, nil, ctx.Viper)
+			cms, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("cannot diff the state of a %T multistore", app.CommitMultiStore())
+			}
+			var decoder *listeners.CollectionDecoder
+			if decoderApp, ok := app.(servertypes.CollectionDecoderApp); ok {
+				decoder = decoderApp.CollectionDecoder()
+			}
+
+			fromStores, err := loadStoresByName(cms, from)
+			if err != nil {
+				return err
+			}
+			toStores, err := loadStoresByName(cms, to)
+			if err != nil {
+				return err
+			}
+
+			if len(storeNames) == 0 {
+				for name := range fromStores {
+					storeNames = append(storeNames, name)
+				}
+				for name := range toStores {
+					if _, ok := fromStores[name]; !ok {
+						storeNames = append(storeNames, name)
+					}
+				}
+				sort.Strings(storeNames)
+			}
+
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			for _, name := range storeNames {
+				fromStore, toStore := fromStores[name], toStores[name]
+				if fromStore == nil && toStore == nil {
+					return fmt.Errorf("no IAVL store %s at heights %d and %d", name, from, to)
+				}
+
+				err := diffStores(fromStore, toStore, func(key, fromValue, toValue []byte) error {
+					diff := newKVDiff(decoder, name, key, fromValue, toValue)
+					if output == flags.OutputFormatJSON {
+						return enc.Encode(diff)
+					}
+					cmd.Println(diff.String())
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagFrom, 0, "First height")
+	cmd.Flags().Int64(flagTo, 0, "Second height")
+	cmd.Flags().StringSlice(flagStore, nil, "Names of the stores to diff, all the IAVL stores if not set")
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatText, "Output format (text|json)")
+
+	return cmd
+}
+
+// loadStoresByName loads the IAVL stores of a version, by store name.
+func loadStoresByName(cms *rootmulti.Store, version int64) (map[string]storetypes.KVStore, error) {
+	stores, err := cms.LoadImmutableStores(version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load height %d: %w", version, err)
+	}
+
+	byName := make(map[string]storetypes.KVStore, len(stores))
+	for key, store := range stores {
+		if store.GetStoreType() == storetypes.StoreTypeIAVL {
+			byName[key.Name()] = store
+		}
+	}
+	return byName, nil
+}
+
+// diffStores calls fn with the keys added, removed and changed between two
+// stores, in key order, fromValue being nil for the keys added and toValue for
+// the keys removed. A nil store has no keys.
+func diffStores(from, to storetypes.KVStore, fn func(key, fromValue, toValue []byte) error) error {
+	fromIter, err := newStoreIterator(from)
+	if err != nil {
+		return err
+	}
+	defer fromIter.Close()
+	toIter, err := newStoreIterator(to)
+	if err != nil {
+		return err
+	}
+	defer toIter.Close()
+
+	for fromIter.Valid() || toIter.Valid() {
+		var cmp int
+		switch {
+		case !fromIter.Valid():
+			cmp = 1
+		case !toIter.Valid():
+			cmp = -1
+		default:
+			cmp = bytes.Compare(fromIter.Key(), toIter.Key())
+		}
+
+		switch {
+		case cmp < 0:
+			if err := fn(fromIter.Key(), fromIter.Value(), nil); err != nil {
+				return err
+			}
+			fromIter.Next()
+		case cmp > 0:
+			if err := fn(toIter.Key(), nil, toIter.Value()); err != nil {
+				return err
+			}
+			toIter.Next()
+		default:
+			if !bytes.Equal(fromIter.Value(), toIter.Value()) {
+				if err := fn(toIter.Key(), fromIter.Value(), toIter.Value()); err != nil {
+					return err
+				}
+			}
+			fromIter.Next()
+			toIter.Next()
+		}
+	}
+
+	if err := fromIter.Error(); err != nil {
+		return err
+	}
+	return toIter.Error()
+}
+
+func newStoreIterator(store storetypes.KVStore) (storetypes.Iterator, error) {
+	if store == nil {
+		return dbm.NewMemDB().Iterator(nil, nil)
+	}
+	return store.Iterator(nil, nil), nil
+}
+
+// newKVDiff returns the KVDiff of a key, decoded by decoder if not nil. The
+// values failing to decode are kept raw.
+func newKVDiff(decoder *listeners.CollectionDecoder, storeKey string, key, fromValue, toValue []byte) *KVDiff {
+	decode := func(value []byte) *listeners.DecodedKVPair {
+		if value == nil {
+			return nil
+		}
+		if decoder != nil {
+			// the values written before a migration may not decode with the current schema
+			if decoded, err := decoder.Decode(&storetypes.StoreKVPair{StoreKey: storeKey, Key: key, Value: value}); err == nil {
+				return decoded
+			}
+		}
+		return &listeners.DecodedKVPair{StoreKey: storeKey, RawKey: key, RawValue: value}
+	}
+
+	diff := &KVDiff{Change: ChangeChanged}
+	switch {
+	case fromValue == nil:
+		diff.Change = ChangeAdded
+	case toValue == nil:
+		diff.Change = ChangeRemoved
+	}
+
+	diff.From, diff.To = decode(fromValue), decode(toValue)
+	return diff
+}
+
+// String implements fmt.Stringer, printing a line such as
+//
+//	~ bank/balances ["cosmos1...","stake"]: "100" -> "200"
+func (d KVDiff) String() string {
+	pair := d.To
+	if pair == nil {
+		pair = d.From
+	}
+
+	var buf bytes.Buffer
+	switch d.Change {
+	case ChangeAdded:
+		buf.WriteString("+ ")
+	case ChangeRemoved:
+		buf.WriteString("- ")
+	default:
+		buf.WriteString("~ ")
+	}
+
+	buf.WriteString(pair.StoreKey)
+	if pair.Collection != "" {
+		fmt.Fprintf(&buf, "/%s", pair.Collection)
+	}
+	if pair.Key != nil {
+		fmt.Fprintf(&buf, " %s", pair.Key)
+	} else {
+		fmt.Fprintf(&buf, " %X", pair.RawKey)
+	}
+
+	buf.WriteString(": ")
+	switch d.Change {
+	case ChangeAdded:
+		buf.WriteString(formatValue(d.To))
+	case ChangeRemoved:
+		buf.WriteString(formatValue(d.From))
+	default:
+		fmt.Fprintf(&buf, "%s -> %s", formatValue(d.From), formatValue(d.To))
+	}
+
+	return buf.String()
+}
+
+func formatValue(pair *listeners.DecodedKVPair) string {
+	if pair.Value != nil {
+		return string(pair.Value)
+	}
+	return fmt.Sprintf("%X", pair.RawValue)
+}
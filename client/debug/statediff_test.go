@@ -0,0 +1,91 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/store/dbadapter"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/listeners"
+)
+
+func newMemStore(kvs ...string) storetypes.KVStore {
+	s := dbadapter.Store{DB: dbm.NewMemDB()}
+	for i := 0; i < len(kvs); i += 2 {
+		s.Set([]byte(kvs[i]), []byte(kvs[i+1]))
+	}
+	return s
+}
+
+func TestDiffStores(t *testing.T) {
+	type change struct{ key, from, to string }
+	collect := func(from, to storetypes.KVStore) []change {
+		var changes []change
+		require.NoError(t, diffStores(from, to, func(key, fromValue, toValue []byte) error {
+			changes = append(changes, change{string(key), string(fromValue), string(toValue)})
+			return nil
+		}))
+		return changes
+	}
+
+	from := newMemStore("a", "1", "b", "2", "c", "3", "e", "5")
+	to := newMemStore("b", "2", "c", "4", "d", "4", "f", "6")
+	require.Equal(t, []change{
+		{"a", "1", ""},
+		{"c", "3", "4"},
+		{"d", "", "4"},
+		{"e", "5", ""},
+		{"f", "", "6"},
+	}, collect(from, to))
+
+	// a store missing at a height has no keys
+	require.Equal(t, []change{{"a", "", "1"}, {"b", "", "2"}, {"c", "", "3"}, {"e", "", "5"}}, collect(nil, from))
+	require.Equal(t, []change{{"b", "2", ""}, {"c", "4", ""}, {"d", "4", ""}, {"f", "6", ""}}, collect(to, nil))
+	require.Empty(t, collect(from, from))
+}
+
+func TestKVDiff(t *testing.T) {
+	sb := collections.NewSchemaBuilderFromAccessor(func(context.Context) store.KVStore { return nil })
+	balances := collections.NewMap(sb, collections.NewPrefix(2), "balances", collections.StringKey, collections.Uint64Value)
+	schema, err := sb.Build()
+	require.NoError(t, err)
+	decoder := listeners.NewCollectionDecoder(map[string]collections.Schema{"bank": schema})
+	require.NoError(t, listeners.RegisterKeyCodec(decoder, "bank", "balances", balances.KeyCodec()))
+
+	key, err := collections.EncodeKeyWithPrefix(balances.GetPrefix(), balances.KeyCodec(), "alice")
+	require.NoError(t, err)
+	encode := func(v uint64) []byte {
+		bz, err := collections.Uint64Value.Encode(v)
+		require.NoError(t, err)
+		return bz
+	}
+
+	diff := newKVDiff(decoder, "bank", key, encode(100), encode(200))
+	require.Equal(t, ChangeChanged, diff.Change)
+	require.Equal(t, `~ bank/balances "alice": "100" -> "200"`, diff.String())
+
+	diff = newKVDiff(decoder, "bank", key, nil, encode(100))
+	require.Equal(t, ChangeAdded, diff.Change)
+	require.Nil(t, diff.From)
+	require.Equal(t, `+ bank/balances "alice": "100"`, diff.String())
+
+	diff = newKVDiff(decoder, "bank", key, encode(100), nil)
+	require.Equal(t, ChangeRemoved, diff.Change)
+	require.Nil(t, diff.To)
+	require.Equal(t, `- bank/balances "alice": "100"`, diff.String())
+
+	// values failing to decode are kept raw
+	diff = newKVDiff(decoder, "bank", key, []byte{1}, encode(100))
+	require.Equal(t, []byte{1}, diff.From.RawValue)
+	require.Equal(t, `~ bank/balances "alice": 01 -> "100"`, diff.String())
+
+	// without a decoder, keys and values are printed in hex
+	diff = newKVDiff(nil, "bank", []byte{2, 3}, nil, []byte{4})
+	require.Equal(t, `+ bank 0203: 04`, diff.String())
+}
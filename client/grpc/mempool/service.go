@@ -0,0 +1,195 @@
+package mempool
+
+import (
+	context "context"
+	"fmt"
+	"sort"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// RegisterMempoolService registers the mempool gRPC service on the provided gRPC router.
+func RegisterMempoolService(server gogogrpc.Server, mp sdkmempool.Mempool, txEncoder sdk.TxEncoder) {
+	RegisterQueryServer(server, NewQueryServer(mp, txEncoder))
+}
+
+// RegisterGRPCGatewayRoutes mounts the mempool gRPC service's GRPC-gateway routes
+// on the given mux object.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterQueryHandlerClient(context.Background(), mux, NewQueryClient(clientConn))
+}
+
+var _ QueryServer = queryServer{}
+
+type queryServer struct {
+	mempool   sdkmempool.Mempool
+	txEncoder sdk.TxEncoder
+}
+
+// NewQueryServer returns a mempool QueryServer inspecting the given mempool. The
+// queries return codes.Unimplemented unless the mempool implements
+// InspectableMempool.
+func NewQueryServer(mp sdkmempool.Mempool, txEncoder sdk.TxEncoder) QueryServer {
+	return queryServer{
+		mempool:   mp,
+		txEncoder: txEncoder,
+	}
+}
+
+// Stats returns the number of transactions in the mempool, per sender, per lane
+// and per priority. Senders are sorted, lanes and priorities are listed in
+// mempool order.
+func (s queryServer) Stats(_ context.Context, req *QueryStatsRequest) (*QueryStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	mp, err := s.inspectableMempool()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		res        = &QueryStatsResponse{}
+		senders    = make(map[string]uint64)
+		lanes      = make(map[string]int)
+		priorities = make(map[string]int)
+	)
+	mp.InspectTxs(func(info sdkmempool.TxInfo) bool {
+		res.Total++
+		senders[info.Sender]++
+
+		if info.Lane != "" {
+			i, ok := lanes[info.Lane]
+			if !ok {
+				i = len(res.Lanes)
+				lanes[info.Lane] = i
+				res.Lanes = append(res.Lanes, LaneStats{Lane: info.Lane})
+			}
+			res.Lanes[i].Count++
+		}
+
+		if info.Priority != "" {
+			i, ok := priorities[info.Priority]
+			if !ok {
+				i = len(res.Priorities)
+				priorities[info.Priority] = i
+				res.Priorities = append(res.Priorities, PriorityStats{Priority: info.Priority})
+			}
+			res.Priorities[i].Count++
+		}
+
+		return false
+	})
+
+	res.Senders = make([]SenderStats, 0, len(senders))
+	for sender, count := range senders {
+		res.Senders = append(res.Senders, SenderStats{Sender: sender, Count: count})
+	}
+	sort.Slice(res.Senders, func(i, j int) bool {
+		return res.Senders[i].Sender < res.Senders[j].Sender
+	})
+
+	return res, nil
+}
+
+// SenderNonces returns the nonces of the sender's transactions in the mempool,
+// in ascending order.
+func (s queryServer) SenderNonces(_ context.Context, req *QuerySenderNoncesRequest) (*QuerySenderNoncesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Sender == "" {
+		return nil, status.Error(codes.InvalidArgument, "sender cannot be empty")
+	}
+
+	mp, err := s.inspectableMempool()
+	if err != nil {
+		return nil, err
+	}
+
+	nonces := []uint64{}
+	mp.InspectTxs(func(info sdkmempool.TxInfo) bool {
+		if info.Sender == req.Sender {
+			nonces = append(nonces, info.Nonce)
+		}
+		return false
+	})
+	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+
+	return &QuerySenderNoncesResponse{Nonces: nonces}, nil
+}
+
+// TxHashes returns the hashes of the transactions in the mempool, in mempool
+// order. Only offset based pagination is supported.
+func (s queryServer) TxHashes(_ context.Context, req *QueryTxHashesRequest) (*QueryTxHashesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var (
+		offset     uint64
+		limit      = uint64(query.DefaultLimit)
+		countTotal bool
+	)
+	if p := req.Pagination; p != nil {
+		if len(p.Key) != 0 {
+			return nil, status.Error(codes.InvalidArgument, "key pagination is not supported, use offset instead")
+		}
+		offset, countTotal = p.Offset, p.CountTotal
+		if p.Limit > 0 {
+			limit = p.Limit
+		}
+	}
+
+	mp, err := s.inspectableMempool()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		txs   []sdk.Tx
+		total uint64
+	)
+	mp.InspectTxs(func(info sdkmempool.TxInfo) bool {
+		if total >= offset && total-offset < limit {
+			txs = append(txs, info.Tx)
+		}
+		total++
+
+		return !countTotal && total >= offset+limit
+	})
+
+	hashes := make([]string, len(txs))
+	for i, tx := range txs {
+		bz, err := s.txEncoder(tx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		hashes[i] = fmt.Sprintf("%X", cmttypes.Tx(bz).Hash())
+	}
+
+	pageRes := &query.PageResponse{}
+	if countTotal {
+		pageRes.Total = total
+	}
+
+	return &QueryTxHashesResponse{Hashes: hashes, Pagination: pageRes}, nil
+}
+
+func (s queryServer) inspectableMempool() (sdkmempool.InspectableMempool, error) {
+	mp, ok := s.mempool.(sdkmempool.InspectableMempool)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "mempool does not support inspection")
+	}
+
+	return mp, nil
+}
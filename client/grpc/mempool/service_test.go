@@ -0,0 +1,142 @@
+package mempool
+
+import (
+	"fmt"
+	"testing"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protov2 "google.golang.org/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+type testTx struct {
+	id     int
+	sender sdk.AccAddress
+	nonce  uint64
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg { return nil }
+
+func (tx testTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+type testSignerExtractor struct{}
+
+func (testSignerExtractor) GetSigners(tx sdk.Tx) ([]sdkmempool.SignerData, error) {
+	t := tx.(testTx)
+	return []sdkmempool.SignerData{sdkmempool.NewSignerData(t.sender, t.nonce)}, nil
+}
+
+func testTxEncoder(tx sdk.Tx) ([]byte, error) {
+	return []byte(fmt.Sprint(tx.(testTx).id)), nil
+}
+
+func testTxHash(id int) string {
+	return fmt.Sprintf("%X", cmttypes.Tx(fmt.Sprint(id)).Hash())
+}
+
+func setupMempool(t *testing.T) (sdkmempool.Mempool, sdk.AccAddress, sdk.AccAddress) {
+	t.Helper()
+
+	cfg := sdkmempool.DefaultPriorityNonceMempoolConfig()
+	cfg.SignerExtractor = testSignerExtractor{}
+	mp := sdkmempool.NewPriorityMempool(cfg)
+
+	alice, bob := sdk.AccAddress("alice"), sdk.AccAddress("bob")
+	txs := []struct {
+		tx       testTx
+		priority int64
+	}{
+		{testTx{id: 0, sender: alice, nonce: 1}, 10},
+		{testTx{id: 1, sender: alice, nonce: 0}, 10},
+		{testTx{id: 2, sender: bob, nonce: 5}, 20},
+		{testTx{id: 3, sender: alice, nonce: 2}, 5},
+	}
+	for _, tx := range txs {
+		ctx := sdk.Context{}.WithPriority(tx.priority)
+		require.NoError(t, mp.Insert(ctx, tx.tx))
+	}
+
+	return mp, alice, bob
+}
+
+func TestQueryServer_Stats(t *testing.T) {
+	mp, alice, bob := setupMempool(t)
+	svr := NewQueryServer(mp, testTxEncoder)
+
+	res, err := svr.Stats(sdk.Context{}, &QueryStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), res.Total)
+	require.Equal(t, []SenderStats{
+		{Sender: alice.String(), Count: 3},
+		{Sender: bob.String(), Count: 1},
+	}, res.Senders)
+	require.Empty(t, res.Lanes)
+	require.Equal(t, []PriorityStats{
+		{Priority: "20", Count: 1},
+		{Priority: "10", Count: 2},
+		{Priority: "5", Count: 1},
+	}, res.Priorities)
+
+	_, err = svr.Stats(sdk.Context{}, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQueryServer_SenderNonces(t *testing.T) {
+	mp, alice, bob := setupMempool(t)
+	svr := NewQueryServer(mp, testTxEncoder)
+
+	res, err := svr.SenderNonces(sdk.Context{}, &QuerySenderNoncesRequest{Sender: alice.String()})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 1, 2}, res.Nonces)
+
+	res, err = svr.SenderNonces(sdk.Context{}, &QuerySenderNoncesRequest{Sender: bob.String()})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5}, res.Nonces)
+
+	res, err = svr.SenderNonces(sdk.Context{}, &QuerySenderNoncesRequest{Sender: sdk.AccAddress("carol").String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Nonces)
+
+	_, err = svr.SenderNonces(sdk.Context{}, &QuerySenderNoncesRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQueryServer_TxHashes(t *testing.T) {
+	mp, _, _ := setupMempool(t)
+	svr := NewQueryServer(mp, testTxEncoder)
+
+	res, err := svr.TxHashes(sdk.Context{}, &QueryTxHashesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{testTxHash(2), testTxHash(0), testTxHash(1), testTxHash(3)}, res.Hashes)
+
+	res, err = svr.TxHashes(sdk.Context{}, &QueryTxHashesRequest{
+		Pagination: &query.PageRequest{Offset: 1, Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{testTxHash(0), testTxHash(1)}, res.Hashes)
+	require.Equal(t, uint64(4), res.Pagination.Total)
+
+	res, err = svr.TxHashes(sdk.Context{}, &QueryTxHashesRequest{
+		Pagination: &query.PageRequest{Offset: 3, Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{testTxHash(3)}, res.Hashes)
+
+	_, err = svr.TxHashes(sdk.Context{}, &QueryTxHashesRequest{
+		Pagination: &query.PageRequest{Key: []byte("key")},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQueryServer_NotInspectable(t *testing.T) {
+	svr := NewQueryServer(sdkmempool.NoOpMempool{}, testTxEncoder)
+
+	_, err := svr.Stats(sdk.Context{}, &QueryStatsRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
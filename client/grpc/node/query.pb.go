@@ -6,6 +6,9 @@ package node
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -253,11 +256,202 @@ func (m *StatusResponse) GetValidatorHash() []byte {
 	return nil
 }
 
+// GasPricesRequest defines the request structure for the GasPrices gRPC query.
+type GasPricesRequest struct {
+	// blocks is the number of recent blocks whose transactions are sampled,
+	// 10 by default and at most 20. At most 100 transactions are sampled per
+	// block.
+	Blocks uint32 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// percentiles are the percentiles, in [0, 100], of the gas prices paid by
+	// the sampled transactions to return, 25, 50 and 75 by default.
+	Percentiles []uint32 `protobuf:"varint,2,rep,packed,name=percentiles,proto3" json:"percentiles,omitempty"`
+}
+
+func (m *GasPricesRequest) Reset()         { *m = GasPricesRequest{} }
+func (m *GasPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*GasPricesRequest) ProtoMessage()    {}
+func (*GasPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{4}
+}
+func (m *GasPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPricesRequest.Merge(m, src)
+}
+func (m *GasPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPricesRequest proto.InternalMessageInfo
+
+func (m *GasPricesRequest) GetBlocks() uint32 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *GasPricesRequest) GetPercentiles() []uint32 {
+	if m != nil {
+		return m.Percentiles
+	}
+	return nil
+}
+
+// GasPricesResponse defines the response structure for the GasPrices gRPC
+// query.
+type GasPricesResponse struct {
+	// minimum_gas_prices are the minimum gas prices of the node, below which its
+	// mempool rejects the transactions.
+	MinimumGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=minimum_gas_prices,json=minimumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"minimum_gas_prices"`
+	// fee_percentiles are the percentiles of the gas prices paid by the sampled
+	// transactions, in the order of the request.
+	FeePercentiles []GasPricePercentile `protobuf:"bytes,2,rep,name=fee_percentiles,json=feePercentiles,proto3" json:"fee_percentiles"`
+	// blocks is the number of blocks sampled.
+	Blocks uint64 `protobuf:"varint,3,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// txs is the number of transactions sampled.
+	Txs uint64 `protobuf:"varint,4,opt,name=txs,proto3" json:"txs,omitempty"`
+}
+
+func (m *GasPricesResponse) Reset()         { *m = GasPricesResponse{} }
+func (m *GasPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*GasPricesResponse) ProtoMessage()    {}
+func (*GasPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{5}
+}
+func (m *GasPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPricesResponse.Merge(m, src)
+}
+func (m *GasPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPricesResponse proto.InternalMessageInfo
+
+func (m *GasPricesResponse) GetMinimumGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinimumGasPrices
+	}
+	return nil
+}
+
+func (m *GasPricesResponse) GetFeePercentiles() []GasPricePercentile {
+	if m != nil {
+		return m.FeePercentiles
+	}
+	return nil
+}
+
+func (m *GasPricesResponse) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *GasPricesResponse) GetTxs() uint64 {
+	if m != nil {
+		return m.Txs
+	}
+	return 0
+}
+
+// GasPricePercentile defines a percentile of the gas prices paid by
+// transactions, per fee denom.
+type GasPricePercentile struct {
+	Percentile uint32                                      `protobuf:"varint,1,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	GasPrices  github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=gas_prices,json=gasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"gas_prices"`
+}
+
+func (m *GasPricePercentile) Reset()         { *m = GasPricePercentile{} }
+func (m *GasPricePercentile) String() string { return proto.CompactTextString(m) }
+func (*GasPricePercentile) ProtoMessage()    {}
+func (*GasPricePercentile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{6}
+}
+func (m *GasPricePercentile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPricePercentile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPricePercentile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPricePercentile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPricePercentile.Merge(m, src)
+}
+func (m *GasPricePercentile) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPricePercentile) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPricePercentile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPricePercentile proto.InternalMessageInfo
+
+func (m *GasPricePercentile) GetPercentile() uint32 {
+	if m != nil {
+		return m.Percentile
+	}
+	return 0
+}
+
+func (m *GasPricePercentile) GetGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.GasPrices
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ConfigRequest)(nil), "cosmos.base.node.v1beta1.ConfigRequest")
 	proto.RegisterType((*ConfigResponse)(nil), "cosmos.base.node.v1beta1.ConfigResponse")
 	proto.RegisterType((*StatusRequest)(nil), "cosmos.base.node.v1beta1.StatusRequest")
 	proto.RegisterType((*StatusResponse)(nil), "cosmos.base.node.v1beta1.StatusResponse")
+	proto.RegisterType((*GasPricesRequest)(nil), "cosmos.base.node.v1beta1.GasPricesRequest")
+	proto.RegisterType((*GasPricesResponse)(nil), "cosmos.base.node.v1beta1.GasPricesResponse")
+	proto.RegisterType((*GasPricePercentile)(nil), "cosmos.base.node.v1beta1.GasPricePercentile")
 }
 
 func init() {
@@ -265,40 +459,55 @@ func init() {
 }
 
 var fileDescriptor_8324226a07064341 = []byte{
-	// 522 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x97, 0x32, 0x3a, 0xe6, 0xb1, 0x8e, 0x79, 0x80, 0x4a, 0x85, 0xd2, 0xaa, 0x02, 0x51,
-	0x90, 0x66, 0x6b, 0xe5, 0xce, 0x61, 0x1c, 0x36, 0xc4, 0x05, 0xa5, 0x9c, 0xb8, 0x44, 0x6e, 0xfa,
-	0x96, 0x58, 0x4b, 0x6c, 0xcf, 0x76, 0x2a, 0x71, 0x45, 0xe2, 0x3e, 0x89, 0x13, 0xdf, 0x84, 0x8f,
-	0xc0, 0x71, 0x12, 0x17, 0x4e, 0x80, 0x5a, 0x3e, 0x08, 0x8a, 0x9d, 0x0c, 0xf5, 0xb0, 0xc1, 0x29,
-	0xf6, 0xff, 0xfd, 0xde, 0xcb, 0x7b, 0xef, 0x6f, 0xf4, 0x28, 0x91, 0xa6, 0x90, 0x86, 0x4e, 0x99,
-	0x01, 0x2a, 0xe4, 0x0c, 0xe8, 0xfc, 0x60, 0x0a, 0x96, 0x1d, 0xd0, 0xb3, 0x12, 0xf4, 0x7b, 0xa2,
-	0xb4, 0xb4, 0x12, 0x77, 0x3d, 0x45, 0x2a, 0x8a, 0x54, 0x14, 0xa9, 0xa9, 0xde, 0xc3, 0x54, 0xca,
-	0x34, 0x07, 0xca, 0x14, 0xa7, 0x4c, 0x08, 0x69, 0x99, 0xe5, 0x52, 0x18, 0x9f, 0xd7, 0xeb, 0xd7,
-	0x51, 0x77, 0x9b, 0x96, 0x27, 0xd4, 0xf2, 0x02, 0x8c, 0x65, 0x85, 0xaa, 0x81, 0xbb, 0xa9, 0x4c,
-	0xa5, 0x3b, 0xd2, 0xea, 0xe4, 0xd5, 0xe1, 0x0e, 0xda, 0x7e, 0x29, 0xc5, 0x09, 0x4f, 0x23, 0x38,
-	0x2b, 0xc1, 0xd8, 0xe1, 0x97, 0x00, 0x75, 0x1a, 0xc5, 0x28, 0x29, 0x0c, 0xe0, 0x67, 0x68, 0xb7,
-	0xe0, 0x82, 0x17, 0x65, 0x11, 0xa7, 0xcc, 0xc4, 0x4a, 0xf3, 0x04, 0xba, 0xc1, 0x20, 0x18, 0x6d,
-	0x46, 0x3b, 0x75, 0xe0, 0x88, 0x99, 0x37, 0x95, 0x8c, 0x09, 0xda, 0x53, 0xba, 0x14, 0x5c, 0xa4,
-	0xf1, 0x29, 0x80, 0x8a, 0x35, 0x24, 0x20, 0x6c, 0xb7, 0xe5, 0xe8, 0xdd, 0x3a, 0xf4, 0x1a, 0x40,
-	0x45, 0x2e, 0x80, 0x9f, 0xa2, 0x3b, 0x0d, 0xcf, 0x85, 0x05, 0x3d, 0x67, 0x79, 0xf7, 0x86, 0x2f,
-	0x5d, 0xeb, 0xaf, 0x6a, 0x19, 0xf7, 0xd1, 0x56, 0xc6, 0x72, 0x1b, 0x67, 0xc0, 0xd3, 0xcc, 0x76,
-	0xd7, 0x07, 0xc1, 0x68, 0x3d, 0x42, 0x95, 0x74, 0xec, 0x94, 0x6a, 0x96, 0x89, 0x65, 0xb6, 0x34,
-	0xcd, 0x2c, 0x3f, 0x02, 0xd4, 0x69, 0x94, 0x7a, 0x96, 0x31, 0xba, 0x07, 0x4c, 0xe7, 0x1c, 0x8c,
-	0x8d, 0x8d, 0x95, 0x1a, 0x9a, 0x72, 0x81, 0x2b, 0xb7, 0xd7, 0x04, 0x27, 0x55, 0xcc, 0xd7, 0xc5,
-	0xf7, 0x51, 0xbb, 0x86, 0x5a, 0x0e, 0xaa, 0x6f, 0xf8, 0x05, 0xda, 0xbc, 0x5c, 0xb2, 0x6b, 0x7a,
-	0x6b, 0xdc, 0x23, 0xde, 0x06, 0xd2, 0xd8, 0x40, 0xde, 0x36, 0xc4, 0xe1, 0xfa, 0xf9, 0xcf, 0x7e,
-	0x10, 0xfd, 0x4d, 0xc1, 0x0f, 0xd0, 0x2d, 0xa6, 0x54, 0x9c, 0x31, 0x93, 0xb9, 0x69, 0x6e, 0x47,
-	0x1b, 0x4c, 0xa9, 0x63, 0x66, 0x32, 0xfc, 0x18, 0x75, 0xe6, 0x2c, 0xe7, 0x33, 0x66, 0xa5, 0xf6,
-	0xc0, 0x4d, 0x07, 0x6c, 0x5f, 0xaa, 0x15, 0x36, 0xfe, 0xdc, 0x42, 0x1b, 0x13, 0xd0, 0xf3, 0x6a,
-	0xf3, 0x1f, 0x03, 0xd4, 0xf6, 0xc6, 0xe1, 0x27, 0xe4, 0xaa, 0x47, 0x44, 0x56, 0xcc, 0xee, 0x8d,
-	0xfe, 0x0d, 0xfa, 0xbd, 0x0d, 0x47, 0x1f, 0xbe, 0xfd, 0xfe, 0xd4, 0x1a, 0xe2, 0x01, 0xbd, 0xf2,
-	0x15, 0x27, 0xfe, 0xe7, 0x55, 0x1f, 0x7e, 0xe9, 0xd7, 0xf5, 0xb1, 0x62, 0xd4, 0x75, 0x7d, 0xac,
-	0xfa, 0xf7, 0x3f, 0x7d, 0x18, 0x97, 0x71, 0x78, 0xf4, 0x75, 0x11, 0x06, 0x17, 0x8b, 0x30, 0xf8,
-	0xb5, 0x08, 0x83, 0xf3, 0x65, 0xb8, 0x76, 0xb1, 0x0c, 0xd7, 0xbe, 0x2f, 0xc3, 0xb5, 0x77, 0xfb,
-	0x29, 0xb7, 0x59, 0x39, 0x25, 0x89, 0x2c, 0x9a, 0x2a, 0xfe, 0xb3, 0x6f, 0x66, 0xa7, 0x34, 0xc9,
-	0x39, 0x08, 0x4b, 0x53, 0xad, 0x12, 0x57, 0x77, 0xda, 0x76, 0x5e, 0x3e, 0xff, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0x0c, 0x93, 0xa1, 0xea, 0xc0, 0x03, 0x00, 0x00,
+	// 757 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x4f, 0xdb, 0x48,
+	0x14, 0x8e, 0x93, 0x2c, 0x6c, 0x26, 0x9b, 0x90, 0x0c, 0xbb, 0xab, 0x6c, 0x84, 0x9c, 0x28, 0x62,
+	0x77, 0xb3, 0xfc, 0xb0, 0x45, 0xf6, 0xb2, 0xa7, 0x3d, 0x84, 0x4a, 0x50, 0xb5, 0x07, 0x64, 0x7a,
+	0x6a, 0x0f, 0xd6, 0xc4, 0xbc, 0x38, 0x23, 0x6c, 0x8f, 0xf1, 0x8c, 0xa3, 0x72, 0xad, 0xda, 0x3b,
+	0x6a, 0xff, 0x82, 0xde, 0xaa, 0x56, 0xaa, 0x7a, 0xec, 0x9f, 0xc0, 0x11, 0xa9, 0x97, 0x9e, 0xa0,
+	0x82, 0x4a, 0xfd, 0x37, 0x2a, 0xdb, 0xe3, 0xc4, 0x80, 0x02, 0x5c, 0x7a, 0x49, 0x66, 0xbe, 0xf7,
+	0xcd, 0xf3, 0xf7, 0xde, 0x7c, 0x6f, 0xd0, 0xb2, 0xc5, 0xb8, 0xcb, 0xb8, 0x3e, 0x20, 0x1c, 0x74,
+	0x8f, 0xed, 0x81, 0x3e, 0xde, 0x18, 0x80, 0x20, 0x1b, 0xfa, 0x41, 0x08, 0xc1, 0xa1, 0xe6, 0x07,
+	0x4c, 0x30, 0xdc, 0x48, 0x58, 0x5a, 0xc4, 0xd2, 0x22, 0x96, 0x26, 0x59, 0xcd, 0x25, 0x9b, 0x31,
+	0xdb, 0x01, 0x9d, 0xf8, 0x54, 0x27, 0x9e, 0xc7, 0x04, 0x11, 0x94, 0x79, 0x3c, 0x39, 0xd7, 0x6c,
+	0xc9, 0x68, 0xbc, 0x1b, 0x84, 0x43, 0x5d, 0x50, 0x17, 0xb8, 0x20, 0xae, 0x2f, 0x09, 0xbf, 0xda,
+	0xcc, 0x66, 0xf1, 0x52, 0x8f, 0x56, 0x12, 0x55, 0xb3, 0xa2, 0x52, 0x3d, 0x16, 0xa3, 0x9e, 0x8c,
+	0xd7, 0x89, 0x4b, 0x3d, 0xa6, 0xc7, 0xbf, 0x09, 0xd4, 0x59, 0x40, 0x95, 0x4d, 0xe6, 0x0d, 0xa9,
+	0x6d, 0xc0, 0x41, 0x08, 0x5c, 0x74, 0x3e, 0x2a, 0xa8, 0x9a, 0x22, 0xdc, 0x67, 0x1e, 0x07, 0xbc,
+	0x82, 0xea, 0x2e, 0xf5, 0xa8, 0x1b, 0xba, 0xa6, 0x4d, 0xb8, 0xe9, 0x07, 0xd4, 0x82, 0x86, 0xd2,
+	0x56, 0xba, 0x25, 0x63, 0x41, 0x06, 0xb6, 0x08, 0xdf, 0x89, 0x60, 0xac, 0xa1, 0x45, 0x3f, 0x08,
+	0x3d, 0xea, 0xd9, 0xe6, 0x3e, 0x80, 0x6f, 0x06, 0x60, 0x81, 0x27, 0x1a, 0xf9, 0x98, 0x5d, 0x97,
+	0xa1, 0x07, 0x00, 0xbe, 0x11, 0x07, 0xf0, 0x3f, 0xa8, 0x96, 0xf2, 0xa9, 0x27, 0x20, 0x18, 0x13,
+	0xa7, 0x51, 0x48, 0x52, 0x4b, 0xfc, 0xbe, 0x84, 0x71, 0x0b, 0x95, 0x47, 0xc4, 0x11, 0xe6, 0x08,
+	0xa8, 0x3d, 0x12, 0x8d, 0x62, 0x5b, 0xe9, 0x16, 0x0d, 0x14, 0x41, 0xdb, 0x31, 0x12, 0xd5, 0xb2,
+	0x2b, 0x88, 0x08, 0x79, 0x5a, 0xcb, 0xa9, 0x82, 0xaa, 0x29, 0x22, 0x6b, 0xe9, 0xa1, 0xdf, 0x80,
+	0x04, 0x0e, 0x05, 0x2e, 0x4c, 0x2e, 0x58, 0x00, 0x69, 0x3a, 0x25, 0x4e, 0xb7, 0x98, 0x06, 0x77,
+	0xa3, 0x58, 0x92, 0x17, 0xff, 0x8e, 0xe6, 0x24, 0x29, 0x1f, 0x93, 0xe4, 0x0e, 0xff, 0x8f, 0x4a,
+	0x93, 0x7b, 0x89, 0x45, 0x97, 0x7b, 0x4d, 0x2d, 0xb9, 0x39, 0x2d, 0xbd, 0x39, 0xed, 0x51, 0xca,
+	0xe8, 0x17, 0x8f, 0xce, 0x5a, 0x8a, 0x31, 0x3d, 0x82, 0xff, 0x40, 0x3f, 0x13, 0xdf, 0x37, 0x47,
+	0x84, 0x8f, 0xe2, 0x6a, 0x7e, 0x31, 0xe6, 0x89, 0xef, 0x6f, 0x13, 0x3e, 0xc2, 0x7f, 0xa2, 0xea,
+	0x98, 0x38, 0x74, 0x8f, 0x08, 0x16, 0x24, 0x84, 0x9f, 0x62, 0x42, 0x65, 0x82, 0x46, 0xb4, 0xce,
+	0x43, 0x54, 0x4b, 0x3b, 0x9f, 0x16, 0x1d, 0xa9, 0x1d, 0x38, 0xcc, 0xda, 0xe7, 0x71, 0x49, 0x15,
+	0x43, 0xee, 0x70, 0x1b, 0x95, 0x7d, 0x08, 0xa2, 0xa6, 0x53, 0x07, 0x78, 0x23, 0xdf, 0x2e, 0x74,
+	0x2b, 0x46, 0x16, 0xea, 0xbc, 0xce, 0xa3, 0x7a, 0x26, 0x9d, 0xec, 0xd8, 0x73, 0x05, 0xe1, 0x6b,
+	0xd7, 0x1f, 0x25, 0x2f, 0x74, 0xcb, 0xbd, 0x25, 0x2d, 0xeb, 0x70, 0x69, 0x39, 0xed, 0x1e, 0x58,
+	0x9b, 0x8c, 0x7a, 0xfd, 0xff, 0x8e, 0x4f, 0x5b, 0xb9, 0xb7, 0x67, 0xad, 0x55, 0x9b, 0x8a, 0x51,
+	0x38, 0xd0, 0x2c, 0xe6, 0xea, 0xd2, 0xa2, 0xc9, 0xdf, 0x3a, 0xdf, 0xdb, 0xd7, 0xc5, 0xa1, 0x0f,
+	0x3c, 0x3d, 0xc3, 0xdf, 0x7c, 0xfb, 0xb0, 0xa2, 0x18, 0xb5, 0x2b, 0xbe, 0xe2, 0xf8, 0x09, 0x5a,
+	0x18, 0x02, 0x98, 0x57, 0x4b, 0x28, 0xf7, 0xd6, 0xb4, 0x59, 0x43, 0xa6, 0xa5, 0xa7, 0x77, 0x26,
+	0x87, 0xfa, 0xc5, 0x48, 0x92, 0x51, 0x1d, 0x42, 0x06, 0xe4, 0x99, 0x9e, 0x15, 0x92, 0x1b, 0x96,
+	0x3d, 0xab, 0xa1, 0x82, 0x78, 0xca, 0xa5, 0xd5, 0xa2, 0x65, 0xe7, 0x9d, 0x82, 0xf0, 0xf5, 0xb4,
+	0x58, 0x45, 0x68, 0xaa, 0x4c, 0x36, 0x3e, 0x83, 0xe0, 0x10, 0xa1, 0x4c, 0xef, 0xf2, 0x3f, 0xb4,
+	0x77, 0x25, 0x3b, 0x6d, 0x5a, 0xef, 0x7d, 0x01, 0xcd, 0xef, 0x42, 0x30, 0x8e, 0x26, 0xf3, 0x85,
+	0x82, 0xe6, 0x92, 0xc1, 0xc6, 0x7f, 0xcf, 0x6e, 0xd9, 0xa5, 0xc7, 0xa0, 0xd9, 0xbd, 0x9d, 0x98,
+	0xb8, 0xa4, 0xd3, 0x7d, 0xf6, 0xe9, 0xeb, 0xab, 0x7c, 0x07, 0xb7, 0xf5, 0x99, 0x0f, 0xa3, 0x95,
+	0x7c, 0x3c, 0xd2, 0x91, 0x0c, 0xe5, 0x4d, 0x3a, 0x2e, 0x0d, 0x72, 0xb3, 0x7b, 0x3b, 0xf1, 0xee,
+	0x3a, 0x78, 0xf2, 0xf1, 0x97, 0x0a, 0x2a, 0x4d, 0xed, 0xb5, 0x72, 0xbb, 0x8b, 0x26, 0x6a, 0x56,
+	0xef, 0xc4, 0x95, 0x82, 0xd6, 0x62, 0x41, 0x7f, 0xe1, 0xe5, 0xd9, 0x82, 0xa6, 0xce, 0xe8, 0x6f,
+	0x1d, 0x9f, 0xab, 0xca, 0xc9, 0xb9, 0xaa, 0x7c, 0x39, 0x57, 0x95, 0xa3, 0x0b, 0x35, 0x77, 0x72,
+	0xa1, 0xe6, 0x3e, 0x5f, 0xa8, 0xb9, 0xc7, 0xeb, 0x37, 0xfa, 0xc0, 0x72, 0x28, 0x78, 0x42, 0xb7,
+	0x03, 0xdf, 0x8a, 0x73, 0x0f, 0xe6, 0xe2, 0x07, 0xe8, 0xdf, 0xef, 0x03, 0x00, 0x6e, 0x71, 0x6a,
+	0x1f, 0xa8, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -317,6 +526,9 @@ type ServiceClient interface {
 	Config(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error)
 	// Status queries for the node status.
 	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	// GasPrices queries for the minimum gas prices of the node and the gas
+	// prices paid by the transactions of the recent blocks.
+	GasPrices(ctx context.Context, in *GasPricesRequest, opts ...grpc.CallOption) (*GasPricesResponse, error)
 }
 
 type serviceClient struct {
@@ -345,12 +557,24 @@ func (c *serviceClient) Status(ctx context.Context, in *StatusRequest, opts ...g
 	return out, nil
 }
 
+func (c *serviceClient) GasPrices(ctx context.Context, in *GasPricesRequest, opts ...grpc.CallOption) (*GasPricesResponse, error) {
+	out := new(GasPricesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.node.v1beta1.Service/GasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// Config queries for the operator configuration.
 	Config(context.Context, *ConfigRequest) (*ConfigResponse, error)
 	// Status queries for the node status.
 	Status(context.Context, *StatusRequest) (*StatusResponse, error)
+	// GasPrices queries for the minimum gas prices of the node and the gas
+	// prices paid by the transactions of the recent blocks.
+	GasPrices(context.Context, *GasPricesRequest) (*GasPricesResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -363,6 +587,9 @@ func (*UnimplementedServiceServer) Config(ctx context.Context, req *ConfigReques
 func (*UnimplementedServiceServer) Status(ctx context.Context, req *StatusRequest) (*StatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
 }
+func (*UnimplementedServiceServer) GasPrices(ctx context.Context, req *GasPricesRequest) (*GasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPrices not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -404,6 +631,24 @@ func _Service_Status_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_GasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.node.v1beta1.Service/GasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GasPrices(ctx, req.(*GasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.base.node.v1beta1.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -416,6 +661,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Status",
 			Handler:    _Service_Status_Handler,
 		},
+		{
+			MethodName: "GasPrices",
+			Handler:    _Service_GasPrices_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/base/node/v1beta1/query.proto",
@@ -573,6 +822,155 @@ func (m *StatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GasPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Percentiles) > 0 {
+		dAtA3 := make([]byte, len(m.Percentiles)*10)
+		var j2 int
+		for _, num := range m.Percentiles {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintQuery(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Txs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Txs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FeePercentiles) > 0 {
+		for iNdEx := len(m.FeePercentiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeePercentiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MinimumGasPrices) > 0 {
+		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinimumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPricePercentile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPricePercentile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPricePercentile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasPrices) > 0 {
+		for iNdEx := len(m.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Percentile != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Percentile))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -653,6 +1051,70 @@ func (m *StatusResponse) Size() (n int) {
 	return n
 }
 
+func (m *GasPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	if len(m.Percentiles) > 0 {
+		l = 0
+		for _, e := range m.Percentiles {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *GasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for _, e := range m.MinimumGasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.FeePercentiles) > 0 {
+		for _, e := range m.FeePercentiles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	if m.Txs != 0 {
+		n += 1 + sovQuery(uint64(m.Txs))
+	}
+	return n
+}
+
+func (m *GasPricePercentile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Percentile != 0 {
+		n += 1 + sovQuery(uint64(m.Percentile))
+	}
+	if len(m.GasPrices) > 0 {
+		for _, e := range m.GasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1116,6 +1578,410 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GasPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Percentiles = append(m.Percentiles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Percentiles) == 0 {
+					m.Percentiles = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Percentiles = append(m.Percentiles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentiles", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
+			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePercentiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePercentiles = append(m.FeePercentiles, GasPricePercentile{})
+			if err := m.FeePercentiles[len(m.FeePercentiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			m.Txs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Txs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPricePercentile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPricePercentile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPricePercentile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentile", wireType)
+			}
+			m.Percentile = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Percentile |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPrices = append(m.GasPrices, types.DecCoin{})
+			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
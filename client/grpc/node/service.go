@@ -2,9 +2,15 @@ package node
 
 import (
 	context "context"
+	"sort"
+	"sync"
 
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/config"
@@ -22,17 +28,35 @@ func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.Serv
 	_ = RegisterServiceHandlerClient(context.Background(), mux, NewServiceClient(clientConn))
 }
 
+const (
+	// DefaultGasPricesBlocks is the default number of blocks sampled by the
+	// GasPrices query.
+	DefaultGasPricesBlocks = 10
+	// MaxGasPricesBlocks is the maximum number of blocks sampled by the
+	// GasPrices query.
+	MaxGasPricesBlocks = 20
+	// MaxGasPricesBlockTxs is the maximum number of transactions sampled per
+	// block by the GasPrices query.
+	MaxGasPricesBlockTxs = 100
+)
+
+// DefaultGasPricesPercentiles are the default percentiles of the gas prices
+// returned by the GasPrices query.
+var DefaultGasPricesPercentiles = []uint32{25, 50, 75}
+
 var _ ServiceServer = queryServer{}
 
 type queryServer struct {
 	clientCtx client.Context
 	cfg       config.Config
+	gasPrices *gasPricesCache
 }
 
 func NewQueryServer(clientCtx client.Context, cfg config.Config) ServiceServer {
 	return queryServer{
 		clientCtx: clientCtx,
 		cfg:       cfg,
+		gasPrices: &gasPricesCache{blocks: make(map[int64]blockGasPrices)},
 	}
 }
 
@@ -63,3 +87,167 @@ func (s queryServer) Status(ctx context.Context, _ *StatusRequest) (*StatusRespo
 		ValidatorHash: sdkCtx.BlockHeader().NextValidatorsHash,
 	}, nil
 }
+
+func (s queryServer) GasPrices(ctx context.Context, req *GasPricesRequest) (*GasPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	blocks := int64(req.Blocks)
+	switch {
+	case blocks == 0:
+		blocks = DefaultGasPricesBlocks
+	case blocks > MaxGasPricesBlocks:
+		return nil, status.Errorf(codes.InvalidArgument, "cannot sample more than %d blocks", MaxGasPricesBlocks)
+	}
+	percentiles := req.Percentiles
+	if len(percentiles) == 0 {
+		percentiles = DefaultGasPricesPercentiles
+	}
+	for _, p := range percentiles {
+		if p > 100 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid percentile %d", p)
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	res := &GasPricesResponse{MinimumGasPrices: sdkCtx.MinGasPrices()}
+
+	// the gas prices paid by the transactions of each denom
+	gasPrices := make(map[string][]math.LegacyDec)
+	if s.clientCtx.Client != nil && s.clientCtx.TxConfig != nil {
+		latest := sdkCtx.BlockHeight()
+		s.gasPrices.prune(latest - MaxGasPricesBlocks)
+		for height := latest; height > 0 && height > latest-blocks; height-- {
+			block, ok := s.gasPrices.get(height)
+			if !ok {
+				var err error
+				block, err = s.blockGasPrices(ctx, height)
+				if err != nil {
+					// the block may be pruned
+					break
+				}
+				s.gasPrices.set(height, block)
+			}
+
+			res.Blocks++
+			res.Txs += block.txs
+			for denom, prices := range block.prices {
+				gasPrices[denom] = append(gasPrices[denom], prices...)
+			}
+		}
+	}
+
+	for _, prices := range gasPrices {
+		sort.Slice(prices, func(i, j int) bool { return prices[i].LT(prices[j]) })
+	}
+	for _, p := range percentiles {
+		percentile := GasPricePercentile{Percentile: p, GasPrices: sdk.DecCoins{}}
+		for denom, prices := range gasPrices {
+			percentile.GasPrices = append(percentile.GasPrices, sdk.NewDecCoinFromDec(denom, nearestRank(prices, p)))
+		}
+
+		percentile.GasPrices = percentile.GasPrices.Sort()
+		res.FeePercentiles = append(res.FeePercentiles, percentile)
+	}
+
+	return res, nil
+}
+
+// blockGasPrices are the gas prices paid by the sampled transactions of a
+// block, per fee denom.
+type blockGasPrices struct {
+	txs    uint64
+	prices map[string][]math.LegacyDec
+}
+
+// blockGasPrices returns the gas prices paid by the first successful
+// transactions of the block at the given height.
+func (s queryServer) blockGasPrices(ctx context.Context, height int64) (blockGasPrices, error) {
+	block, err := s.clientCtx.Client.Block(ctx, &height)
+	if err != nil {
+		return blockGasPrices{}, err
+	}
+	results, err := s.clientCtx.Client.BlockResults(ctx, &height)
+	if err != nil {
+		return blockGasPrices{}, err
+	}
+
+	res := blockGasPrices{prices: make(map[string][]math.LegacyDec)}
+	for i, txBytes := range block.Block.Txs {
+		if res.txs == MaxGasPricesBlockTxs {
+			break
+		}
+		// failed transactions pay their fees, but not necessarily at a gas price
+		// they would have been included at
+		if i >= len(results.TxsResults) || !results.TxsResults[i].IsOK() {
+			continue
+		}
+
+		tx, err := s.clientCtx.TxConfig.TxDecoder()(txBytes)
+		if err != nil {
+			continue
+		}
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok || feeTx.GetGas() == 0 {
+			continue
+		}
+
+		res.txs++
+		gas := math.LegacyNewDecFromInt(math.NewIntFromUint64(feeTx.GetGas()))
+		for _, fee := range feeTx.GetFee() {
+			if fee.Validate() != nil {
+				continue
+			}
+			res.prices[fee.Denom] = append(res.prices[fee.Denom], math.LegacyNewDecFromInt(fee.Amount).Quo(gas))
+		}
+	}
+
+	return res, nil
+}
+
+// gasPricesCache caches the gas prices of the blocks sampled by the GasPrices
+// query, by height.
+type gasPricesCache struct {
+	mu     sync.Mutex
+	blocks map[int64]blockGasPrices
+}
+
+func (c *gasPricesCache) get(height int64) (blockGasPrices, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	block, ok := c.blocks[height]
+	return block, ok
+}
+
+func (c *gasPricesCache) set(height int64, block blockGasPrices) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.blocks[height] = block
+}
+
+// prune removes the blocks at or below the given height, which can no longer
+// be sampled.
+func (c *gasPricesCache) prune(height int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for h := range c.blocks {
+		if h <= height {
+			delete(c.blocks, h)
+		}
+	}
+}
+
+// nearestRank returns the p-th percentile of the sorted values, with the
+// nearest-rank method.
+func nearestRank(sorted []math.LegacyDec, p uint32) math.LegacyDec {
+	rank := (int(p)*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
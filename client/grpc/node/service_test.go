@@ -1,13 +1,21 @@
 package node
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
 func TestServiceServer_Config(t *testing.T) {
@@ -26,3 +34,120 @@ func TestServiceServer_Config(t *testing.T) {
 	require.Equal(t, defaultCfg.PruningInterval, resp.PruningInterval)
 	require.Equal(t, defaultCfg.HaltHeight, resp.HaltHeight)
 }
+
+// mockBlocksNode is a node returning the blocks of a map, by height. The
+// transactions of its blocks succeed, unless their index is in failed.
+type mockBlocksNode struct {
+	client.CometRPC
+
+	blocks map[int64]*cmttypes.Block
+	failed map[int64][]int
+	// calls counts the calls to Block, by height
+	calls map[int64]int
+}
+
+func (m mockBlocksNode) Block(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	m.calls[*height]++
+	block, ok := m.blocks[*height]
+	if !ok {
+		return nil, fmt.Errorf("block %d pruned", *height)
+	}
+
+	return &coretypes.ResultBlock{Block: block}, nil
+}
+
+func (m mockBlocksNode) BlockResults(_ context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	block, ok := m.blocks[*height]
+	if !ok {
+		return nil, fmt.Errorf("block %d pruned", *height)
+	}
+
+	results := make([]*abci.ExecTxResult, len(block.Txs))
+	for i := range results {
+		results[i] = &abci.ExecTxResult{}
+	}
+	for _, i := range m.failed[*height] {
+		results[i].Code = 1
+	}
+
+	return &coretypes.ResultBlockResults{Height: *height, TxsResults: results}, nil
+}
+
+func TestServiceServer_GasPrices(t *testing.T) {
+	txConfig := moduletestutil.MakeTestEncodingConfig().TxConfig
+	newTx := func(gas uint64, fees sdk.Coins) cmttypes.Tx {
+		builder := txConfig.NewTxBuilder()
+		builder.SetGasLimit(gas)
+		builder.SetFeeAmount(fees)
+		bz, err := txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		return bz
+	}
+
+	// block 1 is pruned, the stake gas prices of the sampled blocks are 0.1, 0.2,
+	// 0.3 and 0.4, the failed transaction and the fee of invalid denom are
+	// skipped
+	node := mockBlocksNode{
+		blocks: map[int64]*cmttypes.Block{
+			2: {Data: cmttypes.Data{Txs: cmttypes.Txs{newTx(100, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))}}},
+			3: {Data: cmttypes.Data{Txs: cmttypes.Txs{
+				newTx(100, sdk.NewCoins(sdk.NewInt64Coin("stake", 40), sdk.NewInt64Coin("atom", 1))),
+				newTx(0, nil),
+				[]byte("invalid"),
+				newTx(100, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))),
+			}}},
+			4: {Data: cmttypes.Data{Txs: cmttypes.Txs{
+				newTx(100, sdk.NewCoins(sdk.NewInt64Coin("stake", 30))),
+				newTx(100, sdk.Coins{sdk.NewInt64Coin("stake", 20), {Denom: "!nvalid", Amount: math.NewInt(1)}}),
+			}}},
+		},
+		failed: map[int64][]int{3: {3}},
+		calls:  make(map[int64]int),
+	}
+	clientCtx := client.Context{}.WithTxConfig(txConfig).WithClient(node)
+	svr := NewQueryServer(clientCtx, *config.DefaultConfig())
+	ctx := sdk.Context{}.
+		WithBlockHeight(4).
+		WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(1, 2))))
+
+	resp, err := svr.GasPrices(ctx, &GasPricesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, ctx.MinGasPrices(), resp.MinimumGasPrices)
+	require.Equal(t, uint64(3), resp.Blocks)
+	require.Equal(t, uint64(4), resp.Txs)
+	require.Len(t, resp.FeePercentiles, len(DefaultGasPricesPercentiles))
+	for i, expected := range []string{"0.010000000000000000atom,0.100000000000000000stake", "0.010000000000000000atom,0.200000000000000000stake", "0.010000000000000000atom,0.300000000000000000stake"} {
+		require.Equal(t, DefaultGasPricesPercentiles[i], resp.FeePercentiles[i].Percentile)
+		require.Equal(t, expected, resp.FeePercentiles[i].GasPrices.String())
+	}
+
+	resp, err = svr.GasPrices(ctx, &GasPricesRequest{Blocks: 1, Percentiles: []uint32{0, 100}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), resp.Blocks)
+	require.Equal(t, "0.200000000000000000stake", resp.FeePercentiles[0].GasPrices.String())
+	require.Equal(t, "0.300000000000000000stake", resp.FeePercentiles[1].GasPrices.String())
+
+	// the gas prices of the sampled blocks are cached
+	require.Equal(t, map[int64]int{1: 1, 2: 1, 3: 1, 4: 1}, node.calls)
+
+	// once they can no longer be sampled, blocks leave the cache
+	ctx = ctx.WithBlockHeight(4 + MaxGasPricesBlocks)
+	_, err = svr.GasPrices(ctx, &GasPricesRequest{Blocks: 1})
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(4)
+	_, err = svr.GasPrices(ctx, &GasPricesRequest{Blocks: 1})
+	require.NoError(t, err)
+	require.Equal(t, 2, node.calls[4])
+
+	_, err = svr.GasPrices(ctx, &GasPricesRequest{Blocks: MaxGasPricesBlocks + 1})
+	require.Error(t, err)
+	_, err = svr.GasPrices(ctx, &GasPricesRequest{Percentiles: []uint32{101}})
+	require.Error(t, err)
+
+	// the node samples no blocks without a CometBFT client
+	svr = NewQueryServer(client.Context{}, *config.DefaultConfig())
+	resp, err = svr.GasPrices(ctx, &GasPricesRequest{})
+	require.NoError(t, err)
+	require.Zero(t, resp.Blocks)
+	require.Empty(t, resp.FeePercentiles[0].GasPrices)
+}
@@ -38,6 +38,8 @@ const (
 	flagHDPath       = "hd-path"
 	flagPubKeyBase64 = "pubkey-base64"
 	flagMnemonicSrc  = "source"
+	flagRemote       = "remote"
+	flagRemoteKey    = "remote-key"
 
 	// DefaultKeyPass contains the default key password for genesis transactions
 	DefaultKeyPass = "12345678"
@@ -71,6 +73,13 @@ sorted by address, unless the flag --nosort is set.
 Example:
 
     keys add mymultisig --multisig "keyname1,keyname2,keyname3" --multisig-threshold 2
+
+Use the --remote flag to store a reference to a key of a remote signer, e.g. served by
+"keys serve-signer", signing on behalf of the keyring. The key of the remote signer has
+the given name, unless the flag --remote-key is set.
+Example:
+
+    keys add relayer --remote signer.example.com:9191 --remote-key relayer-1
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: runAddCmdPrepare,
@@ -92,6 +101,8 @@ Example:
 	f.Uint32(flagIndex, 0, "Address index number for HD derivation (less than equal 2147483647)")
 	f.String(flags.FlagKeyType, string(hd.Secp256k1Type), "Key signing algorithm to generate keys for")
 	f.String(flagMnemonicSrc, "", "Import mnemonic from a file (only usable when recover or interactive is passed)")
+	f.String(flagRemote, "", "Store a local reference to a key of the remote signer at this <host>:<port>")
+	f.String(flagRemoteKey, "", "Name of the key in the remote signer (defaults to <name>)")
 
 	// support old flags name for backwards compatibility
 	f.SetNormalizeFunc(func(f *pflag.FlagSet, name string) pflag.NormalizedName {
@@ -252,6 +263,20 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		return printCreate(cmd, k, false, "", outputFormat)
 	}
 
+	if remote, _ := cmd.Flags().GetString(flagRemote); remote != "" {
+		remoteKey, _ := cmd.Flags().GetString(flagRemoteKey)
+		if remoteKey == "" {
+			remoteKey = name
+		}
+
+		k, err := kb.SaveRemoteKey(name, remote, remoteKey)
+		if err != nil {
+			return err
+		}
+
+		return printCreate(cmd, k, false, "", outputFormat)
+	}
+
 	coinType, _ := cmd.Flags().GetUint32(flagCoinType)
 	account, _ := cmd.Flags().GetUint32(flagAccount)
 	index, _ := cmd.Flags().GetUint32(flagIndex)
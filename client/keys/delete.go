@@ -53,7 +53,7 @@ private keys stored in a ledger device cannot be deleted with the CLI.
 					return err
 				}
 
-				if k.GetType() == keyring.TypeLedger || k.GetType() == keyring.TypeOffline {
+				if k.GetType() == keyring.TypeLedger || k.GetType() == keyring.TypeOffline || k.GetType() == keyring.TypeRemote {
 					cmd.PrintErrln("Public key reference deleted")
 					continue
 				}
@@ -64,7 +64,7 @@ private keys stored in a ledger device cannot be deleted with the CLI.
 		},
 	}
 
-	cmd.Flags().BoolP(flagYes, "y", false, "Skip confirmation prompt when deleting offline or ledger key references")
+	cmd.Flags().BoolP(flagYes, "y", false, "Skip confirmation prompt when deleting offline, ledger or remote key references")
 	cmd.Flags().BoolP(flagForce, "f", false, "Remove the key unconditionally without asking for the passphrase. Deprecated.")
 
 	return cmd
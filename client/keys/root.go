@@ -26,6 +26,8 @@ The keyring supports the following backends:
     pass        Uses the pass command line utility to store and retrieve keys.
     test        Stores keys insecurely to disk. It does not prompt for a password to be unlocked
                 and it should be use only for testing purposes.
+    remote      Stores references to the keys of remote signers unencrypted to disk, the remote
+                signers signing on behalf of the keyring. It cannot store private keys.
 
 kwallet and pass backends depend on external tools. Refer to their respective documentation for more
 information:
@@ -49,6 +51,7 @@ The pass backend requires GnuPG: https://gnupg.org/
 		RenameKeyCommand(),
 		ParseKeyStringCommand(),
 		MigrateCommand(),
+		ServeSignerCommand(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagOutput, "text", "Output format (text|json)")
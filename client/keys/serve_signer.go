@@ -0,0 +1,97 @@
+package keys
+
+import (
+	"errors"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+const (
+	flagListen      = "listen"
+	flagTLSCert     = "tls-cert"
+	flagTLSKey      = "tls-key"
+	flagTLSClientCA = "tls-client-ca"
+	flagInsecure    = "insecure"
+)
+
+// ServeSignerCommand defines a keys command serving the keys of the keyring to
+// the keyrings holding remote references to them.
+func ServeSignerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve-signer",
+		Short: "Serve the keys of the keyring as a remote signer",
+		Long: `Run a remote signer signing with the keys of the keyring, whatever its backend, on behalf
+of the keyrings holding remote references to them (see "keys add --remote"). The keyrings only
+send the bytes to sign and the sign mode, the private keys never leave the signer.
+
+The clients must authenticate with a certificate of the certificate authorities of --tls-client-ca
+(mutual TLS), unless --insecure is set.
+Example:
+
+    keys serve-signer --keyring-backend file --listen 0.0.0.0:9191 \
+        --tls-cert signer.crt --tls-key signer.key --tls-client-ca clients-ca.crt
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			opts := []grpc.ServerOption{
+				grpc.ForceServerCodec(codec.NewProtoCodec(clientCtx.InterfaceRegistry).GRPCCodec()),
+			}
+			if insecure, _ := cmd.Flags().GetBool(flagInsecure); !insecure {
+				certFile, _ := cmd.Flags().GetString(flagTLSCert)
+				keyFile, _ := cmd.Flags().GetString(flagTLSKey)
+				clientCAFile, _ := cmd.Flags().GetString(flagTLSClientCA)
+				if certFile == "" || keyFile == "" || clientCAFile == "" {
+					return errors.New("--tls-cert, --tls-key and --tls-client-ca are required unless --insecure is set")
+				}
+
+				tlsConfig, err := keyring.NewRemoteSignerServerTLSConfig(certFile, keyFile, clientCAFile)
+				if err != nil {
+					return err
+				}
+				opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+			}
+
+			listen, _ := cmd.Flags().GetString(flagListen)
+			lis, err := net.Listen("tcp", listen)
+			if err != nil {
+				return err
+			}
+
+			srv := grpc.NewServer(opts...)
+			keyring.RegisterRemoteSignerServer(srv, keyring.NewRemoteSignerServer(clientCtx.Keyring))
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			go func() {
+				<-ctx.Done()
+				srv.GracefulStop()
+			}()
+
+			cmd.PrintErrf("Serving the keys of the %s keyring on %s\n", clientCtx.Keyring.Backend(), lis.Addr())
+			return srv.Serve(lis)
+		},
+	}
+
+	cmd.Flags().String(flagListen, "localhost:9191", "The <host>:<port> to listen on")
+	cmd.Flags().String(flagTLSCert, "", "The TLS certificate of the signer")
+	cmd.Flags().String(flagTLSKey, "", "The TLS key of the signer")
+	cmd.Flags().String(flagTLSClientCA, "", "The certificate authorities of the client certificates")
+	cmd.Flags().Bool(flagInsecure, false, "Serve without TLS, e.g. to the local keyrings only")
+
+	return cmd
+}
@@ -0,0 +1,92 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestServeSignerCommand(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	signerHome := t.TempDir()
+	signer, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, signerHome, nil, encCfg.Codec)
+	require.NoError(t, err)
+	signerKey, _, err := signer.NewMnemonic("signer", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	endpoint := lis.Addr().String()
+	require.NoError(t, lis.Close())
+
+	clientCtx := client.Context{}.WithCodec(encCfg.Codec).WithInterfaceRegistry(encCfg.InterfaceRegistry)
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx))
+
+	serveCmd := ServeSignerCommand()
+	serveCmd.Flags().AddFlagSet(Commands().PersistentFlags())
+	testutil.ApplyMockIODiscardOutErr(serveCmd)
+
+	// TLS is required unless insecure
+	serveCmd.SetArgs([]string{
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, signerHome),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+	})
+	require.ErrorContains(t, serveCmd.ExecuteContext(ctx), "--tls-cert")
+
+	serveCmd.SetArgs([]string{
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, signerHome),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		fmt.Sprintf("--%s=%s", flagListen, endpoint),
+		fmt.Sprintf("--%s", flagInsecure),
+	})
+	served := make(chan error)
+	go func() { served <- serveCmd.ExecuteContext(ctx) }()
+	require.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", endpoint)
+		if err == nil {
+			conn.Close()
+		}
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	kbHome := t.TempDir()
+	addCmd := AddKeyCommand()
+	addCmd.Flags().AddFlagSet(Commands().PersistentFlags())
+	testutil.ApplyMockIODiscardOutErr(addCmd)
+	addCmd.SetArgs([]string{
+		"relayer",
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, kbHome),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendRemote),
+		fmt.Sprintf("--%s=%s", flagRemote, endpoint),
+		fmt.Sprintf("--%s=%s", flagRemoteKey, "signer"),
+	})
+	require.NoError(t, addCmd.ExecuteContext(ctx))
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendRemote, kbHome, nil, encCfg.Codec)
+	require.NoError(t, err)
+	k, err := kb.Key("relayer")
+	require.NoError(t, err)
+	require.Equal(t, keyring.TypeRemote, k.GetType())
+	require.Equal(t, signerKey.PubKey, k.PubKey)
+
+	msg := []byte("message")
+	sig, pubKey, err := kb.Sign("relayer", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	cancel()
+	require.NoError(t, <-served)
+}
@@ -33,10 +33,14 @@ The pruning option is provided via the 'pruning' argument or alternatively with
 - everything: 2 latest states will be kept
 - custom: allow pruning options to be manually specified through 'pruning-keep-recent'
 
+The pruning option of single stores can be overridden with '--pruning-stores', each entry being
+either '<store>:<strategy>' or '<store>:custom:<keep-recent>:<interval>'.
+
 Note: When the --app-db-backend flag is not specified, the default backend type is 'goleveldb'.
 Supported app-db-backend types include 'goleveldb', 'rocksdb', 'pebbledb'.`,
-		Example: "prune custom --pruning-keep-recent 100 --app-db-backend 'goleveldb'",
-		Args:    cobra.RangeArgs(0, 1),
+		Example: `prune custom --pruning-keep-recent 100 --app-db-backend 'goleveldb'
+prune default --pruning-stores bank:nothing,wasm:everything`,
+		Args: cobra.RangeArgs(0, 1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// bind flags to the Context's Viper so we can get pruning options.
 			vp := viper.New()
@@ -59,6 +63,17 @@ Supported app-db-backend types include 'goleveldb', 'rocksdb', 'pebbledb'.`,
 				pruningOptions.Strategy,
 				pruningOptions.KeepRecent,
 			)
+			storePruningOptions, err := server.GetStorePruningOptionsFromFlags(vp)
+			if err != nil {
+				return err
+			}
+			for name, opts := range storePruningOptions {
+				cmd.Printf("get pruning options of store %s from command flags, strategy: %v, keep-recent: %v\n",
+					name,
+					opts.Strategy,
+					opts.KeepRecent,
+				)
+			}
 
 			home := vp.GetString(flags.FlagHome)
 			if home == "" {
@@ -85,11 +100,33 @@ Supported app-db-backend types include 'goleveldb', 'rocksdb', 'pebbledb'.`,
 			}
 
 			pruningHeight := latestHeight - int64(pruningOptions.KeepRecent)
-			cmd.Printf("pruning heights up to %v\n", pruningHeight)
+			if len(storePruningOptions) == 0 {
+				cmd.Printf("pruning heights up to %v\n", pruningHeight)
 
-			err = rootMultiStore.PruneStores(pruningHeight)
-			if err != nil {
-				return err
+				err = rootMultiStore.PruneStores(pruningHeight)
+				if err != nil {
+					return err
+				}
+			} else {
+				pruningHeights := make(map[string]int64)
+				for name := range rootMultiStore.StoreKeysByName() {
+					opts, ok := storePruningOptions[name]
+					if !ok {
+						opts = pruningOptions
+					}
+					if opts.GetPruningStrategy() == pruningtypes.PruningNothing {
+						cmd.Printf("keeping all heights of store %s\n", name)
+						continue
+					}
+
+					pruningHeights[name] = latestHeight - int64(opts.KeepRecent)
+					cmd.Printf("pruning heights of store %s up to %v\n", name, pruningHeights[name])
+				}
+
+				err = rootMultiStore.PruneStoresTo(pruningHeights)
+				if err != nil {
+					return err
+				}
 			}
 
 			cmd.Println("successfully pruned the application root multi stores")
@@ -103,6 +140,8 @@ Supported app-db-backend types include 'goleveldb', 'rocksdb', 'pebbledb'.`,
 	cmd.Flags().Uint64(server.FlagPruningInterval, 10,
 		`Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom'), 
 		this is not used by this command but kept for compatibility with the complete pruning options`)
+	cmd.Flags().StringSlice(server.FlagPruningStores, []string{},
+		"Pruning options of single stores (<store>:<strategy> or <store>:custom:<keep-recent>:<interval>)")
 
 	return cmd
 }
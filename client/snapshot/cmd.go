@@ -16,6 +16,7 @@ func Cmd(appCreator servertypes.AppCreator) *cobra.Command {
 		ListSnapshotsCmd,
 		RestoreSnapshotCmd(appCreator),
 		ExportSnapshotCmd(appCreator),
+		VerifySnapshotCmd(appCreator),
 		DumpArchiveCmd(),
 		LoadArchiveCmd(),
 		DeleteSnapshotCmd(),
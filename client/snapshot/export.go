@@ -1,14 +1,23 @@
 package snapshot
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 
 	"github.com/cosmos/cosmos-sdk/server"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 )
 
+const (
+	flagIncremental = "incremental"
+	flagBaseHeight  = "base-height"
+)
+
 // ExportSnapshotCmd returns a command to take a snapshot of the application state
 func ExportSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
 	cmd := &cobra.Command{
@@ -22,6 +31,17 @@ func ExportSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			incremental, err := cmd.Flags().GetBool(flagIncremental)
+			if err != nil {
+				return err
+			}
+			baseHeight, err := cmd.Flags().GetUint64(flagBaseHeight)
+			if err != nil {
+				return err
+			}
+			if baseHeight != 0 && !incremental {
+				return fmt.Errorf("--%s requires --%s", flagBaseHeight, flagIncremental)
+			}
 
 			home := ctx.Config.RootDir
 			db, err := openDB(home, server.GetAppDBBackend(ctx.Viper))
@@ -35,10 +55,22 @@ func ExportSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
 				height = app.CommitMultiStore().LastCommitID().Version
 			}
 
-			cmd.Printf("Exporting snapshot for height %d\n", height)
-
 			sm := app.SnapshotManager()
-			snapshot, err := sm.Create(uint64(height))
+
+			var snapshot *snapshottypes.Snapshot
+			if incremental {
+				if baseHeight == 0 {
+					if baseHeight, err = latestSnapshotHeight(sm, uint64(height)); err != nil {
+						return err
+					}
+				}
+
+				cmd.Printf("Exporting incremental snapshot for height %d on top of height %d\n", height, baseHeight)
+				snapshot, err = sm.CreateIncremental(uint64(height), baseHeight)
+			} else {
+				cmd.Printf("Exporting snapshot for height %d\n", height)
+				snapshot, err = sm.Create(uint64(height))
+			}
 			if err != nil {
 				return err
 			}
@@ -49,6 +81,25 @@ func ExportSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
 	}
 
 	cmd.Flags().Int64("height", 0, "Height to export, default to latest state height")
+	cmd.Flags().Bool(flagIncremental, false, "Export only the state changed since a base snapshot, which must be kept to restore the incremental snapshot")
+	cmd.Flags().Uint64(flagBaseHeight, 0, "Height of the base snapshot of an incremental snapshot, default to the latest snapshot below the height to export")
 
 	return cmd
 }
+
+// latestSnapshotHeight returns the height of the latest snapshot below height.
+func latestSnapshotHeight(sm *snapshots.Manager, height uint64) (uint64, error) {
+	list, err := sm.List()
+	if err != nil {
+		return 0, err
+	}
+
+	// snapshots are listed from the latest
+	for _, snapshot := range list {
+		if snapshot.Height < height {
+			return snapshot.Height, nil
+		}
+	}
+
+	return 0, fmt.Errorf("no snapshot below height %d to export an incremental snapshot on", height)
+}
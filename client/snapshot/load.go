@@ -12,6 +12,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
 
 	"github.com/cosmos/cosmos-sdk/server"
@@ -108,7 +109,33 @@ func LoadArchiveCmd() *cobra.Command {
 				return fmt.Errorf("invalid archive, the saved snapshot is not equal to the original one")
 			}
 
+			if snapshot.Format == snapshottypes.IncrementalFormat {
+				return checkIncrementalBase(cmd, snapshotStore, snapshot.Height)
+			}
+
 			return nil
 		},
 	}
 }
+
+// checkIncrementalBase warns if the base of a loaded incremental snapshot is missing from the
+// snapshot store, as the incremental snapshot can't be restored without it.
+func checkIncrementalBase(cmd *cobra.Command, snapshotStore *snapshots.Store, height uint64) error {
+	baseHeight, err := snapshotStore.IncrementalBase(height)
+	if err != nil {
+		return err
+	}
+
+	for _, format := range []uint32{snapshottypes.CurrentFormat, snapshottypes.IncrementalFormat} {
+		base, err := snapshotStore.Get(baseHeight, format)
+		if err != nil {
+			return err
+		}
+		if base != nil {
+			return nil
+		}
+	}
+
+	cmd.Printf("WARNING: the base snapshot at height %d of the incremental snapshot is missing, load it before restoring\n", baseHeight)
+	return nil
+}
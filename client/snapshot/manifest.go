@@ -0,0 +1,129 @@
+package snapshot
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// Manifest describes a snapshot verified against the app hash of its height, so that the mirrors
+// of the snapshot can be checked before restoring it.
+type Manifest struct {
+	Height      uint64              `json:"height"`
+	Format      uint32              `json:"format"`
+	Hash        cmtbytes.HexBytes   `json:"hash"`
+	ChunkHashes []cmtbytes.HexBytes `json:"chunk_hashes"`
+	AppHash     cmtbytes.HexBytes   `json:"app_hash"`
+}
+
+// NewManifest returns the Manifest of a snapshot whose state has the given app hash.
+func NewManifest(snapshot *snapshottypes.Snapshot, appHash []byte) Manifest {
+	chunkHashes := make([]cmtbytes.HexBytes, len(snapshot.Metadata.ChunkHashes))
+	for i, hash := range snapshot.Metadata.ChunkHashes {
+		chunkHashes[i] = hash
+	}
+
+	return Manifest{
+		Height:      snapshot.Height,
+		Format:      snapshot.Format,
+		Hash:        snapshot.Hash,
+		ChunkHashes: chunkHashes,
+		AppHash:     appHash,
+	}
+}
+
+// Check returns an error if the snapshot is not the one described by the manifest.
+func (m Manifest) Check(snapshot *snapshottypes.Snapshot) error {
+	if snapshot.Height != m.Height || snapshot.Format != m.Format {
+		return fmt.Errorf("manifest of snapshot at height %d format %d, got height %d format %d",
+			m.Height, m.Format, snapshot.Height, snapshot.Format)
+	}
+	if !bytes.Equal(snapshot.Hash, m.Hash) {
+		return fmt.Errorf("snapshot hash mismatch: manifest %X, got %X", m.Hash, snapshot.Hash)
+	}
+	if len(snapshot.Metadata.ChunkHashes) != len(m.ChunkHashes) {
+		return fmt.Errorf("manifest of %d chunks, got %d", len(m.ChunkHashes), len(snapshot.Metadata.ChunkHashes))
+	}
+	for i, hash := range snapshot.Metadata.ChunkHashes {
+		if !bytes.Equal(hash, m.ChunkHashes[i]) {
+			return fmt.Errorf("chunk %d hash mismatch: manifest %X, got %X", i, m.ChunkHashes[i], hash)
+		}
+	}
+
+	return nil
+}
+
+// SignedManifest is a Manifest with the signature of its JSON encoding by a keyring key.
+type SignedManifest struct {
+	Manifest  Manifest `json:"manifest"`
+	Signer    string   `json:"signer"`
+	Signature []byte   `json:"signature"`
+}
+
+// SignManifest signs the manifest with the named keyring key.
+func SignManifest(kr keyring.Keyring, keyName string, m Manifest) (SignedManifest, error) {
+	bz, err := json.Marshal(m)
+	if err != nil {
+		return SignedManifest{}, err
+	}
+	sig, pubKey, err := kr.Sign(keyName, bz, signing.SignMode_SIGN_MODE_DIRECT)
+	if err != nil {
+		return SignedManifest{}, fmt.Errorf("failed to sign manifest: %w", err)
+	}
+
+	return SignedManifest{
+		Manifest:  m,
+		Signer:    sdk.AccAddress(pubKey.Address()).String(),
+		Signature: sig,
+	}, nil
+}
+
+// Verify returns an error if the manifest is not signed by the public key.
+func (sm SignedManifest) Verify(pubKey cryptotypes.PubKey) error {
+	if signer := sdk.AccAddress(pubKey.Address()).String(); signer != sm.Signer {
+		return fmt.Errorf("manifest signed by %s, expected %s", sm.Signer, signer)
+	}
+	bz, err := json.Marshal(sm.Manifest)
+	if err != nil {
+		return err
+	}
+	if !pubKey.VerifySignature(bz, sm.Signature) {
+		return fmt.Errorf("invalid manifest signature of %s", sm.Signer)
+	}
+
+	return nil
+}
+
+// ReadManifest reads a signed manifest from a JSON file.
+func ReadManifest(path string) (SignedManifest, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return SignedManifest{}, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	var sm SignedManifest
+	if err := json.Unmarshal(bz, &sm); err != nil {
+		return SignedManifest{}, fmt.Errorf("failed to unmarshal manifest: %w", err)
+	}
+	return sm, nil
+}
+
+// WriteManifest writes a signed manifest to a JSON file.
+func WriteManifest(path string, sm SignedManifest) error {
+	bz, err := json.MarshalIndent(sm, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, bz, 0o644)
+}
@@ -0,0 +1,60 @@
+package snapshot_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+
+	"github.com/cosmos/cosmos-sdk/client/snapshot"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestSignedManifest(t *testing.T) {
+	kr := keyring.NewInMemory(testutil.MakeTestEncodingConfig().Codec)
+	path := hd.CreateHDPath(118, 0, 0).String()
+	alice, _, err := kr.NewMnemonic("alice", keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	bob, _, err := kr.NewMnemonic("bob", keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	alicePubKey, err := alice.GetPubKey()
+	require.NoError(t, err)
+	bobPubKey, err := bob.GetPubKey()
+	require.NoError(t, err)
+
+	snap := &snapshottypes.Snapshot{
+		Height:   10,
+		Format:   snapshottypes.CurrentFormat,
+		Chunks:   2,
+		Hash:     []byte{1, 2, 3},
+		Metadata: snapshottypes.Metadata{ChunkHashes: [][]byte{{4, 5}, {6, 7}}},
+	}
+	signed, err := snapshot.SignManifest(kr, "alice", snapshot.NewManifest(snap, []byte{8, 9}))
+	require.NoError(t, err)
+
+	manifestPath := filepath.Join(t.TempDir(), "manifest.json")
+	require.NoError(t, snapshot.WriteManifest(manifestPath, signed))
+	read, err := snapshot.ReadManifest(manifestPath)
+	require.NoError(t, err)
+	require.Equal(t, signed, read)
+
+	require.NoError(t, read.Verify(alicePubKey))
+	require.ErrorContains(t, read.Verify(bobPubKey), "manifest signed by")
+	require.NoError(t, read.Manifest.Check(snap))
+
+	// tampered manifests are rejected
+	tampered := read
+	tampered.Manifest.AppHash = []byte{9, 8}
+	require.ErrorContains(t, tampered.Verify(alicePubKey), "invalid manifest signature")
+
+	// the snapshot must match the manifest
+	other := *snap
+	other.Metadata = snapshottypes.Metadata{ChunkHashes: [][]byte{{4, 5}, {6, 8}}}
+	require.ErrorContains(t, read.Manifest.Check(&other), "chunk 1 hash mismatch")
+	other.Height = 11
+	require.Error(t, read.Manifest.Check(&other))
+}
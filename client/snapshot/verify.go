@@ -0,0 +1,230 @@
+package snapshot
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagManifest = "manifest"
+	flagSign     = "sign"
+	flagSigner   = "signer"
+)
+
+// VerifySnapshotCmd returns a command to verify a local snapshot against the app hash of its height
+func VerifySnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify <height> <format>",
+		Short: "Verify a local snapshot against the app hash of its height",
+		Long: `Verify a local snapshot against the app hash of its height, by checking its chunks and
+rebuilding its stores in a temporary database.
+
+The store hashes are compared with the commit info recorded at the height of the snapshot. With
+--sign, a manifest of the verified snapshot is signed with a keyring key and written to --manifest.
+With --signer, the manifest read from --manifest must be signed by the keyring key, and match the
+snapshot, whose app hash is then taken from the manifest if the node has no commit info at its height.`,
+		Example: fmt.Sprintf(`$ %[1]s snapshots verify 1000 3 --sign operator --manifest manifest.json
+$ %[1]s snapshots verify 1000 3 --signer operator --manifest manifest.json`, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := server.GetServerContextFromCmd(cmd)
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			format, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			manifestPath, _ := cmd.Flags().GetString(flagManifest)
+			signKey, _ := cmd.Flags().GetString(flagSign)
+			signerKey, _ := cmd.Flags().GetString(flagSigner)
+			if (signKey != "" || signerKey != "") && manifestPath == "" {
+				return fmt.Errorf("--%s and --%s require --%s", flagSign, flagSigner, flagManifest)
+			}
+			if signKey != "" && signerKey != "" {
+				return fmt.Errorf("--%s and --%s are exclusive", flagSign, flagSigner)
+			}
+
+			var kr keyring.Keyring
+			if signKey != "" || signerKey != "" {
+				clientCtx, err := client.GetClientQueryContext(cmd)
+				if err != nil {
+					return err
+				}
+				if kr = clientCtx.Keyring; kr == nil {
+					return errors.New("no keyring to sign or verify the manifest")
+				}
+			}
+
+			// the manifest is checked first, so that an untrusted one fails fast
+			var manifest *Manifest
+			if signerKey != "" {
+				signed, err := ReadManifest(manifestPath)
+				if err != nil {
+					return err
+				}
+				record, err := kr.Key(signerKey)
+				if err != nil {
+					return fmt.Errorf("failed to fetch '%s' from the keyring: %w", signerKey, err)
+				}
+				pubKey, err := record.GetPubKey()
+				if err != nil {
+					return err
+				}
+				if err := signed.Verify(pubKey); err != nil {
+					return err
+				}
+				manifest = &signed.Manifest
+			}
+
+			home := ctx.Config.RootDir
+			db, err := openDB(home, server.GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			logger := log.NewLogger(cmd.OutOrStdout())
+			app := appCreator(logger, db, nil, ctx.Viper)
+
+			cms, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("cannot verify the snapshots of a %T multistore", app.CommitMultiStore())
+			}
+			expected, err := cms.GetCommitInfo(int64(height))
+			if err != nil && manifest == nil {
+				return fmt.Errorf("no commit info at height %d to verify the snapshot against, pass a signed manifest: %w", height, err)
+			}
+			if expected == nil && signKey != "" {
+				return fmt.Errorf("cannot sign the manifest of a snapshot without commit info at height %d", height)
+			}
+
+			// rebuild the IAVL stores of the app in a temporary database, only those committed at the
+			// height of the snapshot if known, as stores may have been added by later upgrades
+			var names []string
+			if expected != nil {
+				for _, info := range expected.StoreInfos {
+					names = append(names, info.Name)
+				}
+			} else {
+				for name := range cms.StoreKeysByName() {
+					names = append(names, name)
+				}
+			}
+
+			tmpDir, err := os.MkdirTemp("", "snapshot-verify")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(tmpDir)
+			tmpDB, err := openDB(tmpDir, server.GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			defer tmpDB.Close()
+
+			target := rootmulti.NewStore(tmpDB, log.NewNopLogger(), metrics.NewNoOpMetrics())
+			keys := cms.StoreKeysByName()
+			for _, name := range names {
+				if key, ok := keys[name]; ok && cms.GetCommitKVStore(key).GetStoreType() == storetypes.StoreTypeIAVL {
+					target.MountStoreWithDB(storetypes.NewKVStoreKey(name), storetypes.StoreTypeIAVL, nil)
+				}
+			}
+			if err := target.LoadLatestVersion(); err != nil {
+				return err
+			}
+
+			cmd.Printf("Verifying snapshot at height %d, format %d\n", height, format)
+			snapshot, err := app.SnapshotManager().VerifySnapshot(height, uint32(format), target)
+			if err != nil {
+				return err
+			}
+			if manifest != nil {
+				if err := manifest.Check(snapshot); err != nil {
+					return err
+				}
+			}
+
+			rebuilt, err := target.GetCommitInfo(int64(height))
+			if err != nil {
+				return err
+			}
+			appHash := rebuilt.Hash()
+			if expected != nil {
+				if err := compareCommitInfos(expected, rebuilt); err != nil {
+					return err
+				}
+			}
+			if manifest != nil && !bytes.Equal(manifest.AppHash, appHash) {
+				return fmt.Errorf("app hash mismatch: manifest %X, snapshot %X", manifest.AppHash, appHash)
+			}
+
+			if signKey != "" {
+				signed, err := SignManifest(kr, signKey, NewManifest(snapshot, appHash))
+				if err != nil {
+					return err
+				}
+				if err := WriteManifest(manifestPath, signed); err != nil {
+					return err
+				}
+				cmd.Printf("Manifest signed by %s written to %s\n", signed.Signer, manifestPath)
+			}
+
+			cmd.Printf("Snapshot verified, app hash %X\n", appHash)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagManifest, "", "Path of the snapshot manifest to sign or verify")
+	cmd.Flags().String(flagSign, "", "Name of the keyring key signing the manifest of the verified snapshot")
+	cmd.Flags().String(flagSigner, "", "Name of the keyring key the manifest must be signed by")
+	flags.AddKeyringFlags(cmd.Flags())
+
+	return cmd
+}
+
+// compareCommitInfos returns an error listing the stores whose hash differs between the commit
+// info recorded at a height and the one rebuilt from a snapshot.
+func compareCommitInfos(expected, rebuilt *storetypes.CommitInfo) error {
+	hashes := make(map[string][]byte, len(rebuilt.StoreInfos))
+	for _, info := range rebuilt.StoreInfos {
+		hashes[info.Name] = info.CommitId.Hash
+	}
+
+	var mismatches []string
+	for _, info := range expected.StoreInfos {
+		hash, ok := hashes[info.Name]
+		if !ok {
+			mismatches = append(mismatches, fmt.Sprintf("store %s: missing from the snapshot", info.Name))
+		} else if !bytes.Equal(hash, info.CommitId.Hash) {
+			mismatches = append(mismatches, fmt.Sprintf("store %s: expected %X, got %X", info.Name, info.CommitId.Hash, hash))
+		}
+	}
+	if len(mismatches) > 0 {
+		return fmt.Errorf("snapshot does not match the commit info at height %d:\n%s", expected.Version, strings.Join(mismatches, "\n"))
+	}
+
+	if !bytes.Equal(expected.Hash(), rebuilt.Hash()) {
+		return fmt.Errorf("app hash mismatch: expected %X, got %X", expected.Hash(), rebuilt.Hash())
+	}
+	return nil
+}
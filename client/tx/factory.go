@@ -1,18 +1,21 @@
 package tx
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"strings"
 
 	"github.com/cosmos/go-bip39"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/spf13/pflag"
 
 	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
@@ -42,6 +45,7 @@ type Factory struct {
 	feeGranter         sdk.AccAddress
 	feePayer           sdk.AccAddress
 	gasPrices          sdk.DecCoins
+	autoGasPrices      bool
 	extOptions         []*codectypes.Any
 	signMode           signing.SignMode
 	simulateAndExecute bool
@@ -121,6 +125,7 @@ func (f Factory) ChainID() string                           { return f.chainID }
 func (f Factory) Memo() string                              { return f.memo }
 func (f Factory) Fees() sdk.Coins                           { return f.fees }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
+func (f Factory) AutoGasPrices() bool                       { return f.autoGasPrices }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
 func (f Factory) FromName() string                          { return f.fromName }
@@ -164,14 +169,22 @@ func (f Factory) WithFees(fees string) Factory {
 	return f
 }
 
-// WithGasPrices returns a copy of the Factory with updated gas prices.
+// WithGasPrices returns a copy of the Factory with updated gas prices. The
+// gas prices are estimated by PrepareGasPrices if set to "auto".
 func (f Factory) WithGasPrices(gasPrices string) Factory {
+	if gasPrices == flags.GasFlagAuto {
+		f.gasPrices = nil
+		f.autoGasPrices = true
+		return f
+	}
+
 	parsedGasPrices, err := sdk.ParseDecCoins(gasPrices)
 	if err != nil {
 		panic(err)
 	}
 
 	f.gasPrices = parsedGasPrices
+	f.autoGasPrices = false
 	return f
 }
 
@@ -306,6 +319,10 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 
 	fees := f.fees
 
+	if f.autoGasPrices {
+		return nil, errors.New("gas prices must be estimated before building the transaction")
+	}
+
 	if !f.gasPrices.IsZero() {
 		if !fees.IsZero() {
 			return nil, errors.New("cannot provide both fees and gas prices")
@@ -353,6 +370,17 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 // simulated and also printed to the same writer before the transaction is
 // printed.
 func (f Factory) PrintUnsignedTx(clientCtx client.Context, msgs ...sdk.Msg) error {
+	if f.AutoGasPrices() {
+		if clientCtx.Offline {
+			return errors.New("cannot estimate gas prices in offline mode")
+		}
+
+		var err error
+		if f, err = f.PrepareGasPrices(clientCtx); err != nil {
+			return err
+		}
+	}
+
 	if f.SimulateAndExecute() {
 		if clientCtx.Offline {
 			return errors.New("cannot estimate gas in offline mode")
@@ -475,13 +503,17 @@ func (f Factory) getSimSignatureData(pk cryptotypes.PubKey) signing.SignatureDat
 // if the account number and/or the account sequence number are zero (not set),
 // they will be queried for and set on the provided Factory.
 // A new Factory with the updated fields will be returned.
+// The gas prices are estimated if set to "auto", see PrepareGasPrices.
 // Note: When in offline mode, the Prepare does nothing and returns the original factory.
 func (f Factory) Prepare(clientCtx client.Context) (Factory, error) {
 	if clientCtx.Offline {
 		return f, nil
 	}
 
-	fc := f
+	fc, err := f.PrepareGasPrices(clientCtx)
+	if err != nil {
+		return fc, err
+	}
 	from := clientCtx.GetFromAddress()
 
 	if err := fc.accountRetriever.EnsureExists(clientCtx, from); err != nil {
@@ -506,3 +538,43 @@ func (f Factory) Prepare(clientCtx client.Context) (Factory, error) {
 
 	return fc, nil
 }
+
+// PrepareGasPrices estimates the gas prices of the Factory if set to "auto",
+// returning a new Factory with the estimated gas prices. The gas price is the
+// median gas price paid by the transactions of the recent blocks in the first
+// denom of the minimum gas prices of the node, and at least its minimum gas
+// price. If the node has no minimum gas prices, it is the median gas price of
+// the first denom paid by the recent transactions, if any.
+func (f Factory) PrepareGasPrices(clientConn gogogrpc.ClientConn) (Factory, error) {
+	if !f.autoGasPrices {
+		return f, nil
+	}
+
+	res, err := node.NewServiceClient(clientConn).GasPrices(context.Background(), &node.GasPricesRequest{
+		Percentiles: []uint32{50},
+	})
+	if err != nil {
+		return f, fmt.Errorf("failed to estimate gas prices: %w", err)
+	}
+
+	var median sdk.DecCoins
+	if len(res.FeePercentiles) > 0 {
+		median = res.FeePercentiles[0].GasPrices
+	}
+
+	f.autoGasPrices = false
+	f.gasPrices = nil
+	switch {
+	case len(res.MinimumGasPrices) > 0:
+		gasPrice := res.MinimumGasPrices[0]
+		if price := median.AmountOf(gasPrice.Denom); price.GT(gasPrice.Amount) {
+			gasPrice = sdk.NewDecCoinFromDec(gasPrice.Denom, price)
+		}
+
+		f.gasPrices = sdk.DecCoins{gasPrice}
+	case len(median) > 0:
+		f.gasPrices = sdk.DecCoins{median[0]}
+	}
+
+	return f, nil
+}
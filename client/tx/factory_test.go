@@ -1,11 +1,17 @@
 package tx
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
@@ -15,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
@@ -117,3 +124,82 @@ func TestFactory_getSimSignatureData(t *testing.T) {
 		})
 	}
 }
+
+// mockGasPricesConn is a gRPC client connection answering the GasPrices query
+// with res.
+type mockGasPricesConn struct {
+	res *node.GasPricesResponse
+}
+
+func (m mockGasPricesConn) Invoke(_ context.Context, _ string, _, reply interface{}, _ ...grpc.CallOption) error {
+	*(reply.(*node.GasPricesResponse)) = *m.res
+	return nil
+}
+
+func (mockGasPricesConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	panic("not implemented")
+}
+
+func TestFactoryPrepareGasPrices(t *testing.T) {
+	percentiles := func(gasPrices ...sdk.DecCoin) []node.GasPricePercentile {
+		return []node.GasPricePercentile{{Percentile: 50, GasPrices: sdk.NewDecCoins(gasPrices...)}}
+	}
+
+	testCases := []struct {
+		name     string
+		res      *node.GasPricesResponse
+		expected sdk.DecCoins
+	}{
+		{
+			"minimum gas price",
+			&node.GasPricesResponse{
+				MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(25, 3))),
+				FeePercentiles:   percentiles(sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(1, 3))),
+			},
+			sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(25, 3))),
+		},
+		{
+			"median gas price above the minimum",
+			&node.GasPricesResponse{
+				MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(25, 3))),
+				FeePercentiles: percentiles(
+					sdk.NewDecCoinFromDec("atom", math.LegacyNewDec(1)),
+					sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(5, 2)),
+				),
+			},
+			sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(5, 2))),
+		},
+		{
+			"no minimum gas prices",
+			&node.GasPricesResponse{
+				FeePercentiles: percentiles(sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(5, 2))),
+			},
+			sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(5, 2))),
+		},
+		{
+			"no gas prices",
+			&node.GasPricesResponse{FeePercentiles: percentiles()},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			factory := Factory{}.WithChainID("test-chain").WithGasPrices(flags.GasFlagAuto)
+			require.True(t, factory.AutoGasPrices())
+			_, err := factory.BuildUnsignedTx()
+			require.ErrorContains(t, err, "gas prices must be estimated")
+
+			output, err := factory.PrepareGasPrices(mockGasPricesConn{tc.res})
+			require.NoError(t, err)
+			require.False(t, output.AutoGasPrices())
+			require.Equal(t, tc.expected, output.GasPrices())
+		})
+	}
+
+	// the gas prices which are not "auto" are not estimated
+	factory := Factory{}.WithGasPrices("0.1stake")
+	output, err := factory.PrepareGasPrices(nil)
+	require.NoError(t, err)
+	require.Equal(t, factory, output)
+}
@@ -0,0 +1,326 @@
+package tx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+const (
+	// DefaultMaxInFlight is the default maximum number of transactions of a
+	// signer broadcast by a TxSender and not yet included in a block.
+	DefaultMaxInFlight = 16
+	// DefaultMaxSequenceRetries is the default number of times a TxSender
+	// signs a transaction again after a sequence mismatch.
+	DefaultMaxSequenceRetries = 3
+	// DefaultPollInterval is the default interval between the GetTx queries
+	// of a TxSender waiting for a transaction.
+	DefaultPollInterval = time.Second
+	// DefaultInclusionTimeout is the default maximum time a TxSender waits for
+	// a transaction to be included in a block.
+	DefaultInclusionTimeout = time.Minute
+)
+
+// sequenceMismatchRegexp matches the log of the transactions rejected by the
+// ante handler for a sequence mismatch.
+var sequenceMismatchRegexp = regexp.MustCompile(`account sequence mismatch, expected (\d+), got \d+`)
+
+// TxSender signs, broadcasts and waits for the inclusion of the transactions
+// of the keys of a keyring. It keeps the account number and sequence of each
+// signer locally, so that several transactions of a signer can be in flight
+// at once instead of querying the sequence for each of them, and re-syncs the
+// sequence when the node rejects a transaction for a sequence mismatch.
+//
+// A TxSender is safe for concurrent use: the transactions of a signer are
+// signed and broadcast in order, their inclusion being waited for
+// concurrently.
+type TxSender struct {
+	clientCtx client.Context
+	txf       Factory
+
+	maxInFlight      int
+	maxRetries       int
+	pollInterval     time.Duration
+	inclusionTimeout time.Duration
+
+	mu       sync.Mutex
+	accounts map[string]*senderAccount
+}
+
+// senderAccount is the account of a signer of a TxSender.
+type senderAccount struct {
+	// mu serializes the signing and broadcasting of the transactions of the
+	// account.
+	mu       sync.Mutex
+	inFlight chan struct{}
+
+	synced   bool
+	number   uint64
+	sequence uint64
+}
+
+// TxSenderOption configures a TxSender.
+type TxSenderOption func(*TxSender)
+
+// WithMaxInFlight sets the maximum number of transactions of a signer sent and
+// not yet included in a block.
+func WithMaxInFlight(n int) TxSenderOption {
+	return func(s *TxSender) { s.maxInFlight = n }
+}
+
+// WithMaxSequenceRetries sets the number of times a transaction is signed again
+// after being rejected for a sequence mismatch.
+func WithMaxSequenceRetries(n int) TxSenderOption {
+	return func(s *TxSender) { s.maxRetries = n }
+}
+
+// WithPollInterval sets the interval between the GetTx queries of WaitTx.
+func WithPollInterval(interval time.Duration) TxSenderOption {
+	return func(s *TxSender) { s.pollInterval = interval }
+}
+
+// WithInclusionTimeout sets the maximum time WaitTx waits for a transaction to
+// be included in a block.
+func WithInclusionTimeout(timeout time.Duration) TxSenderOption {
+	return func(s *TxSender) { s.inclusionTimeout = timeout }
+}
+
+// NewTxSender returns a TxSender broadcasting to the node of clientCtx the
+// transactions built by txf, and signed with the keys of its keyring. The
+// account numbers and sequences of txf are ignored.
+func NewTxSender(clientCtx client.Context, txf Factory, opts ...TxSenderOption) *TxSender {
+	s := &TxSender{
+		clientCtx:        clientCtx,
+		txf:              txf,
+		maxInFlight:      DefaultMaxInFlight,
+		maxRetries:       DefaultMaxSequenceRetries,
+		pollInterval:     DefaultPollInterval,
+		inclusionTimeout: DefaultInclusionTimeout,
+		accounts:         make(map[string]*senderAccount),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Send signs msgs with the key from, broadcasts the transaction and waits for
+// its inclusion in a block, returning its result. Send blocks while the
+// maximum number of transactions of from are in flight.
+//
+// The transaction response is returned along the error if the transaction
+// failed, whether in CheckTx or in the block.
+func (s *TxSender) Send(ctx context.Context, from string, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	acc, _, err := s.account(from)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case acc.inFlight <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-acc.inFlight }()
+
+	res, err := s.Broadcast(ctx, from, msgs...)
+	if err != nil {
+		return res, err
+	}
+
+	res, err = s.WaitTx(ctx, res.TxHash)
+	if err != nil {
+		// the transaction may have been evicted from the mempool, the
+		// sequence must be queried again
+		s.resync(acc)
+		return nil, err
+	}
+	if res.Code != 0 {
+		return res, errorsmod.ABCIError(res.Codespace, res.Code, res.RawLog)
+	}
+
+	return res, nil
+}
+
+// Broadcast signs msgs with the key from with its next sequence and
+// broadcasts the transaction in sync mode, returning its CheckTx response
+// without waiting for its inclusion in a block.
+//
+// If the node rejects the transaction for a sequence mismatch, the sequence is
+// re-synced and the transaction signed and broadcast again.
+func (s *TxSender) Broadcast(ctx context.Context, from string, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	acc, addr, err := s.account(from)
+	if err != nil {
+		return nil, err
+	}
+
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+
+	for retries := 0; ; retries++ {
+		if !acc.synced {
+			num, seq, err := s.clientCtx.AccountRetriever.GetAccountNumberSequence(s.clientCtx, addr)
+			if err != nil {
+				return nil, err
+			}
+
+			acc.number, acc.sequence, acc.synced = num, seq, true
+		}
+
+		txBytes, err := s.sign(ctx, from, acc, msgs...)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := s.clientCtx.BroadcastTxSync(txBytes)
+		if err != nil {
+			// whether the node received the transaction is unknown
+			acc.synced = false
+			return nil, err
+		}
+
+		switch {
+		case res.Code == 0:
+			acc.sequence++
+			return res, nil
+
+		case res.Codespace == sdkerrors.ErrWrongSequence.Codespace() && res.Code == sdkerrors.ErrWrongSequence.ABCICode() && retries < s.maxRetries:
+			// the node reports the sequence it expects, including the
+			// transactions in its mempool, unlike the queried one
+			if seq, ok := expectedSequence(res.RawLog); ok {
+				acc.sequence = seq
+			} else {
+				acc.synced = false
+			}
+
+		default:
+			return res, errorsmod.ABCIError(res.Codespace, res.Code, res.RawLog)
+		}
+	}
+}
+
+// WaitTx waits for the transaction with the given hash to be included in a
+// block, polling the GetTx query of the node, and returns its result.
+func (s *TxSender) WaitTx(ctx context.Context, hash string) (*sdk.TxResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.inclusionTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	txSvcClient := tx.NewServiceClient(s.clientCtx)
+	for {
+		res, err := txSvcClient.GetTx(ctx, &tx.GetTxRequest{Hash: hash})
+		switch {
+		case err == nil:
+			return res.TxResponse, nil
+		case ctx.Err() == nil && status.Code(err) != codes.NotFound:
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, ctx.Err()
+			}
+
+			return nil, sdkerrors.ErrLogic.Wrapf("timed out waiting for transaction %s to be included in a block", hash)
+		case <-ticker.C:
+		}
+	}
+}
+
+// account returns the account of the key name and its address.
+func (s *TxSender) account(name string) (*senderAccount, sdk.AccAddress, error) {
+	if s.txf.Keybase() == nil {
+		return nil, nil, errors.New("keybase must be set prior to sending a transaction")
+	}
+
+	k, err := s.txf.Keybase().Key(name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get key %s: %w", name, err)
+	}
+	addr, err := k.GetAddress()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	acc, ok := s.accounts[addr.String()]
+	if !ok {
+		acc = &senderAccount{inFlight: make(chan struct{}, s.maxInFlight)}
+		s.accounts[addr.String()] = acc
+	}
+
+	return acc, addr, nil
+}
+
+// resync makes the next transaction of acc query its sequence.
+func (s *TxSender) resync(acc *senderAccount) {
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+
+	acc.synced = false
+}
+
+// sign builds the transaction of msgs and signs it with the key from and the
+// current sequence of acc, returning its bytes.
+func (s *TxSender) sign(ctx context.Context, from string, acc *senderAccount, msgs ...sdk.Msg) ([]byte, error) {
+	txf := s.txf.
+		WithFromName(from).
+		WithAccountNumber(acc.number).
+		WithSequence(acc.sequence)
+
+	txf, err := txf.PrepareGasPrices(s.clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := CalculateGas(s.clientCtx, txf, msgs...)
+		if err != nil {
+			return nil, err
+		}
+
+		txf = txf.WithGas(adjusted)
+	}
+
+	txBuilder, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if err := Sign(ctx, txf, from, txBuilder, true); err != nil {
+		return nil, err
+	}
+
+	return s.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+}
+
+// expectedSequence parses the sequence expected by the node from the log of a
+// transaction rejected for a sequence mismatch.
+func expectedSequence(log string) (uint64, bool) {
+	matches := sequenceMismatchRegexp.FindStringSubmatch(log)
+	if matches == nil {
+		return 0, false
+	}
+
+	seq, err := strconv.ParseUint(matches[1], 10, 64)
+	return seq, err == nil
+}
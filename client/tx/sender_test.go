@@ -0,0 +1,190 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// mockNode is a node accepting the transactions of a single account in
+// sequence order, and including them in a block after a few GetTx queries.
+type mockNode struct {
+	client.CometRPC
+
+	txConfig client.TxConfig
+	cdc      codec.Codec
+
+	mu       sync.Mutex
+	sequence uint64
+	received []uint64
+	queries  map[string]int
+	txs      map[string]*sdk.TxResponse
+}
+
+func (m *mockNode) BroadcastTxSync(_ context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	decoded, err := m.txConfig.TxDecoder()(tx)
+	if err != nil {
+		return nil, err
+	}
+	sigs, err := decoded.(interface {
+		GetSignaturesV2() ([]signing.SignatureV2, error)
+	}).GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+
+	if seq := sigs[0].Sequence; seq != m.sequence {
+		return &coretypes.ResultBroadcastTx{
+			Code:      sdkerrors.ErrWrongSequence.ABCICode(),
+			Codespace: sdkerrors.ErrWrongSequence.Codespace(),
+			Log:       fmt.Sprintf("account sequence mismatch, expected %d, got %d: incorrect account sequence", m.sequence, seq),
+			Hash:      tx.Hash(),
+		}, nil
+	}
+
+	m.received = append(m.received, m.sequence)
+	m.sequence++
+	hash := fmt.Sprintf("%X", tx.Hash())
+	m.txs[hash] = &sdk.TxResponse{TxHash: hash, Height: 1}
+	return &coretypes.ResultBroadcastTx{Hash: tx.Hash()}, nil
+}
+
+func (m *mockNode) ABCIQueryWithOptions(_ context.Context, path string, data bytes.HexBytes, _ rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if path != "/cosmos.tx.v1beta1.Service/GetTx" {
+		return nil, fmt.Errorf("unexpected query %s", path)
+	}
+	var req txtypes.GetTxRequest
+	if err := m.cdc.Unmarshal(data, &req); err != nil {
+		return nil, err
+	}
+
+	// the transactions are included after two queries
+	m.queries[req.Hash]++
+	res, ok := m.txs[req.Hash]
+	if !ok || m.queries[req.Hash] < 3 {
+		return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{
+			Code:      sdkerrors.ErrKeyNotFound.ABCICode(),
+			Codespace: sdkerrors.ErrKeyNotFound.Codespace(),
+			Log:       "tx not found",
+		}}, nil
+	}
+
+	bz, err := m.cdc.Marshal(&txtypes.GetTxResponse{TxResponse: res})
+	if err != nil {
+		return nil, err
+	}
+	return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: bz, Height: 1}}, nil
+}
+
+func TestTxSender(t *testing.T) {
+	encodingConfig := moduletestutil.MakeTestEncodingConfig()
+	banktypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	txCfg := authtx.NewTxConfig(codec.NewProtoCodec(encodingConfig.InterfaceRegistry), authtx.DefaultSignModes)
+
+	kb := keyring.NewInMemory(encodingConfig.Codec)
+	k, _, err := kb.NewMnemonic("sender", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+
+	// the node expects a sequence ahead of the queried one, e.g. because of
+	// transactions in its mempool
+	node := &mockNode{
+		txConfig: txCfg,
+		cdc:      encodingConfig.Codec,
+		sequence: 5,
+		queries:  make(map[string]int),
+		txs:      make(map[string]*sdk.TxResponse),
+	}
+	clientCtx := client.Context{}.
+		WithCodec(encodingConfig.Codec).
+		WithInterfaceRegistry(encodingConfig.InterfaceRegistry).
+		WithTxConfig(txCfg).
+		WithClient(node).
+		WithAccountRetriever(client.MockAccountRetriever{ReturnAccNum: 1, ReturnAccSeq: 3})
+	txf := Factory{}.
+		WithTxConfig(txCfg).
+		WithKeybase(kb).
+		WithChainID("test-chain").
+		WithGas(200000).
+		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+
+	sender := NewTxSender(clientCtx, txf, WithPollInterval(time.Millisecond), WithMaxInFlight(4))
+	msg := banktypes.NewMsgSend(addr, sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	const n = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			res, err := sender.Send(context.Background(), "sender", msg)
+			if err == nil && res.Height != 1 {
+				err = fmt.Errorf("unexpected height %d", res.Height)
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	// the sequences were re-synced once and incremented locally
+	require.Equal(t, []uint64{5, 6, 7, 8, 9, 10, 11, 12, 13, 14}, node.received)
+
+	// the sequence mismatches are retried a limited number of times
+	node.mu.Lock()
+	node.sequence = 100
+	node.mu.Unlock()
+	sender = NewTxSender(clientCtx, txf, WithMaxSequenceRetries(0))
+	res, err := sender.Broadcast(context.Background(), "sender", msg)
+	require.ErrorIs(t, err, sdkerrors.ErrWrongSequence)
+	require.Equal(t, sdkerrors.ErrWrongSequence.ABCICode(), res.Code)
+
+	sender = NewTxSender(clientCtx, txf, WithPollInterval(time.Millisecond), WithInclusionTimeout(10*time.Millisecond))
+	_, err = sender.WaitTx(context.Background(), "unknown")
+	require.ErrorContains(t, err, "timed out")
+
+	_, err = sender.Send(context.Background(), "unknown", msg)
+	require.ErrorContains(t, err, "unknown")
+}
+
+func TestExpectedSequence(t *testing.T) {
+	seq, ok := expectedSequence("account sequence mismatch, expected 12, got 10: incorrect account sequence")
+	require.True(t, ok)
+	require.Equal(t, uint64(12), seq)
+
+	_, ok = expectedSequence("insufficient funds")
+	require.False(t, ok)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/cosmos/cosmos-proto/anyutil"
 	"github.com/cosmos/gogoproto/jsonpb"
@@ -308,17 +309,17 @@ func (pc ProtoCodec) GetMsgAnySigners(msg *types.Any) ([][]byte, proto.Message,
 		return nil, nil, err
 	}
 
-	signers, err := pc.interfaceRegistry.SigningContext().GetSigners(msgv2)
+	signers, err := pc.getSigners(msgv2)
 	return signers, msgv2, err
 }
 
 func (pc *ProtoCodec) GetMsgV2Signers(msg proto.Message) ([][]byte, error) {
-	return pc.interfaceRegistry.SigningContext().GetSigners(msg)
+	return pc.getSigners(msg)
 }
 
 func (pc *ProtoCodec) GetMsgV1Signers(msg gogoproto.Message) ([][]byte, proto.Message, error) {
 	if msgV2, ok := msg.(proto.Message); ok {
-		signers, err := pc.interfaceRegistry.SigningContext().GetSigners(msgV2)
+		signers, err := pc.getSigners(msgV2)
 		return signers, msgV2, err
 	}
 	a, err := types.NewAnyWithValue(msg)
@@ -328,6 +329,17 @@ func (pc *ProtoCodec) GetMsgV1Signers(msg gogoproto.Message) ([][]byte, proto.Me
 	return pc.GetMsgAnySigners(a)
 }
 
+// signersMtx serializes the signer extraction of cosmossdk.io/x/tx v0.13, whose
+// GetSigners functions share state between calls and are thus not safe for
+// concurrent use, e.g. by the transactions of a block executed in parallel.
+var signersMtx sync.Mutex
+
+func (pc *ProtoCodec) getSigners(msg proto.Message) ([][]byte, error) {
+	signersMtx.Lock()
+	defer signersMtx.Unlock()
+	return pc.interfaceRegistry.SigningContext().GetSigners(msg)
+}
+
 // GRPCCodec returns the gRPC Codec for this specific ProtoCodec
 func (pc *ProtoCodec) GRPCCodec() encoding.Codec {
 	return &grpcProtoCodec{cdc: pc}
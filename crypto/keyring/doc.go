@@ -37,4 +37,15 @@
 //		be unlocked and it should be used only for testing purposes.
 //	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 //		are discarded when the process terminates or the type instance is garbage collected.
+//	remote	This backend stores references to the keys of remote signers unencrypted to disk,
+//		the signing being forwarded to the RemoteSigner gRPC services they point to. It
+//		cannot store private keys.
+//
+// # Remote signers
+//
+// Remote records, saved with SaveRemoteKey in any backend, reference a key of a remote
+// signer: Sign sends the bytes to sign and the sign mode to its RemoteSigner gRPC service,
+// over mutual TLS if Options.RemoteSignerTLS is set. NewRemoteSignerServer returns the
+// RemoteSignerServer signing with the keys of any Keyring, e.g. served by the
+// "keys serve-signer" command.
 package keyring
@@ -35,4 +35,12 @@ var (
 	ErrLegacyToRecord = errors.New("unable to convert LegacyInfo to Record")
 	// ErrUnknownLegacyType is raised when a LegacyInfo type is unknown.
 	ErrUnknownLegacyType = errors.New("unknown LegacyInfo type")
+	// ErrNotRemoteObj is raised when record.GetRemote() returns nil.
+	ErrNotRemoteObj = errors.New("not a remote object")
+	// ErrRemoteSignerPubKey is raised when a remote signer returns the public key of another key.
+	ErrRemoteSignerPubKey = errors.New("public key of the remote signer does not match the key")
+	// ErrRemoteInvalidSignature is raised when a remote signer generates an invalid signature.
+	ErrRemoteInvalidSignature = errors.New("remote signer generated an invalid signature")
+	// ErrRemoteBackendLocalKey is raised when storing a private key in the remote backend.
+	ErrRemoteBackendLocalKey = errors.New("the remote backend cannot store private keys")
 )
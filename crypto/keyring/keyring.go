@@ -2,6 +2,7 @@ package keyring
 
 import (
 	"bufio"
+	"crypto/tls"
 	"encoding/hex"
 	"fmt"
 	"io"
@@ -36,12 +37,14 @@ const (
 	BackendPass    = "pass"
 	BackendTest    = "test"
 	BackendMemory  = "memory"
+	BackendRemote  = "remote"
 )
 
 const (
-	keyringFileDirName = "keyring-file"
-	keyringTestDirName = "keyring-test"
-	passKeyringPrefix  = "keyring-%s"
+	keyringFileDirName   = "keyring-file"
+	keyringTestDirName   = "keyring-test"
+	keyringRemoteDirName = "keyring-remote"
+	passKeyringPrefix    = "keyring-%s"
 
 	// temporary pass phrase for exporting a key during a key rename
 	passPhrase = "temp"
@@ -56,7 +59,7 @@ var (
 
 // Keyring exposes operations over a backend supported by github.com/99designs/keyring.
 type Keyring interface {
-	// Get the backend type used in the keyring config: "file", "os", "kwallet", "pass", "test", "memory".
+	// Get the backend type used in the keyring config: "file", "os", "kwallet", "pass", "test", "memory", "remote".
 	Backend() string
 	// List all keys.
 	List() ([]*Record, error)
@@ -90,6 +93,10 @@ type Keyring interface {
 	// SaveLedgerKey retrieves a public key reference from a Ledger device and persists it.
 	SaveLedgerKey(uid string, algo SignatureAlgo, hrp string, coinType, account, index uint32) (*Record, error)
 
+	// SaveRemoteKey retrieves the public key of the key named remoteUID from the remote signer
+	// served at endpoint and persists a reference to it.
+	SaveRemoteKey(uid, endpoint, remoteUID string) (*Record, error)
+
 	// SaveOfflineKey stores a public key and returns the persisted Info structure.
 	SaveOfflineKey(uid string, pubkey types.PubKey) (*Record, error)
 
@@ -158,6 +165,9 @@ type Options struct {
 	// indicate whether Ledger should skip DER Conversion on signature,
 	// depending on which format (DER or BER) the Ledger app returns signatures
 	LedgerSigSkipDERConv bool
+	// TLS configuration of the connections to the remote signers, the connections
+	// being insecure if nil
+	RemoteSignerTLS *tls.Config
 }
 
 // NewInMemory creates a transient keyring useful for testing
@@ -175,7 +185,7 @@ func NewInMemoryWithKeyring(kr keyring.Keyring, cdc codec.Codec, opts ...Option)
 
 // New creates a new instance of a keyring.
 // Keyring options can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test".
+// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "remote".
 func New(
 	appName, backend, rootDir string, userInput io.Reader, cdc codec.Codec, opts ...Option,
 ) (Keyring, error) {
@@ -197,6 +207,8 @@ func New(
 		db, err = keyring.Open(newKWalletBackendKeyringConfig(appName, rootDir, userInput))
 	case BackendPass:
 		db, err = keyring.Open(newPassBackendKeyringConfig(appName, rootDir, userInput))
+	case BackendRemote:
+		db, err = keyring.Open(newRemoteBackendKeyringConfig(appName, rootDir))
 	default:
 		return nil, errorsmod.Wrap(ErrUnknownBacked, backend)
 	}
@@ -407,6 +419,9 @@ func (ks keystore) Sign(uid string, msg []byte, signMode signing.SignMode) ([]by
 	case k.GetLedger() != nil:
 		return SignWithLedger(k, msg, signMode)
 
+	case k.GetRemote() != nil:
+		return ks.signWithRemote(k, msg, signMode)
+
 		// multi or offline record
 	default:
 		pub, err := k.GetPubKey()
@@ -450,6 +465,20 @@ func (ks keystore) writeLedgerKey(name string, pk types.PubKey, path *hd.BIP44Pa
 	return k, ks.writeRecord(k)
 }
 
+func (ks keystore) SaveRemoteKey(uid, endpoint, remoteUID string) (*Record, error) {
+	pk, err := ks.remotePubKey(endpoint, remoteUID)
+	if err != nil {
+		return nil, err
+	}
+
+	k, err := NewRemoteRecord(uid, pk, endpoint, remoteUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return k, ks.writeRecord(k)
+}
+
 func (ks keystore) SaveMultisig(uid string, pubkey types.PubKey) (*Record, error) {
 	return ks.writeMultisigKey(uid, pubkey)
 }
@@ -685,6 +714,19 @@ func newTestBackendKeyringConfig(appName, dir string) keyring.Config {
 	}
 }
 
+// newRemoteBackendKeyringConfig returns the configuration of the remote backend,
+// storing the records unencrypted to disk as they do not hold private keys.
+func newRemoteBackendKeyringConfig(appName, dir string) keyring.Config {
+	return keyring.Config{
+		AllowedBackends: []keyring.BackendType{keyring.FileBackend},
+		ServiceName:     appName,
+		FileDir:         filepath.Join(dir, keyringRemoteDirName),
+		FilePasswordFunc: func(_ string) (string, error) {
+			return "remote", nil
+		},
+	}
+}
+
 func newKWalletBackendKeyringConfig(appName, _ string, _ io.Reader) keyring.Config {
 	return keyring.Config{
 		AllowedBackends: []keyring.BackendType{keyring.KWalletBackend},
@@ -799,6 +841,10 @@ func newRealPrompt(dir string, buf io.Reader) func(string) (string, error) {
 }
 
 func (ks keystore) writeLocalKey(name string, privKey types.PrivKey) (*Record, error) {
+	if ks.backend == BackendRemote {
+		return nil, ErrRemoteBackendLocalKey
+	}
+
 	k, err := NewLocalRecord(name, privKey, privKey.PubKey())
 	if err != nil {
 		return nil, err
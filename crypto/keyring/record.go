@@ -67,6 +67,14 @@ func NewMultiRecord(name string, pk cryptotypes.PubKey) (*Record, error) {
 	return newRecord(name, pk, recordMultiItem)
 }
 
+// NewRemoteRecord creates a new Record with remote item, referencing the key
+// named uid of the remote signer served at endpoint.
+func NewRemoteRecord(name string, pk cryptotypes.PubKey, endpoint, uid string) (*Record, error) {
+	recordRemote := &Record_Remote{endpoint, uid}
+	recordRemoteItem := &Record_Remote_{recordRemote}
+	return newRecord(name, pk, recordRemoteItem)
+}
+
 // GetPubKey fetches a public key of the record
 func (k *Record) GetPubKey() (cryptotypes.PubKey, error) {
 	pk, ok := k.PubKey.GetCachedValue().(cryptotypes.PubKey)
@@ -97,6 +105,8 @@ func (k Record) GetType() KeyType {
 		return TypeMulti
 	case k.GetOffline() != nil:
 		return TypeOffline
+	case k.GetRemote() != nil:
+		return TypeRemote
 	default:
 		panic("unrecognized record type")
 	}
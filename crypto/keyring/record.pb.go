@@ -38,6 +38,7 @@ type Record struct {
 	//	*Record_Ledger_
 	//	*Record_Multi_
 	//	*Record_Offline_
+	//	*Record_Remote_
 	Item isRecord_Item `protobuf_oneof:"item"`
 }
 
@@ -92,11 +93,15 @@ type Record_Multi_ struct {
 type Record_Offline_ struct {
 	Offline *Record_Offline `protobuf:"bytes,6,opt,name=offline,proto3,oneof" json:"offline,omitempty"`
 }
+type Record_Remote_ struct {
+	Remote *Record_Remote `protobuf:"bytes,7,opt,name=remote,proto3,oneof" json:"remote,omitempty"`
+}
 
 func (*Record_Local_) isRecord_Item()   {}
 func (*Record_Ledger_) isRecord_Item()  {}
 func (*Record_Multi_) isRecord_Item()   {}
 func (*Record_Offline_) isRecord_Item() {}
+func (*Record_Remote_) isRecord_Item()  {}
 
 func (m *Record) GetItem() isRecord_Item {
 	if m != nil {
@@ -133,6 +138,13 @@ func (m *Record) GetOffline() *Record_Offline {
 	return nil
 }
 
+func (m *Record) GetRemote() *Record_Remote {
+	if x, ok := m.GetItem().(*Record_Remote_); ok {
+		return x.Remote
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Record) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -140,6 +152,7 @@ func (*Record) XXX_OneofWrappers() []interface{} {
 		(*Record_Ledger_)(nil),
 		(*Record_Multi_)(nil),
 		(*Record_Offline_)(nil),
+		(*Record_Remote_)(nil),
 	}
 }
 
@@ -294,12 +307,54 @@ func (m *Record_Offline) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Record_Offline proto.InternalMessageInfo
 
+// Remote item
+type Record_Remote struct {
+	// endpoint is the address of the RemoteSigner gRPC service.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// uid is the name of the key in the keyring of the signer.
+	Uid string `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (m *Record_Remote) Reset()         { *m = Record_Remote{} }
+func (m *Record_Remote) String() string { return proto.CompactTextString(m) }
+func (*Record_Remote) ProtoMessage()    {}
+func (*Record_Remote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36d640103edea005, []int{0, 4}
+}
+func (m *Record_Remote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Record_Remote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Record_Remote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Record_Remote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Record_Remote.Merge(m, src)
+}
+func (m *Record_Remote) XXX_Size() int {
+	return m.Size()
+}
+func (m *Record_Remote) XXX_DiscardUnknown() {
+	xxx_messageInfo_Record_Remote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Record_Remote proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Record)(nil), "cosmos.crypto.keyring.v1.Record")
 	proto.RegisterType((*Record_Local)(nil), "cosmos.crypto.keyring.v1.Record.Local")
 	proto.RegisterType((*Record_Ledger)(nil), "cosmos.crypto.keyring.v1.Record.Ledger")
 	proto.RegisterType((*Record_Multi)(nil), "cosmos.crypto.keyring.v1.Record.Multi")
 	proto.RegisterType((*Record_Offline)(nil), "cosmos.crypto.keyring.v1.Record.Offline")
+	proto.RegisterType((*Record_Remote)(nil), "cosmos.crypto.keyring.v1.Record.Remote")
 }
 
 func init() {
@@ -307,33 +362,36 @@ func init() {
 }
 
 var fileDescriptor_36d640103edea005 = []byte{
-	// 411 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbd, 0xae, 0xd3, 0x30,
-	0x1c, 0xc5, 0x1d, 0x6e, 0x3e, 0xb8, 0x66, 0xb3, 0xee, 0x10, 0x22, 0x64, 0x55, 0x48, 0x40, 0x25,
-	0x54, 0x5b, 0x85, 0x0e, 0x4c, 0x95, 0x5a, 0x31, 0x14, 0x95, 0x8a, 0x2a, 0x23, 0x0b, 0xca, 0x87,
-	0x9b, 0x44, 0x4d, 0xe2, 0xc8, 0x49, 0x2a, 0xe5, 0x2d, 0x18, 0x79, 0xa4, 0x8e, 0x1d, 0x19, 0xa1,
-	0xd9, 0x78, 0x0a, 0x64, 0x3b, 0x1d, 0xa8, 0x04, 0x65, 0x8a, 0x23, 0xff, 0xce, 0xff, 0x9c, 0x63,
-	0xfd, 0xe1, 0x8b, 0x88, 0xd7, 0x05, 0xaf, 0x69, 0x24, 0xba, 0xaa, 0xe1, 0x74, 0xcf, 0x3a, 0x91,
-	0x95, 0x09, 0x3d, 0x4c, 0xa9, 0x60, 0x11, 0x17, 0x31, 0xa9, 0x04, 0x6f, 0x38, 0x72, 0x35, 0x46,
-	0x34, 0x46, 0x06, 0x8c, 0x1c, 0xa6, 0xde, 0x43, 0xc2, 0x13, 0xae, 0x20, 0x2a, 0x4f, 0x9a, 0xf7,
-	0x9e, 0x26, 0x9c, 0x27, 0x39, 0xa3, 0xea, 0x2f, 0x6c, 0x77, 0x34, 0x28, 0xbb, 0xe1, 0xea, 0xd9,
-	0x9f, 0x8e, 0x69, 0x2c, 0xcd, 0xd2, 0xc1, 0xe8, 0xf9, 0xaf, 0x3b, 0x68, 0xfb, 0xca, 0x19, 0x21,
-	0x68, 0x96, 0x41, 0xc1, 0x5c, 0x63, 0x64, 0x8c, 0xef, 0x7d, 0x75, 0x46, 0x13, 0xe8, 0x54, 0x6d,
-	0xf8, 0x65, 0xcf, 0x3a, 0xf7, 0xd1, 0xc8, 0x18, 0x3f, 0x79, 0xf3, 0x40, 0xb4, 0x13, 0xb9, 0x38,
-	0x91, 0x45, 0xd9, 0xf9, 0x76, 0xd5, 0x86, 0x6b, 0xd6, 0xa1, 0x39, 0xb4, 0x72, 0x1e, 0x05, 0xb9,
-	0x7b, 0xa7, 0xe0, 0x97, 0xe4, 0x6f, 0x35, 0x88, 0xf6, 0x24, 0x1f, 0x25, 0xbd, 0x02, 0xbe, 0x96,
-	0xa1, 0x05, 0xb4, 0x73, 0x16, 0x27, 0x4c, 0xb8, 0xa6, 0x1a, 0xf0, 0xea, 0xf6, 0x00, 0x85, 0xaf,
-	0x80, 0x3f, 0x08, 0x65, 0x84, 0xa2, 0xcd, 0x9b, 0xcc, 0xb5, 0xfe, 0x33, 0xc2, 0x46, 0xd2, 0x32,
-	0x82, 0x92, 0xa1, 0xf7, 0xd0, 0xe1, 0xbb, 0x5d, 0x9e, 0x95, 0xcc, 0xb5, 0xd5, 0x84, 0xf1, 0xcd,
-	0x09, 0x9f, 0x34, 0xbf, 0x02, 0xfe, 0x45, 0xea, 0xbd, 0x83, 0x96, 0xaa, 0x86, 0x28, 0x7c, 0x5c,
-	0x89, 0xec, 0xa0, 0x5e, 0xd0, 0xf8, 0xc7, 0x0b, 0x3a, 0x92, 0x5a, 0xb3, 0xce, 0x9b, 0x43, 0x5b,
-	0x77, 0x42, 0x33, 0x68, 0x56, 0x41, 0x93, 0x0e, 0xb2, 0xd1, 0x55, 0x8c, 0x34, 0x96, 0x09, 0x96,
-	0x1f, 0xb6, 0xb3, 0xd9, 0x36, 0x10, 0x41, 0x51, 0xfb, 0x8a, 0xf6, 0x1c, 0x68, 0xa9, 0x46, 0xde,
-	0x3d, 0x74, 0x86, 0x60, 0x4b, 0x1b, 0x9a, 0x59, 0xc3, 0x8a, 0xe5, 0xe6, 0xf8, 0x13, 0x83, 0xe3,
-	0x19, 0x1b, 0xa7, 0x33, 0x36, 0x7e, 0x9c, 0xb1, 0xf1, 0xb5, 0xc7, 0xe0, 0x5b, 0x8f, 0xc1, 0xa9,
-	0xc7, 0xe0, 0x7b, 0x8f, 0xc1, 0xe7, 0xd7, 0x49, 0xd6, 0xa4, 0x6d, 0x48, 0x22, 0x5e, 0xd0, 0xcb,
-	0xde, 0xa8, 0xcf, 0xa4, 0x8e, 0xf7, 0x57, 0x4b, 0x1b, 0xda, 0xaa, 0xc1, 0xdb, 0xdf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x47, 0x24, 0x2f, 0xa9, 0xd4, 0x02, 0x00, 0x00,
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x13, 0x37, 0x4d, 0xb6, 0xcf, 0x8b, 0x0c, 0x7b, 0x88, 0x41, 0x42, 0x11, 0xd4, 0x82,
+	0xec, 0x0c, 0xab, 0x45, 0x3c, 0x2d, 0x6c, 0xf1, 0x50, 0x59, 0x17, 0x97, 0x39, 0x7a, 0x91, 0xfc,
+	0x98, 0x26, 0xa1, 0x49, 0x26, 0x4c, 0x93, 0x42, 0xfe, 0x0b, 0x8f, 0xfe, 0x49, 0x7b, 0xdc, 0xa3,
+	0x47, 0x6d, 0xf1, 0xff, 0x90, 0x79, 0x93, 0x0a, 0x2e, 0xb8, 0xdb, 0x53, 0x67, 0xe8, 0xe7, 0xfb,
+	0xbe, 0xef, 0xfb, 0xe6, 0x05, 0x5e, 0x24, 0x72, 0x5d, 0xc9, 0x35, 0x4b, 0x54, 0xdf, 0xb4, 0x92,
+	0xad, 0x44, 0xaf, 0x8a, 0x3a, 0x63, 0x9b, 0x33, 0xa6, 0x44, 0x22, 0x55, 0x4a, 0x1b, 0x25, 0x5b,
+	0x49, 0x7c, 0x83, 0x51, 0x83, 0xd1, 0x01, 0xa3, 0x9b, 0xb3, 0xe0, 0x24, 0x93, 0x99, 0x44, 0x88,
+	0xe9, 0x93, 0xe1, 0x83, 0xa7, 0x99, 0x94, 0x59, 0x29, 0x18, 0xde, 0xe2, 0x6e, 0xc9, 0xa2, 0xba,
+	0x1f, 0xfe, 0x7a, 0xf6, 0xaf, 0x63, 0x9e, 0x6a, 0xb3, 0x7c, 0x30, 0x7a, 0xfe, 0xdb, 0x01, 0x97,
+	0xa3, 0x33, 0x21, 0xe0, 0xd4, 0x51, 0x25, 0x7c, 0x7b, 0x62, 0x4f, 0xc7, 0x1c, 0xcf, 0xe4, 0x14,
+	0xbc, 0xa6, 0x8b, 0xbf, 0xae, 0x44, 0xef, 0x3f, 0x9a, 0xd8, 0xd3, 0xc7, 0x6f, 0x4e, 0xa8, 0x71,
+	0xa2, 0x7b, 0x27, 0x7a, 0x51, 0xf7, 0xdc, 0x6d, 0xba, 0xf8, 0x52, 0xf4, 0xe4, 0x1c, 0x46, 0xa5,
+	0x4c, 0xa2, 0xd2, 0x3f, 0x42, 0xf8, 0x25, 0xfd, 0x5f, 0x0c, 0x6a, 0x3c, 0xe9, 0x27, 0x4d, 0x2f,
+	0x2c, 0x6e, 0x64, 0xe4, 0x02, 0xdc, 0x52, 0xa4, 0x99, 0x50, 0xbe, 0x83, 0x05, 0x5e, 0x3d, 0x5c,
+	0x00, 0xf1, 0x85, 0xc5, 0x07, 0xa1, 0x6e, 0xa1, 0xea, 0xca, 0xb6, 0xf0, 0x47, 0x07, 0xb6, 0x70,
+	0xa5, 0x69, 0xdd, 0x02, 0xca, 0xc8, 0x07, 0xf0, 0xe4, 0x72, 0x59, 0x16, 0xb5, 0xf0, 0x5d, 0xac,
+	0x30, 0x7d, 0xb0, 0xc2, 0x67, 0xc3, 0x2f, 0x2c, 0xbe, 0x97, 0xea, 0x20, 0x4a, 0x54, 0xb2, 0x15,
+	0xbe, 0x77, 0x60, 0x10, 0x8e, 0xb8, 0x0e, 0x62, 0x84, 0xc1, 0x7b, 0x18, 0xe1, 0x74, 0x08, 0x83,
+	0xe3, 0x46, 0x15, 0x1b, 0x7c, 0x04, 0xfb, 0x9e, 0x47, 0xf0, 0x34, 0x75, 0x29, 0xfa, 0xe0, 0x1c,
+	0x5c, 0x33, 0x16, 0x32, 0x03, 0xa7, 0x89, 0xda, 0x7c, 0x90, 0x4d, 0xee, 0x34, 0x91, 0xa7, 0xda,
+	0x7f, 0xfe, 0xf1, 0x7a, 0x36, 0xbb, 0x8e, 0x54, 0x54, 0xad, 0x39, 0xd2, 0x81, 0x07, 0x23, 0x1c,
+	0x4a, 0x30, 0x06, 0x6f, 0xc8, 0x16, 0xbc, 0xd3, 0x6b, 0xa2, 0xfb, 0x22, 0x01, 0x1c, 0x8b, 0x3a,
+	0x6d, 0x64, 0x51, 0xb7, 0xc3, 0xaa, 0xfc, 0xbd, 0x93, 0x27, 0x70, 0xd4, 0x15, 0x29, 0xae, 0xca,
+	0x98, 0xeb, 0xe3, 0xdc, 0x05, 0xa7, 0x68, 0x45, 0x35, 0xbf, 0xba, 0xf9, 0x15, 0x5a, 0x37, 0xdb,
+	0xd0, 0xbe, 0xdd, 0x86, 0xf6, 0xcf, 0x6d, 0x68, 0x7f, 0xdb, 0x85, 0xd6, 0xf7, 0x5d, 0x68, 0xdd,
+	0xee, 0x42, 0xeb, 0xc7, 0x2e, 0xb4, 0xbe, 0xbc, 0xce, 0x8a, 0x36, 0xef, 0x62, 0x9a, 0xc8, 0x8a,
+	0xed, 0x57, 0x16, 0x7f, 0x4e, 0xd7, 0xe9, 0xea, 0xce, 0xf7, 0x12, 0xbb, 0x98, 0xfc, 0xed, 0x9f,
+	0x01, 0x00, 0xa1, 0x2a, 0x63, 0x78, 0x4f, 0x03, 0x00, 0x00,
 }
 
 func (m *Record) Marshal() (dAtA []byte, err error) {
@@ -471,6 +529,27 @@ func (m *Record_Offline_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Record_Remote_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Record_Remote_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Remote != nil {
+		{
+			size, err := m.Remote.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *Record_Local) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -587,6 +666,43 @@ func (m *Record_Offline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Record_Remote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Record_Remote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Record_Remote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Endpoint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRecord(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRecord(v)
 	base := offset
@@ -666,6 +782,18 @@ func (m *Record_Offline_) Size() (n int) {
 	}
 	return n
 }
+func (m *Record_Remote_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Remote != nil {
+		l = m.Remote.Size()
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	return n
+}
 func (m *Record_Local) Size() (n int) {
 	if m == nil {
 		return 0
@@ -710,6 +838,23 @@ func (m *Record_Offline) Size() (n int) {
 	return n
 }
 
+func (m *Record_Remote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	return n
+}
+
 func sovRecord(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -953,6 +1098,41 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 			}
 			m.Item = &Record_Offline_{v}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Record_Remote{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Item = &Record_Remote_{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecord(dAtA[iNdEx:])
@@ -1246,6 +1426,120 @@ func (m *Record_Offline) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Record_Remote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Remote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Remote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRecord(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,221 @@
+package keyring
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// remoteSignerTimeout is the timeout of the requests to the remote signers.
+const remoteSignerTimeout = time.Minute
+
+var _ RemoteSignerServer = remoteSignerServer{}
+
+// signWithRemote signs msg with the remote signer referenced by a remote record,
+// checking the signature against the public key of the record.
+func (ks keystore) signWithRemote(k *Record, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	remote := k.GetRemote()
+	if remote == nil {
+		return nil, nil, ErrNotRemoteObj
+	}
+
+	pubKey, err := k.GetPubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var res *SignResponse
+	err = ks.withRemoteSigner(remote.Endpoint, func(ctx context.Context, client RemoteSignerClient) error {
+		res, err = client.Sign(ctx, &SignRequest{Uid: remote.Uid, Msg: msg, SignMode: signMode})
+		return err
+	})
+	if err != nil {
+		return nil, nil, errorsmod.Wrapf(err, "failed to sign with remote signer %s", remote.Endpoint)
+	}
+
+	var remotePubKey types.PubKey
+	if err := ks.cdc.UnpackAny(res.PubKey, &remotePubKey); err != nil {
+		return nil, nil, err
+	}
+	if !pubKey.Equals(remotePubKey) {
+		return nil, nil, errorsmod.Wrapf(ErrRemoteSignerPubKey, "%v does not match %v", pubKey, remotePubKey)
+	}
+	if !pubKey.VerifySignature(msg, res.Signature) {
+		return nil, nil, ErrRemoteInvalidSignature
+	}
+
+	return res.Signature, pubKey, nil
+}
+
+// remotePubKey returns the public key of the key named uid of a remote signer.
+func (ks keystore) remotePubKey(endpoint, uid string) (types.PubKey, error) {
+	var res *PubKeyResponse
+	err := ks.withRemoteSigner(endpoint, func(ctx context.Context, client RemoteSignerClient) (err error) {
+		res, err = client.PubKey(ctx, &PubKeyRequest{Uid: uid})
+		return err
+	})
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to get public key from remote signer %s", endpoint)
+	}
+
+	var pubKey types.PubKey
+	if err := ks.cdc.UnpackAny(res.PubKey, &pubKey); err != nil {
+		return nil, err
+	}
+
+	return pubKey, nil
+}
+
+// withRemoteSigner calls fn with a client of the remote signer served at endpoint.
+func (ks keystore) withRemoteSigner(endpoint string, fn func(context.Context, RemoteSignerClient) error) error {
+	creds := insecure.NewCredentials()
+	if ks.options.RemoteSignerTLS != nil {
+		creds = credentials.NewTLS(ks.options.RemoteSignerTLS)
+	}
+
+	conn, err := grpc.NewClient(
+		endpoint,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec.NewProtoCodec(ks.cdc.InterfaceRegistry()).GRPCCodec())),
+	)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	return fn(ctx, NewRemoteSignerClient(conn))
+}
+
+// remoteSignerServer is the RemoteSignerServer signing with the keys of a keyring.
+type remoteSignerServer struct {
+	kr Keyring
+}
+
+// NewRemoteSignerServer returns a RemoteSignerServer signing with the keys of
+// kr, whatever its backend, e.g. to serve them to the keyrings holding remote
+// records.
+func NewRemoteSignerServer(kr Keyring) RemoteSignerServer {
+	return remoteSignerServer{kr: kr}
+}
+
+// PubKey implements RemoteSignerServer.
+func (s remoteSignerServer) PubKey(_ context.Context, req *PubKeyRequest) (*PubKeyResponse, error) {
+	k, err := s.kr.Key(req.Uid)
+	if err != nil {
+		return nil, remoteSignerError(err)
+	}
+
+	return &PubKeyResponse{PubKey: k.PubKey}, nil
+}
+
+// Sign implements RemoteSignerServer.
+func (s remoteSignerServer) Sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	sig, pubKey, err := s.kr.Sign(req.Uid, req.Msg, req.SignMode)
+	if err != nil {
+		return nil, remoteSignerError(err)
+	}
+
+	pubKeyAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignResponse{Signature: sig, PubKey: pubKeyAny}, nil
+}
+
+// remoteSignerError returns the gRPC status of a keyring error.
+func remoteSignerError(err error) error {
+	switch {
+	case errors.Is(err, sdkerrors.ErrKeyNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrOfflineSign):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ErrInvalidSignMode):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// NewRemoteSignerClientTLSConfig returns the TLS configuration of the
+// connections to the remote signers, authenticating with the certificate and
+// key in certFile and keyFile, the remote signers being authenticated with the
+// certificate authorities in caFile, or the system ones if empty.
+func NewRemoteSignerClientTLSConfig(certFile, keyFile, caFile string) (*tls.Config, error) {
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	if caFile != "" {
+		pool, err := loadCertPool(caFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = pool
+	}
+
+	return config, nil
+}
+
+// NewRemoteSignerServerTLSConfig returns the TLS configuration of a remote
+// signer, serving the certificate and key in certFile and keyFile, and
+// requiring the clients to authenticate with a certificate of the certificate
+// authorities in clientCAFile.
+func NewRemoteSignerServerTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := loadCertPool(clientCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    pool,
+	}, nil
+}
+
+func loadCertPool(file string) (*x509.CertPool, error) {
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bz) {
+		return nil, fmt.Errorf("no certificate found in %s", file)
+	}
+
+	return pool, nil
+}
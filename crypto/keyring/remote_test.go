@@ -0,0 +1,202 @@
+package keyring
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// startRemoteSigner serves the keys of kr in process, returning the endpoint
+// of the signer.
+func startRemoteSigner(t *testing.T, kr Keyring, cdc codec.Codec, tlsConfig *tls.Config) string {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	opts := []grpc.ServerOption{grpc.ForceServerCodec(codec.NewProtoCodec(cdc.InterfaceRegistry()).GRPCCodec())}
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	srv := grpc.NewServer(opts...)
+	RegisterRemoteSignerServer(srv, NewRemoteSignerServer(kr))
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	return lis.Addr().String()
+}
+
+func TestRemoteSigner(t *testing.T) {
+	cdc := getCodec()
+	signer := NewInMemory(cdc)
+	_, _, err := signer.NewMnemonic("signer-key", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = signer.SaveOfflineKey("offline-key", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	endpoint := startRemoteSigner(t, signer, cdc, nil)
+
+	dir := t.TempDir()
+	kr, err := New("remote", BackendRemote, dir, nil, cdc)
+	require.NoError(t, err)
+
+	k, err := kr.SaveRemoteKey("key", endpoint, "signer-key")
+	require.NoError(t, err)
+	require.Equal(t, TypeRemote, k.GetType())
+	require.Equal(t, &Record_Remote{Endpoint: endpoint, Uid: "signer-key"}, k.GetRemote())
+	signerKey, err := signer.Key("signer-key")
+	require.NoError(t, err)
+	require.Equal(t, signerKey.PubKey, k.PubKey)
+
+	_, err = kr.SaveRemoteKey("unknown", endpoint, "unknown")
+	require.ErrorContains(t, err, "NotFound")
+
+	// the remote backend does not store private keys
+	_, _, err = kr.NewMnemonic("local", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.ErrorIs(t, err, ErrRemoteBackendLocalKey)
+
+	// the records are persisted
+	kr, err = New("remote", BackendRemote, dir, nil, cdc)
+	require.NoError(t, err)
+	k, err = kr.Key("key")
+	require.NoError(t, err)
+	require.Equal(t, TypeRemote, k.GetType())
+
+	msg := []byte("message")
+	for _, signMode := range []signing.SignMode{signing.SignMode_SIGN_MODE_DIRECT, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON} {
+		sig, pubKey, err := kr.Sign("key", msg, signMode)
+		require.NoError(t, err)
+		require.True(t, pubKey.VerifySignature(msg, sig))
+
+		addr, err := k.GetAddress()
+		require.NoError(t, err)
+		sigByAddress, _, err := kr.SignByAddress(addr, msg, signMode)
+		require.NoError(t, err)
+		require.True(t, pubKey.VerifySignature(msg, sigByAddress))
+	}
+
+	// the remote signer must sign with the key of the record
+	_, err = kr.SaveRemoteKey("offline", endpoint, "offline-key")
+	require.NoError(t, err)
+	_, _, err = kr.Sign("offline", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorContains(t, err, "FailedPrecondition")
+
+	require.NoError(t, signer.Delete("signer-key"))
+	_, _, err = signer.NewMnemonic("signer-key", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, _, err = kr.Sign("key", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrRemoteSignerPubKey)
+
+	// the remote records can be stored in the other backends
+	kr = NewInMemory(cdc)
+	_, err = kr.SaveRemoteKey("key", endpoint, "signer-key")
+	require.NoError(t, err)
+	sig, pubKey, err := kr.Sign("key", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+}
+
+func TestRemoteSignerTLS(t *testing.T) {
+	dir := t.TempDir()
+	serverCA := writeTestCertificate(t, dir, "server")
+	writeTestCertificate(t, dir, "client")
+	writeTestCertificate(t, dir, "other")
+
+	cdc := getCodec()
+	signer := NewInMemory(cdc)
+	_, _, err := signer.NewMnemonic("key", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	path := func(name string) string { return filepath.Join(dir, name) }
+	serverTLS, err := NewRemoteSignerServerTLSConfig(path("server.crt"), path("server.key"), path("client.crt"))
+	require.NoError(t, err)
+	endpoint := startRemoteSigner(t, signer, cdc, serverTLS)
+
+	clientTLS, err := NewRemoteSignerClientTLSConfig(path("client.crt"), path("client.key"), path("server.crt"))
+	require.NoError(t, err)
+	kr := NewInMemory(cdc, func(options *Options) { options.RemoteSignerTLS = clientTLS })
+	_, err = kr.SaveRemoteKey("key", endpoint, "key")
+	require.NoError(t, err)
+	_, _, err = kr.Sign("key", []byte("message"), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	// the clients must authenticate with a certificate of the client certificate authorities
+	for _, clientTLS := range []*tls.Config{
+		{RootCAs: serverCA},
+		mustClientTLSConfig(t, path("other.crt"), path("other.key"), path("server.crt")),
+	} {
+		kr := NewInMemory(cdc, func(options *Options) { options.RemoteSignerTLS = clientTLS })
+		_, err = kr.SaveRemoteKey("key", endpoint, "key")
+		require.Error(t, err)
+	}
+
+	// the signer must be authenticated
+	kr = NewInMemory(cdc, func(options *Options) {
+		options.RemoteSignerTLS = mustClientTLSConfig(t, path("client.crt"), path("client.key"), path("other.crt"))
+	})
+	_, err = kr.SaveRemoteKey("key", endpoint, "key")
+	require.Error(t, err)
+
+	_, err = NewRemoteSignerServerTLSConfig(path("server.crt"), path("server.key"), path("server.key"))
+	require.ErrorContains(t, err, "no certificate found")
+}
+
+func mustClientTLSConfig(t *testing.T, certFile, keyFile, caFile string) *tls.Config {
+	t.Helper()
+
+	config, err := NewRemoteSignerClientTLSConfig(certFile, keyFile, caFile)
+	require.NoError(t, err)
+	return config
+}
+
+// writeTestCertificate writes a self-signed certificate valid for 127.0.0.1
+// and its key to <name>.crt and <name>.key in dir.
+func writeTestCertificate(t *testing.T, dir, name string) *x509.CertPool {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".crt"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return pool
+}
@@ -3,10 +3,13 @@ package config
 import (
 	"fmt"
 	"math"
+	"strings"
 
 	"github.com/spf13/viper"
 
+	"cosmossdk.io/store/historical"
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -46,6 +49,10 @@ type BaseConfig struct {
 	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
 	PruningInterval   string `mapstructure:"pruning-interval"`
 
+	// PruningStores overrides the pruning options of some stores, each entry being
+	// either "<store>:<strategy>" or "<store>:custom:<keep-recent>:<interval>".
+	PruningStores []string `mapstructure:"pruning-stores"`
+
 	// HaltHeight contains a non-zero block height at which a node will gracefully
 	// halt and shutdown that can be used to assist upgrades and testing.
 	//
@@ -156,6 +163,9 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// SnapshotFormat sets the format of the state sync snapshots taken.
+	SnapshotFormat uint32 `mapstructure:"snapshot-format"`
 }
 
 // MempoolConfig defines the configurations for the SDK built-in app-side mempool
@@ -166,19 +176,116 @@ type MempoolConfig struct {
 	// unbounded in how many txs it may contain, and a positive value indicates
 	// the maximum amount of txs it may contain.
 	MaxTxs int `mapstructure:"max-txs"`
+
+	// TxSelector defines the strategy used to select the mempool transactions
+	// included in a block proposal: "default", "fee" or "sender-quota".
+	TxSelector string `mapstructure:"tx-selector"`
+
+	// MaxTxsPerSender defines the maximum number of transactions of a single
+	// signer selected for a block proposal by the "sender-quota" TxSelector.
+	MaxTxsPerSender int `mapstructure:"max-txs-per-sender"`
+
+	// Lanes defines the proposal lanes reserving a share of the block space for
+	// the transactions of given message types.
+	Lanes []ProposalLaneConfig `mapstructure:"lanes"`
+}
+
+// ProposalLaneConfig defines a proposal lane of the default proposal handler.
+type ProposalLaneConfig struct {
+	// Name identifies the lane.
+	Name string `mapstructure:"name"`
+
+	// MaxBlockSpace defines the share of the block bytes and gas reserved for
+	// the lane, in (0, 1].
+	MaxBlockSpace string `mapstructure:"max-block-space"`
+
+	// MsgTypes defines the type URLs of the messages of the lane's
+	// transactions.
+	MsgTypes []string `mapstructure:"msg-types"`
+}
+
+// HistoricalQueriesConfig defines the configuration of the queries at past
+// heights, served from a cache of the versions loaded.
+type HistoricalQueriesConfig struct {
+	// Enable defines if the versions loaded for queries at past heights are cached.
+	Enable bool `mapstructure:"enable"`
+
+	// CacheSize defines the number of versions kept loaded.
+	CacheSize int `mapstructure:"cache-size"`
+
+	// MaxConcurrentQueries defines the number of queries served at once at a
+	// single past height, 0 for no limit.
+	MaxConcurrentQueries int `mapstructure:"max-concurrent-queries"`
+
+	// MinHeight defines the lowest queryable height, 0 for no bound.
+	MinHeight int64 `mapstructure:"min-height"`
+
+	// MaxDepth defines the number of heights below the latest one that are
+	// queryable, 0 for no bound.
+	MaxDepth int64 `mapstructure:"max-depth"`
+}
+
+// OptimisticExecutionConfig defines the node configuration of optimistic
+// execution. It only applies if the application enables optimistic execution.
+type OptimisticExecutionConfig struct {
+	// Telemetry defines if optimistic execution metrics are emitted: the number
+	// of executions, hits and aborts, the hit rate and the time wasted by
+	// aborted executions.
+	Telemetry bool `mapstructure:"telemetry"`
+
+	// StartOnFirstProcessProposal defines if optimistic execution is only
+	// started on the first ProcessProposal call for a proposal, keeping the
+	// execution when the same block is processed again in a later round.
+	StartOnFirstProcessProposal bool `mapstructure:"start-on-first-process-proposal"`
 }
 
 // State Streaming configuration
 type (
 	// StreamingConfig defines application configuration for external streaming services
 	StreamingConfig struct {
-		ABCI ABCIListenerConfig `mapstructure:"abci"`
+		ABCI  ABCIListenerConfig   `mapstructure:"abci"`
+		File  FileStreamingConfig  `mapstructure:"file"`
+		Kafka KafkaStreamingConfig `mapstructure:"kafka"`
+		Async AsyncStreamingConfig `mapstructure:"async"`
 	}
 	// ABCIListenerConfig defines application configuration for ABCIListener streaming service
 	ABCIListenerConfig struct {
 		Keys          []string `mapstructure:"keys"`
 		Plugin        string   `mapstructure:"plugin"`
 		StopNodeOnErr bool     `mapstructure:"stop-node-on-err"`
+		Filters       []string `mapstructure:"filters"`
+		Async         bool     `mapstructure:"async"`
+	}
+	// FileStreamingConfig defines application configuration for the built-in
+	// listener streaming blocks to files
+	FileStreamingConfig struct {
+		Enable      bool     `mapstructure:"enable"`
+		Dir         string   `mapstructure:"dir"`
+		MaxFileSize uint64   `mapstructure:"max-file-size"`
+		Fsync       bool     `mapstructure:"fsync"`
+		AllowGaps   bool     `mapstructure:"allow-gaps"`
+		Filters     []string `mapstructure:"filters"`
+		Async       bool     `mapstructure:"async"`
+	}
+	// KafkaStreamingConfig defines application configuration for the built-in
+	// listener streaming blocks to a Kafka topic
+	KafkaStreamingConfig struct {
+		Enable    bool     `mapstructure:"enable"`
+		Brokers   []string `mapstructure:"brokers"`
+		Topic     string   `mapstructure:"topic"`
+		Partition int32    `mapstructure:"partition"`
+		ClientID  string   `mapstructure:"client-id"`
+		Timeout   uint     `mapstructure:"timeout"`
+		SpoolDir  string   `mapstructure:"spool-dir"`
+		AllowGaps bool     `mapstructure:"allow-gaps"`
+		Filters   []string `mapstructure:"filters"`
+		Async     bool     `mapstructure:"async"`
+	}
+	// AsyncStreamingConfig defines application configuration for the
+	// asynchronous delivery to the streaming listeners enabling it
+	AsyncStreamingConfig struct {
+		BufferSize uint   `mapstructure:"buffer-size"`
+		Policy     string `mapstructure:"policy"`
 	}
 )
 
@@ -194,6 +301,9 @@ type Config struct {
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
 	Streaming StreamingConfig  `mapstructure:"streaming"`
 	Mempool   MempoolConfig    `mapstructure:"mempool"`
+
+	OptimisticExecution OptimisticExecutionConfig `mapstructure:"optimistic-execution"`
+	HistoricalQueries   HistoricalQueriesConfig   `mapstructure:"historical-queries"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -225,6 +335,7 @@ func DefaultConfig() *Config {
 			Pruning:             pruningtypes.PruningOptionDefault,
 			PruningKeepRecent:   "0",
 			PruningInterval:     "0",
+			PruningStores:       []string{},
 			MinRetainBlocks:     0,
 			IndexEvents:         make([]string, 0),
 			IAVLCacheSize:       781250,
@@ -255,15 +366,40 @@ func DefaultConfig() *Config {
 		StateSync: StateSyncConfig{
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
+			SnapshotFormat:     snapshottypes.CurrentFormat,
 		},
 		Streaming: StreamingConfig{
 			ABCI: ABCIListenerConfig{
 				Keys:          []string{},
 				StopNodeOnErr: true,
+				Filters:       []string{},
+			},
+			File: FileStreamingConfig{
+				Dir:         "data/streaming/file",
+				MaxFileSize: 128 << 20,
+				Filters:     []string{},
+			},
+			Kafka: KafkaStreamingConfig{
+				Brokers:  []string{"localhost:9092"},
+				Topic:    "blocks",
+				ClientID: "cosmos-sdk",
+				Timeout:  10,
+				SpoolDir: "data/streaming/kafka",
+				Filters:  []string{},
+			},
+			Async: AsyncStreamingConfig{
+				BufferSize: 100,
+				Policy:     "block",
 			},
 		},
 		Mempool: MempoolConfig{
-			MaxTxs: -1,
+			MaxTxs:     -1,
+			TxSelector: "default",
+		},
+		HistoricalQueries: HistoricalQueriesConfig{
+			Enable:               false,
+			CacheSize:            historical.DefaultOptions().CacheSize,
+			MaxConcurrentQueries: historical.DefaultOptions().MaxConcurrentQueries,
 		},
 	}
 }
@@ -287,6 +423,23 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", pruningtypes.PruningOptionEverything,
 		)
 	}
+	for _, override := range c.PruningStores {
+		parts := strings.Split(override, ":")
+		if len(parts) == 2 && parts[1] == pruningtypes.PruningOptionEverything && c.StateSync.SnapshotInterval > 0 {
+			return sdkerrors.ErrAppConfig.Wrapf(
+				"cannot enable state sync snapshots with '%s' pruning setting of store %s", pruningtypes.PruningOptionEverything, parts[0],
+			)
+		}
+	}
+	// a zero format, e.g. from an app.toml predating snapshot-format, takes snapshots in the current format
+	switch c.StateSync.SnapshotFormat {
+	case 0, snapshottypes.CurrentFormat, snapshottypes.ParallelFormat:
+	default:
+		return sdkerrors.ErrAppConfig.Wrapf("unsupported state sync snapshot format %d", c.StateSync.SnapshotFormat)
+	}
+	if c.HistoricalQueries.Enable && c.HistoricalQueries.CacheSize <= 0 {
+		return sdkerrors.ErrAppConfig.Wrap("historical-queries.cache-size must be positive")
+	}
 
 	return nil
 }
@@ -51,6 +51,14 @@ func TestStreamingConfig(t *testing.T) {
 				Keys:          []string{"one", "two"},
 				Plugin:        "plugin-A",
 				StopNodeOnErr: false,
+				Filters:       []string{"one/02"},
+			},
+			File: FileStreamingConfig{
+				Filters: []string{"one", "two/0102"},
+			},
+			Kafka: KafkaStreamingConfig{
+				Brokers: []string{"kafka-1:9092", "kafka-2:9092"},
+				Filters: []string{"two"},
 			},
 		},
 	}
@@ -68,6 +76,10 @@ func TestStreamingConfig(t *testing.T) {
 		`keys = ["one", "two", ]`,
 		`plugin = "plugin-A"`,
 		`stop-node-on-err = false`,
+		`filters = ["one/02", ]`,
+		`filters = ["one", "two/0102", ]`,
+		`brokers = ["kafka-1:9092", "kafka-2:9092", ]`,
+		`filters = ["two", ]`,
 	}
 
 	for _, line := range expectedLines {
@@ -193,6 +205,30 @@ func TestGlobalLabelsWriteRead(t *testing.T) {
 	require.Equal(t, expected, actual, "config value")
 }
 
+func TestMempoolLanesWriteRead(t *testing.T) {
+	expected := []ProposalLaneConfig{
+		{Name: "oracle", MaxBlockSpace: "0.1", MsgTypes: []string{"/cosmos.bank.v1beta1.MsgSend"}},
+		{Name: "staking", MaxBlockSpace: "0.25", MsgTypes: []string{"/cosmos.staking.v1beta1.MsgDelegate", "/cosmos.staking.v1beta1.MsgUndelegate"}},
+	}
+
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := DefaultConfig()
+	conf.Mempool.Lanes = expected
+	WriteConfigFile(confFile, conf)
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig(), "reading config file into viper")
+	require.Len(t, vpr.Get("mempool.lanes"), len(expected), "viper value")
+
+	// the lane tables do not swallow the sections following them
+	require.True(t, vpr.IsSet("optimistic-execution.telemetry"))
+	cfg, err := ParseConfig(vpr)
+	require.NoError(t, err, "parsing config")
+	require.Equal(t, expected, cfg.Mempool.Lanes, "config value")
+	require.Equal(t, conf.OptimisticExecution, cfg.OptimisticExecution)
+}
+
 func TestSetConfigTemplate(t *testing.T) {
 	conf := DefaultConfig()
 	var initBuffer, setBuffer bytes.Buffer
@@ -35,6 +35,14 @@ pruning = "{{ .BaseConfig.Pruning }}"
 pruning-keep-recent = "{{ .BaseConfig.PruningKeepRecent }}"
 pruning-interval = "{{ .BaseConfig.PruningInterval }}"
 
+# Overrides the pruning strategy of some stores, to keep the full history of a store
+# while pruning the others, or the other way around. Each entry is either
+# "<store>:<strategy>" or "<store>:custom:<keep-recent>:<interval>".
+#
+# Example:
+# ["bank:nothing", "wasm:everything", "ibc:custom:100:10"]
+pruning-stores = [{{ range .BaseConfig.PruningStores }}{{ printf "%q, " . }}{{end}}]
+
 # HaltHeight contains a non-zero block height at which a node will gracefully
 # halt and shutdown that can be used to assist upgrades and testing.
 #
@@ -208,6 +216,11 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 
+# snapshot-format specifies the format of the snapshots taken: 3 for a single zlib stream, or 5 to
+# compress the chunks and restore the stores in parallel, which requires the nodes restoring the
+# snapshots to support it.
+snapshot-format = {{ .StateSync.SnapshotFormat }}
+
 ###############################################################################
 ###                              State Streaming                            ###
 ###############################################################################
@@ -218,7 +231,7 @@ snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 # streaming.abci specifies the configuration for the ABCI Listener streaming service.
 [streaming.abci]
 
-# List of kv store keys to stream out via gRPC.
+# List of kv store keys to stream out via gRPC and the built-in listeners.
 # The store key names MUST match the module's StoreKey name.
 #
 # Example:
@@ -234,6 +247,97 @@ plugin = "{{ .Streaming.ABCI.Plugin }}"
 # stop-node-on-err specifies whether to stop the node on message delivery error.
 stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 
+# List of state change filters of the plugin, only streaming the state changes
+# matching any of them. A filter is a store key, optionally followed by a hex
+# key prefix. The store keys must be exposed by the keys above.
+#
+# Example:
+# ["bank/02"] to only stream the bank balances.
+# [] to stream all the state changes.
+filters = [{{ range .Streaming.ABCI.Filters }}{{ printf "%q, " . }}{{end}}]
+
+# Deliver the blocks to the plugin asynchronously, see streaming.async. It
+# cannot be enabled with stop-node-on-err, as the node must then not commit a
+# block the plugin did not process.
+async = {{ .Streaming.ABCI.Async }}
+
+# streaming.file writes the blocks to rotating files of length-prefixed protobuf
+# messages, grouping the FinalizeBlock and Commit messages of every block.
+[streaming.file]
+
+# Enable the file listener.
+enable = {{ .Streaming.File.Enable }}
+
+# Directory of the block files, relative to the node home if not absolute.
+dir = "{{ .Streaming.File.Dir }}"
+
+# Size in bytes after which a new block file is started (0 for no limit).
+max-file-size = {{ .Streaming.File.MaxFileSize }}
+
+# fsync flushes every block to disk before it is considered written.
+fsync = {{ .Streaming.File.Fsync }}
+
+# The node halts when the listener receives a block not following the last one
+# it wrote, e.g. because the node crashed before writing a block, as the state
+# changes of the missed blocks cannot be recovered. allow-gaps resumes writing
+# after such a gap instead. Gaps are always allowed when async is enabled with
+# the "drop-oldest" policy.
+allow-gaps = {{ .Streaming.File.AllowGaps }}
+
+# State change filters of the file listener, see streaming.abci.filters.
+filters = [{{ range .Streaming.File.Filters }}{{ printf "%q, " . }}{{end}}]
+
+# Write the blocks asynchronously, see streaming.async.
+async = {{ .Streaming.File.Async }}
+
+# streaming.kafka publishes the blocks to a Kafka topic, one message per block
+# keyed by its big endian height.
+[streaming.kafka]
+
+# Enable the Kafka listener.
+enable = {{ .Streaming.Kafka.Enable }}
+
+# Addresses of the Kafka brokers the cluster is discovered from, the blocks are
+# published to the broker leading the partition.
+brokers = [{{ range .Streaming.Kafka.Brokers }}{{ printf "%q, " . }}{{end}}]
+
+# Topic and partition the blocks are published to.
+topic = "{{ .Streaming.Kafka.Topic }}"
+partition = {{ .Streaming.Kafka.Partition }}
+
+# Client id sent to the brokers.
+client-id = "{{ .Streaming.Kafka.ClientID }}"
+
+# Time the brokers have to acknowledge a block, in seconds (0 for no limit).
+timeout = {{ .Streaming.Kafka.Timeout }}
+
+# Directory the blocks are spooled to until acknowledged by the broker, relative
+# to the node home if not absolute. Spooled blocks are published on restart.
+spool-dir = "{{ .Streaming.Kafka.SpoolDir }}"
+
+# Resume publishing after missed blocks instead of halting the node, see
+# streaming.file.allow-gaps.
+allow-gaps = {{ .Streaming.Kafka.AllowGaps }}
+
+# State change filters of the Kafka listener, see streaming.abci.filters.
+filters = [{{ range .Streaming.Kafka.Filters }}{{ printf "%q, " . }}{{end}}]
+
+# Publish the blocks asynchronously, see streaming.async.
+async = {{ .Streaming.Kafka.Async }}
+
+# streaming.async configures the listeners above enabling async, which are
+# delivered the blocks from a bounded buffer per listener, so that a slow
+# listener does not slow block production down.
+[streaming.async]
+
+# Maximum number of blocks buffered per listener.
+buffer-size = {{ .Streaming.Async.BufferSize }}
+
+# Policy when the buffer of a listener is full: "block" waits for the listener,
+# "drop-oldest" drops the oldest buffered block and "halt" stops the node
+# before committing the next block, which is replayed on restart.
+policy = "{{ .Streaming.Async.Policy }}"
+
 ###############################################################################
 ###                         Mempool                                         ###
 ###############################################################################
@@ -246,6 +350,83 @@ stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 # Note, this configuration only applies to SDK built-in app-side mempool
 # implementations.
 max-txs = {{ .Mempool.MaxTxs }}
+
+# tx-selector defines how mempool transactions are selected for block proposals.
+# "default": fill the block in mempool order.
+# "fee": fill the block by fee per gas, highest first. Fees of different denominations
+# are compared once converted with minimum-gas-prices, which must be set.
+# "sender-quota": fill the block in mempool order, with at most max-txs-per-sender
+# transactions from any single signer.
+tx-selector = "{{ .Mempool.TxSelector }}"
+
+# max-txs-per-sender defines the maximum number of transactions of a single signer
+# selected for a block proposal by the "sender-quota" tx-selector.
+max-txs-per-sender = {{ .Mempool.MaxTxsPerSender }}
+
+# lanes reserve a share of the block bytes and gas, max-block-space in (0, 1], for
+# the transactions whose messages all have one of the msg-types. Transactions
+# matching no lane share the space not reserved by any lane.
+#
+# Note, lanes are enforced when validating proposals, so all validators must
+# configure the same lanes.
+#
+# [[mempool.lanes]]
+# name = "oracle"
+# max-block-space = "0.1"
+# msg-types = ["/cosmos.bank.v1beta1.MsgSend"]
+{{- range .Mempool.Lanes }}
+
+[[mempool.lanes]]
+name = "{{ .Name }}"
+max-block-space = "{{ .MaxBlockSpace }}"
+msg-types = [{{ range .MsgTypes }}{{ printf "%q, " . }}{{end}}]
+{{- end }}
+
+###############################################################################
+###                         Optimistic Execution                            ###
+###############################################################################
+
+# Note, this configuration only applies if the application enables optimistic
+# execution.
+[optimistic-execution]
+
+# telemetry defines if optimistic execution metrics are emitted: the number of
+# executions, hits and aborts, the hit rate and the time wasted by aborted
+# executions. Telemetry must be enabled as well.
+telemetry = {{ .OptimisticExecution.Telemetry }}
+
+# start-on-first-process-proposal defines if optimistic execution is only
+# started on the first ProcessProposal call for a proposal. When enabled,
+# processing the same block again in a later round keeps the running execution
+# instead of rolling it back and restarting it. A different proposal always
+# rolls back the running execution.
+start-on-first-process-proposal = {{ .OptimisticExecution.StartOnFirstProcessProposal }}
+
+###############################################################################
+###                         Historical Queries                              ###
+###############################################################################
+
+# Historical queries serve the gRPC queries at past heights, i.e. with the
+# x-cosmos-block-height header, from a cache of the versions loaded instead of
+# loading the stores of the height for every query.
+[historical-queries]
+
+# enable defines if the versions loaded for queries at past heights are cached.
+enable = {{ .HistoricalQueries.Enable }}
+
+# cache-size defines the number of versions kept loaded.
+cache-size = {{ .HistoricalQueries.CacheSize }}
+
+# max-concurrent-queries defines the number of queries served at once at a single
+# past height, 0 for no limit. Further queries fail with a ResourceExhausted code.
+max-concurrent-queries = {{ .HistoricalQueries.MaxConcurrentQueries }}
+
+# min-height defines the lowest queryable height, 0 for no bound.
+min-height = {{ .HistoricalQueries.MinHeight }}
+
+# max-depth defines the number of heights below the latest one that are
+# queryable, 0 for no bound.
+max-depth = {{ .HistoricalQueries.MaxDepth }}
 `
 
 var configTemplate *template.Template
@@ -3,13 +3,18 @@ package server
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 
 	"github.com/spf13/cobra"
 
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/stateexport"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
@@ -20,6 +25,7 @@ const (
 	FlagForZeroHeight    = "for-zero-height"
 	FlagJailAllowedAddrs = "jail-allowed-addrs"
 	FlagModulesToExport  = "modules-to-export"
+	FlagExportFormat     = "format"
 )
 
 // ExportCmd dumps app state to JSON.
@@ -124,3 +130,99 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 
 	return cmd
 }
+
+// ExportStateCmd exports the state of the modules at a height to a SQLite
+// database or Parquet files, a table per collection of their collections.Schema.
+func ExportStateCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-state <output>",
+		Short: "Export the state of the modules to a SQLite database or Parquet files",
+		Long: `Export the state of the modules at a height to a SQLite database, or to a directory of a
+Parquet file per table, for analytics.
+
+Every collection of the collections.Schema of the modules is exported to a table named after its
+store and collection, e.g. bank_balances, with a TEXT column per field of its keys and values
+decoded as JSON. The state is streamed from the application database, which must not be in use.`,
+		Example: fmt.Sprintf(`$ %[1]s export-state state.db --format sqlite
+$ %[1]s export-state state --format parquet --height 1000 --modules-to-export bank,staking`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			height, _ := cmd.Flags().GetInt64(FlagHeight)
+			format, _ := cmd.Flags().GetString(FlagExportFormat)
+			modulesToExport, _ := cmd.Flags().GetStringSlice(FlagModulesToExport)
+
+			db, err := openDB(config.RootDir, GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+			decoderApp, ok := app.(types.CollectionDecoderApp)
+			if !ok {
+				return errors.New("the application does not decode the state of its modules through their collections")
+			}
+			cms, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("cannot export the state of a %T multistore", app.CommitMultiStore())
+			}
+
+			if height == -1 {
+				height = cms.LatestVersion()
+			}
+			loaded, err := cms.LoadImmutableStores(height)
+			if err != nil {
+				return fmt.Errorf("failed to load height %d: %w", height, err)
+			}
+
+			stores := make(map[string]storetypes.KVStore, len(loaded))
+			for key, store := range loaded {
+				stores[key.Name()] = store
+			}
+			if len(modulesToExport) > 0 {
+				selected := make(map[string]storetypes.KVStore, len(modulesToExport))
+				for _, name := range modulesToExport {
+					store, ok := stores[name]
+					if !ok {
+						return fmt.Errorf("no store %s at height %d", name, height)
+					}
+					selected[name] = store
+				}
+				stores = selected
+			}
+			for _, store := range stores {
+				if err := rootmulti.PrunedStoreError(store); err != nil {
+					return fmt.Errorf("%w, select the modules to export with --%s", err, FlagModulesToExport)
+				}
+			}
+
+			w, err := stateexport.NewWriter(format, args[0])
+			if err != nil {
+				return err
+			}
+			if err := stateexport.Export(stores, decoderApp.CollectionDecoder(), w); err != nil {
+				w.Close()
+				return fmt.Errorf("error exporting state: %w", err)
+			}
+			if err := w.Close(); err != nil {
+				return err
+			}
+
+			cmd.Printf("State at height %d exported to %s\n", height, args[0])
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(FlagHeight, -1, "Export state from a particular height (-1 means latest height)")
+	cmd.Flags().String(FlagExportFormat, stateexport.FormatSQLite, fmt.Sprintf("Export format (%s|%s)", stateexport.FormatSQLite, stateexport.FormatParquet))
+	cmd.Flags().StringSlice(FlagModulesToExport, []string{}, "Comma-separated list of the stores of the modules to export. If empty, will export all modules")
+
+	return cmd
+}
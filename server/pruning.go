@@ -2,6 +2,7 @@ package server
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cast"
@@ -37,3 +38,59 @@ func GetPruningOptionsFromFlags(appOpts types.AppOptions) (pruningtypes.PruningO
 		return pruningtypes.PruningOptions{}, fmt.Errorf("unknown pruning strategy %s", strategy)
 	}
 }
+
+// GetStorePruningOptionsFromFlags parses the pruning options overriding the ones
+// of GetPruningOptionsFromFlags for some stores, by store name. Each override is
+// either "<store>:<strategy>" or "<store>:custom:<keep-recent>:<interval>".
+func GetStorePruningOptionsFromFlags(appOpts types.AppOptions) (map[string]pruningtypes.PruningOptions, error) {
+	storeOpts := make(map[string]pruningtypes.PruningOptions)
+	for _, override := range cast.ToStringSlice(appOpts.Get(FlagPruningStores)) {
+		storeName, opts, err := parseStorePruningOptions(override)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := storeOpts[storeName]; ok {
+			return nil, fmt.Errorf("duplicate pruning options for store %s", storeName)
+		}
+		storeOpts[storeName] = opts
+	}
+
+	return storeOpts, nil
+}
+
+func parseStorePruningOptions(override string) (string, pruningtypes.PruningOptions, error) {
+	parts := strings.Split(strings.TrimSpace(override), ":")
+	storeName, strategy := parts[0], ""
+	if len(parts) > 1 {
+		strategy = strings.ToLower(parts[1])
+	}
+	if storeName == "" {
+		return "", pruningtypes.PruningOptions{}, fmt.Errorf("invalid store pruning options %q: missing store name", override)
+	}
+
+	switch {
+	case len(parts) == 2 && (strategy == pruningtypes.PruningOptionDefault ||
+		strategy == pruningtypes.PruningOptionNothing || strategy == pruningtypes.PruningOptionEverything):
+		return storeName, pruningtypes.NewPruningOptionsFromString(strategy), nil
+
+	case len(parts) == 4 && strategy == pruningtypes.PruningOptionCustom:
+		keepRecent, err := strconv.ParseUint(parts[2], 10, 64)
+		if err != nil {
+			return "", pruningtypes.PruningOptions{}, fmt.Errorf("invalid keep-recent of store %s: %w", storeName, err)
+		}
+		interval, err := strconv.ParseUint(parts[3], 10, 64)
+		if err != nil {
+			return "", pruningtypes.PruningOptions{}, fmt.Errorf("invalid interval of store %s: %w", storeName, err)
+		}
+
+		opts := pruningtypes.NewCustomPruningOptions(keepRecent, interval)
+		if err := opts.Validate(); err != nil {
+			return "", opts, fmt.Errorf("invalid custom pruning options of store %s: %w", storeName, err)
+		}
+		return storeName, opts, nil
+
+	default:
+		return "", pruningtypes.PruningOptions{}, fmt.Errorf(
+			"invalid store pruning options %q, expected <store>:<strategy> or <store>:custom:<keep-recent>:<interval>", override)
+	}
+}
@@ -66,3 +66,74 @@ func TestGetPruningOptionsFromFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestGetStorePruningOptionsFromFlags(t *testing.T) {
+	tests := []struct {
+		name            string
+		stores          []string
+		expectedOptions map[string]pruningtypes.PruningOptions
+		wantErr         bool
+	}{
+		{
+			name:            "no overrides",
+			expectedOptions: map[string]pruningtypes.PruningOptions{},
+		},
+		{
+			name:   "strategies",
+			stores: []string{"bank:nothing", "wasm:everything", "ibc:custom:100:10"},
+			expectedOptions: map[string]pruningtypes.PruningOptions{
+				"bank": pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+				"wasm": pruningtypes.NewPruningOptions(pruningtypes.PruningEverything),
+				"ibc":  pruningtypes.NewCustomPruningOptions(100, 10),
+			},
+		},
+		{
+			name:    "duplicate store",
+			stores:  []string{"bank:nothing", "bank:default"},
+			wantErr: true,
+		},
+		{
+			name:    "missing strategy",
+			stores:  []string{"bank"},
+			wantErr: true,
+		},
+		{
+			name:    "missing store name",
+			stores:  []string{":nothing"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown strategy",
+			stores:  []string{"bank:some"},
+			wantErr: true,
+		},
+		{
+			name:    "custom without interval",
+			stores:  []string{"bank:custom:100"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid custom options",
+			stores:  []string{"bank:custom:100:1"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			v := viper.New()
+			v.Set(FlagPruningStores, tt.stores)
+
+			opts, err := GetStorePruningOptionsFromFlags(v)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedOptions, opts)
+		})
+	}
+}
@@ -34,8 +34,11 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"cosmossdk.io/store/historical"
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -69,6 +72,7 @@ const (
 	FlagPruning             = "pruning"
 	FlagPruningKeepRecent   = "pruning-keep-recent"
 	FlagPruningInterval     = "pruning-interval"
+	FlagPruningStores       = "pruning-stores"
 	FlagIndexEvents         = "index-events"
 	FlagMinRetainBlocks     = "min-retain-blocks"
 	FlagIAVLCacheSize       = "iavl-cache-size"
@@ -78,6 +82,7 @@ const (
 	// state sync-related flags
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
 	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotFormat     = "state-sync.snapshot-format"
 
 	// api-related flags
 	FlagAPIEnable             = "api.enable"
@@ -96,7 +101,21 @@ const (
 	flagGRPCWebEnable = "grpc-web.enable"
 
 	// mempool flags
-	FlagMempoolMaxTxs = "mempool.max-txs"
+	FlagMempoolMaxTxs          = "mempool.max-txs"
+	FlagMempoolTxSelector      = "mempool.tx-selector"
+	FlagMempoolMaxTxsPerSender = "mempool.max-txs-per-sender"
+	FlagMempoolLanes           = "mempool.lanes"
+
+	// historical queries flags
+	FlagHistoricalQueriesEnable               = "historical-queries.enable"
+	FlagHistoricalQueriesCacheSize            = "historical-queries.cache-size"
+	FlagHistoricalQueriesMaxConcurrentQueries = "historical-queries.max-concurrent-queries"
+	FlagHistoricalQueriesMinHeight            = "historical-queries.min-height"
+	FlagHistoricalQueriesMaxDepth             = "historical-queries.max-depth"
+
+	// optimistic execution flags
+	FlagOptimisticExecutionTelemetry                   = "optimistic-execution.telemetry"
+	FlagOptimisticExecutionStartOnFirstProcessProposal = "optimistic-execution.start-on-first-process-proposal"
 
 	// testnet keys
 	KeyIsTestnet             = "is-testnet"
@@ -966,6 +985,7 @@ func addStartNodeFlags(cmd *cobra.Command, opts StartCmdOptions) {
 	cmd.Flags().String(FlagPruning, pruningtypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
 	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
+	cmd.Flags().StringSlice(FlagPruningStores, []string{}, "Pruning options of single stores (<store>:<strategy> or <store>:custom:<keep-recent>:<interval>)")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune CometBFT blocks")
 	cmd.Flags().Bool(FlagAPIEnable, false, "Define if the API server should be enabled")
@@ -982,8 +1002,18 @@ func addStartNodeFlags(cmd *cobra.Command, opts StartCmdOptions) {
 	cmd.Flags().Bool(flagGRPCWebEnable, true, "Define if the gRPC-Web server should be enabled. (Note: gRPC must also be enabled)")
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().Uint32(FlagStateSyncSnapshotFormat, snapshottypes.CurrentFormat, "State sync snapshot format (3: zlib, 5: parallel zstd)")
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
+	cmd.Flags().String(FlagMempoolTxSelector, baseapp.TxSelectorDefault, "Sets the strategy selecting mempool txs for block proposals (default|fee|sender-quota)")
+	cmd.Flags().Int(FlagMempoolMaxTxsPerSender, 0, "Sets the max number of txs of a single signer selected for a block proposal by the sender-quota tx selector")
+	cmd.Flags().Bool(FlagHistoricalQueriesEnable, false, "Cache the versions loaded for queries at past heights")
+	cmd.Flags().Int(FlagHistoricalQueriesCacheSize, historical.DefaultOptions().CacheSize, "Number of versions kept loaded for queries at past heights")
+	cmd.Flags().Int(FlagHistoricalQueriesMaxConcurrentQueries, historical.DefaultOptions().MaxConcurrentQueries, "Number of queries served at once at a single past height (0 for no limit)")
+	cmd.Flags().Int64(FlagHistoricalQueriesMinHeight, 0, "Lowest queryable height (0 for no bound)")
+	cmd.Flags().Int64(FlagHistoricalQueriesMaxDepth, 0, "Number of heights below the latest one that are queryable (0 for no bound)")
+	cmd.Flags().Bool(FlagOptimisticExecutionTelemetry, false, "Emit optimistic execution metrics (Note: telemetry must also be enabled)")
+	cmd.Flags().Bool(FlagOptimisticExecutionStartOnFirstProcessProposal, false, "Only start optimistic execution on the first ProcessProposal call for a proposal")
 	cmd.Flags().Duration(FlagShutdownGrace, 0*time.Second, "On Shutdown, duration to wait for resource clean up")
 
 	// support old flags name for backwards compatibility
@@ -0,0 +1,262 @@
+// Package stateexport exports the state of the modules, decoded through their
+// collections.Schema, to tables: a table per collection, with a column per
+// field of its keys and values.
+//
+// The state is streamed from the stores to a Writer, e.g. a SQLite database or
+// Parquet files, without holding it in memory.
+package stateexport
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/listeners"
+)
+
+// Export formats, see NewWriter.
+const (
+	FormatSQLite  = "sqlite"
+	FormatParquet = "parquet"
+)
+
+const (
+	// keyColumn is the column of the keys decoded as a single value, the
+	// fields of the keys decoded as arrays or objects being in columns
+	// prefixed with it.
+	keyColumn = "key"
+	// valueColumn is the column of the values not decoded as objects, the
+	// fields of the objects being in their own columns.
+	valueColumn = "value"
+)
+
+// Writer writes the tables of an export, one after the other.
+type Writer interface {
+	// CreateTable starts a table, the rows written until the next table
+	// belong to it.
+	CreateTable(name string, columns []string) error
+	// WriteRow writes a row of the current table, with a value per column,
+	// nil for NULL.
+	WriteRow(values []*string) error
+	// Close finishes the last table and the export.
+	Close() error
+}
+
+// NewWriter returns the Writer of a format, writing to path: a SQLite
+// database file, or a directory of a Parquet file per table.
+func NewWriter(format, path string) (Writer, error) {
+	switch format {
+	case FormatSQLite:
+		return NewSQLiteWriter(path)
+	case FormatParquet:
+		return NewParquetWriter(path)
+	default:
+		return nil, fmt.Errorf("unsupported export format %q, expected %s or %s", format, FormatSQLite, FormatParquet)
+	}
+}
+
+// TableName returns the name of the table of a collection.
+func TableName(storeKey, collection string) string {
+	return storeKey + "_" + collection
+}
+
+// Export writes a table per collection of the stores decoded by decoder, the
+// stores without schema and the keys outside of the collections being left
+// out. The stores missing from stores are skipped, e.g. those added after the
+// exported height.
+//
+// Every collection is iterated twice, first to find the columns of its table,
+// then to write its rows.
+func Export(stores map[string]storetypes.KVStore, decoder *listeners.CollectionDecoder, w Writer) error {
+	for _, storeKey := range decoder.StoreKeys() {
+		store, ok := stores[storeKey]
+		if !ok {
+			continue
+		}
+
+		for _, collection := range decoder.Collections(storeKey) {
+			if err := exportCollection(store, decoder, storeKey, collection, w); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func exportCollection(store storetypes.KVStore, decoder *listeners.CollectionDecoder, storeKey, collection string, w Writer) error {
+	filter, err := decoder.Filter(storeKey, collection)
+	if err != nil {
+		return err
+	}
+
+	var (
+		columns []string
+		indexes = make(map[string]int)
+	)
+	err = iterateRows(store, decoder, storeKey, filter.Prefix, func(row []field) error {
+		for _, f := range row {
+			if _, ok := indexes[f.column]; !ok {
+				indexes[f.column] = len(columns)
+				columns = append(columns, f.column)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(columns) == 0 {
+		// the tables of the empty collections have the columns of the undecoded keys and values
+		columns = []string{keyColumn, valueColumn}
+	}
+
+	if err := w.CreateTable(TableName(storeKey, collection), columns); err != nil {
+		return err
+	}
+
+	values := make([]*string, len(columns))
+	return iterateRows(store, decoder, storeKey, filter.Prefix, func(row []field) error {
+		for i := range values {
+			values[i] = nil
+		}
+		for _, f := range row {
+			values[indexes[f.column]] = f.value
+		}
+		return w.WriteRow(values)
+	})
+}
+
+// field is the value of a column of a row, nil for NULL.
+type field struct {
+	column string
+	value  *string
+}
+
+// iterateRows calls fn with the fields of the keys of a collection, in key
+// order.
+func iterateRows(store storetypes.KVStore, decoder *listeners.CollectionDecoder, storeKey string, prefix []byte, fn func(row []field) error) error {
+	iter := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		decoded, err := decoder.Decode(&storetypes.StoreKVPair{StoreKey: storeKey, Key: iter.Key(), Value: iter.Value()})
+		if err != nil {
+			return err
+		}
+
+		var row []field
+		if decoded.Key != nil {
+			row, err = splitJSON(row, keyColumn, decoded.Key, true)
+			if err != nil {
+				return fmt.Errorf("invalid key %x of collection %s/%s: %w", decoded.RawKey, storeKey, decoded.Collection, err)
+			}
+		} else {
+			// the keys of the collections without key codec are exported raw
+			key := hex.EncodeToString(decoded.RawKey[len(prefix):])
+			row = append(row, field{column: keyColumn, value: &key})
+		}
+
+		row, err = splitJSON(row, valueColumn, decoded.Value, false)
+		if err != nil {
+			return fmt.Errorf("invalid value of key %x of collection %s/%s: %w", decoded.RawKey, storeKey, decoded.Collection, err)
+		}
+
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+
+	return iter.Error()
+}
+
+// splitJSON appends the fields of a JSON value to row: the fields of an
+// object, or the elements of an array if elements is true, each in its own
+// column, the other values in the column named name.
+//
+// The columns of the fields of the keys are prefixed by the key column, and
+// those of the values colliding with them by the value column.
+func splitJSON(row []field, name string, raw json.RawMessage, elements bool) ([]field, error) {
+	raw = bytes.TrimSpace(raw)
+	switch {
+	case len(raw) > 0 && raw[0] == '{':
+		var fields []string
+		fieldValues := make(map[string]json.RawMessage)
+		dec := json.NewDecoder(bytes.NewReader(raw))
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		for dec.More() {
+			tok, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			var value json.RawMessage
+			if err := dec.Decode(&value); err != nil {
+				return nil, err
+			}
+			fieldName := tok.(string)
+			if _, ok := fieldValues[fieldName]; !ok {
+				fields = append(fields, fieldName)
+			}
+			fieldValues[fieldName] = value
+		}
+
+		for _, fieldName := range fields {
+			row = append(row, field{column: columnName(name, fieldName), value: jsonString(fieldValues[fieldName])})
+		}
+		return row, nil
+
+	case len(raw) > 0 && raw[0] == '[' && elements:
+		var elems []json.RawMessage
+		if err := json.Unmarshal(raw, &elems); err != nil {
+			return nil, err
+		}
+		for i, elem := range elems {
+			row = append(row, field{column: columnName(name, strconv.Itoa(i)), value: jsonString(elem)})
+		}
+		return row, nil
+
+	default:
+		if len(raw) > 0 && !json.Valid(raw) {
+			return nil, fmt.Errorf("invalid JSON %s", raw)
+		}
+		return append(row, field{column: name, value: jsonString(raw)}), nil
+	}
+}
+
+// columnName returns the column of a field of the keys or values.
+func columnName(name, fieldName string) string {
+	if name == keyColumn || fieldName == keyColumn || strings.HasPrefix(fieldName, keyColumn+"_") {
+		return name + "_" + fieldName
+	}
+	return fieldName
+}
+
+// jsonString returns the string of a JSON value: the strings unquoted, nil
+// for null, and the other values as compact JSON.
+func jsonString(raw json.RawMessage) *string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+
+	var s string
+	if raw[0] == '"' {
+		if err := json.Unmarshal(raw, &s); err == nil {
+			return &s
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err != nil {
+		s = string(raw)
+	} else {
+		s = buf.String()
+	}
+	return &s
+}
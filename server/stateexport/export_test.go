@@ -0,0 +1,170 @@
+package stateexport
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/store/dbadapter"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/listeners"
+)
+
+// account is a value decoded as a JSON object.
+type account struct {
+	Name    string  `json:"name"`
+	Key     string  `json:"key,omitempty"`
+	Balance *uint64 `json:"balance,omitempty"`
+}
+
+type accountValue struct{}
+
+func (accountValue) Encode(value account) ([]byte, error) { return json.Marshal(value) }
+
+func (accountValue) Decode(b []byte) (account, error) {
+	var value account
+	err := json.Unmarshal(b, &value)
+	return value, err
+}
+
+func (v accountValue) EncodeJSON(value account) ([]byte, error) { return v.Encode(value) }
+
+func (v accountValue) DecodeJSON(b []byte) (account, error) { return v.Decode(b) }
+
+func (accountValue) Stringify(value account) string { return value.Name }
+
+func (accountValue) ValueType() string { return "account" }
+
+// table is a table recorded by a recordingWriter.
+type table struct {
+	name    string
+	columns []string
+	rows    [][]*string
+}
+
+type recordingWriter struct {
+	tables []*table
+	closed bool
+}
+
+func (w *recordingWriter) CreateTable(name string, columns []string) error {
+	w.tables = append(w.tables, &table{name: name, columns: columns})
+	return nil
+}
+
+func (w *recordingWriter) WriteRow(values []*string) error {
+	t := w.tables[len(w.tables)-1]
+	t.rows = append(t.rows, append([]*string(nil), values...))
+	return nil
+}
+
+func (w *recordingWriter) Close() error {
+	w.closed = true
+	return nil
+}
+
+func str(s string) *string { return &s }
+
+func TestExport(t *testing.T) {
+	sb := collections.NewSchemaBuilderFromAccessor(func(context.Context) store.KVStore { return nil })
+	balances := collections.NewMap(sb, collections.NewPrefix(1), "balances",
+		collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.Uint64Value)
+	accounts := collections.NewMap[uint64, account](sb, collections.NewPrefix(2), "accounts", collections.Uint64Key, accountValue{})
+	paramsPrefix := collections.NewPrefix(3)
+	collections.NewItem(sb, paramsPrefix, "params", collections.StringValue)
+	collections.NewItem(sb, collections.NewPrefix(4), "empty", collections.StringValue)
+	schema, err := sb.Build()
+	require.NoError(t, err)
+
+	decoder := listeners.NewCollectionDecoder(map[string]collections.Schema{"bank": schema, "unknown": schema})
+	require.NoError(t, listeners.RegisterKeyCodec(decoder, "bank", "balances", balances.KeyCodec()))
+	require.NoError(t, listeners.RegisterKeyCodec(decoder, "bank", "accounts", accounts.KeyCodec()))
+
+	kv := dbadapter.Store{DB: dbm.NewMemDB()}
+	set := func(prefix collections.Prefix, key, value []byte) {
+		kv.Set(append(prefix.Bytes(), key...), value)
+	}
+	for _, balance := range []struct {
+		address, denom string
+		amount         uint64
+	}{{"alice", "atom", 10}, {"alice", "stake", 20}, {"bob", "stake", 30}} {
+		key, err := collections.EncodeKeyWithPrefix(nil, balances.KeyCodec(), collections.Join(balance.address, balance.denom))
+		require.NoError(t, err)
+		value, err := collections.Uint64Value.Encode(balance.amount)
+		require.NoError(t, err)
+		set(balances.GetPrefix(), key, value)
+	}
+	amount := uint64(5)
+	for i, acc := range []account{{Name: "alice", Key: "pk"}, {Name: "bob", Balance: &amount}} {
+		key, err := collections.EncodeKeyWithPrefix(nil, accounts.KeyCodec(), uint64(i))
+		require.NoError(t, err)
+		value, err := accountValue{}.Encode(acc)
+		require.NoError(t, err)
+		set(accounts.GetPrefix(), key, value)
+	}
+	set(paramsPrefix, nil, []byte("params"))
+	// keys outside of the collections are not exported
+	set(collections.NewPrefix(9), []byte("other"), []byte("value"))
+
+	w := &recordingWriter{}
+	require.NoError(t, Export(map[string]storetypes.KVStore{"bank": kv}, decoder, w))
+	require.Equal(t, []*table{
+		{
+			name:    "bank_accounts",
+			columns: []string{"key", "name", "value_key", "balance"},
+			rows: [][]*string{
+				{str("0"), str("alice"), str("pk"), nil},
+				{str("1"), str("bob"), nil, str("5")},
+			},
+		},
+		{
+			name:    "bank_balances",
+			columns: []string{"key_0", "key_1", "value"},
+			rows: [][]*string{
+				{str("alice"), str("atom"), str("10")},
+				{str("alice"), str("stake"), str("20")},
+				{str("bob"), str("stake"), str("30")},
+			},
+		},
+		{name: "bank_empty", columns: []string{"key", "value"}},
+		{
+			// the keys of the collections without key codec are exported raw
+			name:    "bank_params",
+			columns: []string{"key", "value"},
+			rows:    [][]*string{{str(""), str("params")}},
+		},
+	}, w.tables)
+	require.False(t, w.closed)
+
+	// values failing to decode fail the export
+	set(balances.GetPrefix(), []byte{5, 'c', 'a', 'r', 'o', 'l'}, []byte("invalid"))
+	require.Error(t, Export(map[string]storetypes.KVStore{"bank": kv}, decoder, &recordingWriter{}))
+}
+
+func TestSplitJSON(t *testing.T) {
+	row, err := splitJSON(nil, valueColumn, json.RawMessage(`{"a":"x","b":{"c":[1, 2]},"key":null,"key_0":true,"a":"y"}`), false)
+	require.NoError(t, err)
+	require.Equal(t, []field{
+		{column: "a", value: str("y")},
+		{column: "b", value: str(`{"c":[1,2]}`)},
+		{column: "value_key"},
+		{column: "value_key_0", value: str("true")},
+	}, row)
+
+	row, err = splitJSON(nil, valueColumn, json.RawMessage(`["x", 1]`), false)
+	require.NoError(t, err)
+	require.Equal(t, []field{{column: "value", value: str(`["x",1]`)}}, row)
+
+	row, err = splitJSON(nil, keyColumn, json.RawMessage(`["x", 1]`), true)
+	require.NoError(t, err)
+	require.Equal(t, []field{{column: "key_0", value: str("x")}, {column: "key_1", value: str("1")}}, row)
+
+	_, err = splitJSON(nil, valueColumn, json.RawMessage(`invalid`), false)
+	require.Error(t, err)
+}
@@ -0,0 +1,393 @@
+package stateexport
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// The Parquet file format, see https://parquet.apache.org/docs/file-format.
+const (
+	parquetMagic = "PAR1"
+
+	parquetTypeByteArray      = 6
+	parquetRepetitionOptional = 1
+	parquetConvertedTypeUTF8  = 0
+	parquetEncodingPlain      = 0
+	parquetEncodingRLE        = 3
+	parquetCodecUncompressed  = 0
+	parquetPageTypeData       = 0
+
+	// parquetRowGroupSize is the size of the values buffered before writing
+	// them as a row group.
+	parquetRowGroupSize = 64 << 20
+)
+
+var _ Writer = (*parquetWriter)(nil)
+
+// parquetWriter is a Writer creating a Parquet file per table in a directory,
+// without relying on a Parquet library: every column is an optional UTF-8
+// string, written uncompressed with the plain encoding, a page per column of
+// each row group.
+type parquetWriter struct {
+	dir   string
+	table *parquetTable
+}
+
+// NewParquetWriter returns a Writer creating a Parquet file per table in dir,
+// named after the table, e.g. bank_balances.parquet.
+func NewParquetWriter(dir string) (Writer, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &parquetWriter{dir: dir}, nil
+}
+
+// CreateTable implements Writer.
+func (w *parquetWriter) CreateTable(name string, columns []string) error {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+		return fmt.Errorf("invalid table name %q", name)
+	}
+	if err := w.finishTable(); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(filepath.Join(w.dir, name+".parquet"), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.WriteString(parquetMagic); err != nil {
+		file.Close()
+		return err
+	}
+
+	w.table = &parquetTable{
+		file:    file,
+		name:    name,
+		columns: columns,
+		chunks:  make([]parquetChunk, len(columns)),
+		offset:  int64(len(parquetMagic)),
+	}
+	return nil
+}
+
+// WriteRow implements Writer.
+func (w *parquetWriter) WriteRow(values []*string) error {
+	if w.table == nil {
+		return errors.New("no table created")
+	}
+
+	return w.table.writeRow(values)
+}
+
+// Close implements Writer.
+func (w *parquetWriter) Close() error {
+	return w.finishTable()
+}
+
+func (w *parquetWriter) finishTable() error {
+	if w.table == nil {
+		return nil
+	}
+
+	t := w.table
+	w.table = nil
+	err := t.finish()
+	if closeErr := t.file.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+// parquetTable is the file of a table being written.
+type parquetTable struct {
+	file    *os.File
+	name    string
+	columns []string
+	// offset is the number of bytes written to the file.
+	offset int64
+	// rowGroups holds the metadata of the row groups written.
+	rowGroups [][]byte
+	numRows   int64
+
+	// chunks holds the values of the row group being buffered, by column.
+	chunks []parquetChunk
+	rows   int
+	size   int
+}
+
+// parquetChunk holds the values of a column in a row group.
+type parquetChunk struct {
+	// defined holds a bit per row, set unless the value is null.
+	defined []byte
+	// values holds the plain encoding of the non-null values.
+	values []byte
+}
+
+func (t *parquetTable) writeRow(values []*string) error {
+	if len(values) != len(t.columns) {
+		return fmt.Errorf("row of %d values in table %s of %d columns", len(values), t.name, len(t.columns))
+	}
+
+	for i, value := range values {
+		c := &t.chunks[i]
+		if t.rows%8 == 0 {
+			c.defined = append(c.defined, 0)
+		}
+		if value == nil {
+			continue
+		}
+
+		c.defined[t.rows/8] |= 1 << (t.rows % 8)
+		c.values = binary.LittleEndian.AppendUint32(c.values, uint32(len(*value)))
+		c.values = append(c.values, *value...)
+		t.size += 4 + len(*value)
+	}
+	t.rows++
+
+	if t.size >= parquetRowGroupSize {
+		return t.flushRowGroup()
+	}
+	return nil
+}
+
+// flushRowGroup writes the buffered rows as a row group.
+func (t *parquetTable) flushRowGroup() error {
+	if t.rows == 0 {
+		return nil
+	}
+
+	var (
+		columnChunks [][]byte
+		groupSize    int64
+		groupOffset  = t.offset
+	)
+	for i := range t.chunks {
+		c := &t.chunks[i]
+
+		// the definition levels, of bit width 1, are a single bit-packed run
+		var levels []byte
+		levels = binary.AppendUvarint(levels, uint64(len(c.defined))<<1|1)
+		levels = append(levels, c.defined...)
+
+		data := binary.LittleEndian.AppendUint32(make([]byte, 0, 4+len(levels)+len(c.values)), uint32(len(levels)))
+		data = append(data, levels...)
+		data = append(data, c.values...)
+
+		header := parquetPageHeader(t.rows, len(data))
+		pageOffset := t.offset
+		if err := t.write(header, data); err != nil {
+			return err
+		}
+
+		size := int64(len(header) + len(data))
+		columnChunks = append(columnChunks, parquetColumnChunk(t.columns[i], t.rows, size, pageOffset))
+		groupSize += size
+
+		c.defined, c.values = c.defined[:0], c.values[:0]
+	}
+
+	t.rowGroups = append(t.rowGroups, parquetRowGroup(columnChunks, groupSize, t.rows, groupOffset))
+	t.numRows += int64(t.rows)
+	t.rows, t.size = 0, 0
+	return nil
+}
+
+// finish writes the remaining rows and the footer of the file.
+func (t *parquetTable) finish() error {
+	if err := t.flushRowGroup(); err != nil {
+		return err
+	}
+
+	footer := parquetFileMetaData(t.columns, t.numRows, t.rowGroups)
+	return t.write(footer, binary.LittleEndian.AppendUint32(nil, uint32(len(footer))), []byte(parquetMagic))
+}
+
+func (t *parquetTable) write(bufs ...[]byte) error {
+	for _, buf := range bufs {
+		n, err := t.file.Write(buf)
+		t.offset += int64(n)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parquetPageHeader returns the PageHeader of a data page.
+func parquetPageHeader(numValues, size int) []byte {
+	var e thriftEncoder
+	e.beginStruct()
+	e.i32Field(1, parquetPageTypeData)
+	e.i32Field(2, int32(size)) // uncompressed_page_size
+	e.i32Field(3, int32(size)) // compressed_page_size
+	e.structField(5)           // data_page_header
+	e.i32Field(1, int32(numValues))
+	e.i32Field(2, parquetEncodingPlain)
+	e.i32Field(3, parquetEncodingRLE) // definition_level_encoding
+	e.i32Field(4, parquetEncodingRLE) // repetition_level_encoding
+	e.endStruct()
+	e.endStruct()
+
+	return e.buf
+}
+
+// parquetColumnChunk returns the ColumnChunk of a column in a row group,
+// written as a single page.
+func parquetColumnChunk(column string, numValues int, size, pageOffset int64) []byte {
+	var e thriftEncoder
+	e.beginStruct()
+	e.i64Field(2, pageOffset) // file_offset
+	e.structField(3)          // meta_data
+	e.i32Field(1, parquetTypeByteArray)
+	e.listField(2, thriftI32, 2) // encodings
+	e.i32(parquetEncodingPlain)
+	e.i32(parquetEncodingRLE)
+	e.listField(3, thriftBinary, 1) // path_in_schema
+	e.binary([]byte(column))
+	e.i32Field(4, parquetCodecUncompressed)
+	e.i64Field(5, int64(numValues))
+	e.i64Field(6, size) // total_uncompressed_size
+	e.i64Field(7, size) // total_compressed_size
+	e.i64Field(9, pageOffset)
+	e.endStruct()
+	e.endStruct()
+
+	return e.buf
+}
+
+// parquetRowGroup returns the RowGroup of the encoded column chunks.
+func parquetRowGroup(columnChunks [][]byte, size int64, numRows int, offset int64) []byte {
+	var e thriftEncoder
+	e.beginStruct()
+	e.listField(1, thriftStruct, len(columnChunks))
+	for _, chunk := range columnChunks {
+		e.buf = append(e.buf, chunk...)
+	}
+	e.i64Field(2, size) // total_byte_size
+	e.i64Field(3, int64(numRows))
+	e.i64Field(5, offset) // file_offset
+	e.i64Field(6, size)   // total_compressed_size
+	e.endStruct()
+
+	return e.buf
+}
+
+// parquetFileMetaData returns the FileMetaData of a file of the encoded row
+// groups.
+func parquetFileMetaData(columns []string, numRows int64, rowGroups [][]byte) []byte {
+	var e thriftEncoder
+	e.beginStruct()
+	e.i32Field(1, 1) // version
+
+	e.listField(2, thriftStruct, len(columns)+1) // schema
+	e.beginStruct()
+	e.binaryField(4, []byte("schema"))
+	e.i32Field(5, int32(len(columns))) // num_children
+	e.endStruct()
+	for _, column := range columns {
+		e.beginStruct()
+		e.i32Field(1, parquetTypeByteArray)
+		e.i32Field(3, parquetRepetitionOptional)
+		e.binaryField(4, []byte(column))
+		e.i32Field(6, parquetConvertedTypeUTF8)
+		e.structField(10) // logicalType
+		e.structField(1)  // STRING
+		e.endStruct()
+		e.endStruct()
+		e.endStruct()
+	}
+
+	e.i64Field(3, numRows)
+	e.listField(4, thriftStruct, len(rowGroups))
+	for _, rowGroup := range rowGroups {
+		e.buf = append(e.buf, rowGroup...)
+	}
+	e.endStruct()
+
+	return e.buf
+}
+
+// Thrift compact protocol types.
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// thriftEncoder encodes the Parquet metadata in the Thrift compact protocol.
+type thriftEncoder struct {
+	buf []byte
+	// lastFields holds the id of the last field of the structs being encoded.
+	lastFields []int16
+}
+
+func (e *thriftEncoder) beginStruct() {
+	e.lastFields = append(e.lastFields, 0)
+}
+
+func (e *thriftEncoder) endStruct() {
+	e.buf = append(e.buf, 0)
+	e.lastFields = e.lastFields[:len(e.lastFields)-1]
+}
+
+func (e *thriftEncoder) field(id int16, fieldType byte) {
+	last := &e.lastFields[len(e.lastFields)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		e.buf = append(e.buf, byte(delta)<<4|fieldType)
+	} else {
+		e.buf = append(e.buf, fieldType)
+		e.buf = binary.AppendUvarint(e.buf, uint64(uint16(id<<1^id>>15)))
+	}
+	*last = id
+}
+
+func (e *thriftEncoder) i32(v int32) {
+	e.buf = binary.AppendUvarint(e.buf, uint64(uint32(v<<1^v>>31)))
+}
+
+func (e *thriftEncoder) binary(v []byte) {
+	e.buf = binary.AppendUvarint(e.buf, uint64(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+func (e *thriftEncoder) i32Field(id int16, v int32) {
+	e.field(id, thriftI32)
+	e.i32(v)
+}
+
+func (e *thriftEncoder) i64Field(id int16, v int64) {
+	e.field(id, thriftI64)
+	e.buf = binary.AppendUvarint(e.buf, uint64(v<<1^v>>63))
+}
+
+func (e *thriftEncoder) binaryField(id int16, v []byte) {
+	e.field(id, thriftBinary)
+	e.binary(v)
+}
+
+// structField starts a struct field, ended by endStruct.
+func (e *thriftEncoder) structField(id int16) {
+	e.field(id, thriftStruct)
+	e.beginStruct()
+}
+
+// listField starts a list field, followed by its elements, the structs being
+// encoded with beginStruct and endStruct.
+func (e *thriftEncoder) listField(id int16, elemType byte, size int) {
+	e.field(id, thriftList)
+	if size < 15 {
+		e.buf = append(e.buf, byte(size)<<4|elemType)
+	} else {
+		e.buf = append(e.buf, 0xf0|elemType)
+		e.buf = binary.AppendUvarint(e.buf, uint64(size))
+	}
+}
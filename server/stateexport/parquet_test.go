@@ -0,0 +1,220 @@
+package stateexport
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+)
+
+// thriftDecoder decodes the Thrift compact protocol structs written by a
+// thriftEncoder, as maps of field id to value.
+type thriftDecoder struct {
+	t   *testing.T
+	buf []byte
+}
+
+func (d *thriftDecoder) uvarint() uint64 {
+	v, n := binary.Uvarint(d.buf)
+	require.Positive(d.t, n)
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *thriftDecoder) value(valueType byte) any {
+	switch valueType {
+	case thriftI32, thriftI64:
+		v := d.uvarint()
+		return int64(v>>1) ^ -int64(v&1)
+	case thriftBinary:
+		size := d.uvarint()
+		v := string(d.buf[:size])
+		d.buf = d.buf[size:]
+		return v
+	case thriftList:
+		header := d.buf[0]
+		d.buf = d.buf[1:]
+		size := int(header >> 4)
+		if size == 15 {
+			size = int(d.uvarint())
+		}
+		list := make([]any, size)
+		for i := range list {
+			list[i] = d.value(header & 0x0f)
+		}
+		return list
+	case thriftStruct:
+		return d.structValue()
+	default:
+		d.t.Fatalf("unexpected thrift type %d", valueType)
+		return nil
+	}
+}
+
+func (d *thriftDecoder) structValue() map[int16]any {
+	fields := make(map[int16]any)
+	var last int16
+	for {
+		header := d.buf[0]
+		d.buf = d.buf[1:]
+		if header == 0 {
+			return fields
+		}
+		id := last + int16(header>>4)
+		if header>>4 == 0 {
+			v := d.uvarint()
+			id = int16(v>>1) ^ -int16(v&1)
+		}
+		fields[id] = d.value(header & 0x0f)
+		last = id
+	}
+}
+
+func TestParquetWriter(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "state")
+	w, err := NewParquetWriter(dir)
+	require.NoError(t, err)
+
+	require.Error(t, w.WriteRow([]*string{nil}))
+	require.NoError(t, w.CreateTable("bank_balances", []string{"key", "value"}))
+	rows := [][]*string{{str("alice"), str("10")}, {str("bob"), nil}, {str("carol"), str("")}}
+	for _, row := range rows {
+		require.NoError(t, w.WriteRow(row))
+	}
+	require.Error(t, w.WriteRow([]*string{nil}))
+	require.NoError(t, w.CreateTable("bank_empty", []string{"key", "value"}))
+	require.Error(t, w.CreateTable("../escape", []string{"key"}))
+	require.NoError(t, w.Close())
+
+	bz, err := os.ReadFile(filepath.Join(dir, "bank_balances.parquet"))
+	require.NoError(t, err)
+	require.Equal(t, parquetMagic, string(bz[:4]))
+	require.Equal(t, parquetMagic, string(bz[len(bz)-4:]))
+	footerSize := int(binary.LittleEndian.Uint32(bz[len(bz)-8:]))
+	d := &thriftDecoder{t: t, buf: bz[len(bz)-8-footerSize : len(bz)-8]}
+	metadata := d.structValue()
+	require.Empty(t, d.buf)
+
+	require.Equal(t, int64(len(rows)), metadata[3])
+	schema := metadata[2].([]any)
+	require.Len(t, schema, 3)
+	require.Equal(t, int64(2), schema[0].(map[int16]any)[5])
+	require.Equal(t, "key", schema[1].(map[int16]any)[4])
+	require.Equal(t, "value", schema[2].(map[int16]any)[4])
+
+	rowGroups := metadata[4].([]any)
+	require.Len(t, rowGroups, 1)
+	columns := rowGroups[0].(map[int16]any)[1].([]any)
+	require.Len(t, columns, 2)
+
+	// the page of the value column holds the definition levels then the
+	// non-null values
+	columnMetadata := columns[1].(map[int16]any)[3].(map[int16]any)
+	require.Equal(t, []any{"value"}, columnMetadata[3])
+	require.Equal(t, int64(len(rows)), columnMetadata[5])
+	d = &thriftDecoder{t: t, buf: bz[columnMetadata[9].(int64):]}
+	pageHeader := d.structValue()
+	require.Equal(t, int64(len(rows)), pageHeader[5].(map[int16]any)[1])
+	page := d.buf[:pageHeader[3].(int64)]
+	levelsSize := binary.LittleEndian.Uint32(page)
+	require.Equal(t, []byte{1<<1 | 1, 0b101}, page[4:4+levelsSize])
+	require.Equal(t, []byte("\x02\x00\x00\x0010\x00\x00\x00\x00"), page[4+levelsSize:])
+
+	bz, err = os.ReadFile(filepath.Join(dir, "bank_empty.parquet"))
+	require.NoError(t, err)
+	require.Equal(t, parquetMagic, string(bz[:4]))
+
+	// existing files are not overwritten
+	w, err = NewParquetWriter(dir)
+	require.NoError(t, err)
+	require.Error(t, w.CreateTable("bank_balances", []string{"key"}))
+}
+
+// readParquetFile reads a Parquet file of optional UTF-8 string columns with
+// the xitongsys/parquet-go library, independently of the writer.
+func readParquetFile(t *testing.T, path string) ([]string, [][]*string) {
+	t.Helper()
+
+	f, err := local.NewLocalFileReader(path)
+	require.NoError(t, err)
+	defer f.Close()
+	pr, err := reader.NewParquetColumnReader(f, 1)
+	require.NoError(t, err)
+	defer pr.ReadStop()
+
+	var columns []string
+	for i, element := range pr.SchemaHandler.SchemaElements[1:] {
+		// the reader renames the columns, keeping their names in the file as
+		// external names
+		name := pr.SchemaHandler.GetExName(i + 1)
+		require.Equal(t, parquet.Type_BYTE_ARRAY, element.GetType(), name)
+		require.Equal(t, parquet.ConvertedType_UTF8, element.GetConvertedType(), name)
+		require.Equal(t, parquet.FieldRepetitionType_OPTIONAL, element.GetRepetitionType(), name)
+		columns = append(columns, name)
+	}
+
+	numRows := pr.GetNumRows()
+	rows := make([][]*string, numRows)
+	for i := range rows {
+		rows[i] = make([]*string, len(columns))
+	}
+	for i := range columns {
+		values, _, dls, err := pr.ReadColumnByIndex(int64(i), numRows)
+		require.NoError(t, err)
+		require.Len(t, values, int(numRows))
+		for j, v := range values {
+			if dls[j] == 0 {
+				require.Nil(t, v)
+				continue
+			}
+			s := v.(string)
+			rows[j][i] = &s
+		}
+	}
+
+	return columns, rows
+}
+
+func TestParquetWriter_RoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	w, err := NewParquetWriter(dir)
+	require.NoError(t, err)
+
+	// long runs of nulls and non-nulls, and alternating ones, cover both
+	// encodings of the definition levels
+	columns := []string{"key", "value", "memo"}
+	var rows [][]*string
+	for i := 0; i < 1000; i++ {
+		row := []*string{str(fmt.Sprintf("key-%d", i)), nil, nil}
+		if i < 300 || i%2 == 0 {
+			row[1] = str(fmt.Sprintf("%d", i*i))
+		}
+		if i%7 == 0 {
+			row[2] = str("")
+		} else if i%11 == 0 {
+			row[2] = str("mémo ✓")
+		}
+		rows = append(rows, row)
+	}
+
+	require.NoError(t, w.CreateTable("bank_balances", columns))
+	for _, row := range rows {
+		require.NoError(t, w.WriteRow(row))
+	}
+	require.NoError(t, w.CreateTable("bank_empty", []string{"key", "value"}))
+	require.NoError(t, w.Close())
+
+	readColumns, readRows := readParquetFile(t, filepath.Join(dir, "bank_balances.parquet"))
+	require.Equal(t, columns, readColumns)
+	require.Equal(t, rows, readRows)
+
+	readColumns, readRows = readParquetFile(t, filepath.Join(dir, "bank_empty.parquet"))
+	require.Equal(t, []string{"key", "value"}, readColumns)
+	require.Empty(t, readRows)
+}
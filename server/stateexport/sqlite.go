@@ -0,0 +1,502 @@
+package stateexport
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// The SQLite database file format, see https://www.sqlite.org/fileformat.html.
+const (
+	sqlitePageSize           = 4096
+	sqliteHeaderSize         = 100
+	sqliteLeafHeaderSize     = 8
+	sqliteInteriorHeaderSize = 12
+	sqliteTableLeafPage      = 0x0d
+	sqliteTableInteriorPage  = 0x05
+	sqliteSchemaVersion      = 3040001
+
+	// sqlitePendingBytePage is the page of the byte at offset 1 GiB, used by
+	// SQLite for locking, which must not hold any data.
+	sqlitePendingBytePage = 1<<30/sqlitePageSize + 1
+)
+
+var _ Writer = (*sqliteWriter)(nil)
+
+// sqliteWriter is a Writer creating a SQLite database file, without relying on
+// the SQLite library: the rows are appended to the B-trees of the tables, whose
+// pages are written as soon as they are full.
+type sqliteWriter struct {
+	file *os.File
+	// pages is the number of pages allocated, the first one holding the
+	// header and the schema of the database, written on Close.
+	pages uint32
+	// schema holds the records of the tables created.
+	schema [][]byte
+	// table is the current table.
+	table       *sqliteBTree
+	tableName   string
+	tableSQL    string
+	tableRowID  int64
+	tableValues []any
+}
+
+// NewSQLiteWriter returns a Writer creating a SQLite database at path, where
+// every table has a TEXT column per column of the export.
+func NewSQLiteWriter(path string) (Writer, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqliteWriter{file: file, pages: 1}, nil
+}
+
+// CreateTable implements Writer.
+func (w *sqliteWriter) CreateTable(name string, columns []string) error {
+	if len(columns) == 0 {
+		return fmt.Errorf("table %s has no columns", name)
+	}
+	if err := w.finishTable(); err != nil {
+		return err
+	}
+
+	defs := make([]string, len(columns))
+	for i, column := range columns {
+		defs[i] = sqliteQuote(column) + " TEXT"
+	}
+
+	w.table = newSQLiteBTree(w, 0, 0)
+	w.tableName = name
+	w.tableSQL = fmt.Sprintf("CREATE TABLE %s(%s)", sqliteQuote(name), strings.Join(defs, ", "))
+	w.tableRowID = 0
+	w.tableValues = make([]any, len(columns))
+	return nil
+}
+
+// WriteRow implements Writer.
+func (w *sqliteWriter) WriteRow(values []*string) error {
+	if w.table == nil {
+		return errors.New("no table created")
+	}
+	if len(values) != len(w.tableValues) {
+		return fmt.Errorf("row of %d values in table %s of %d columns", len(values), w.tableName, len(w.tableValues))
+	}
+
+	for i, value := range values {
+		if value == nil {
+			w.tableValues[i] = nil
+		} else {
+			w.tableValues[i] = *value
+		}
+	}
+
+	w.tableRowID++
+	return w.table.add(w.tableRowID, sqliteRecord(w.tableValues))
+}
+
+// Close implements Writer, writing the schema of the tables on the first page.
+func (w *sqliteWriter) Close() error {
+	err := w.finishTable()
+	if err == nil {
+		err = w.writeSchema()
+	}
+	if err == nil {
+		err = w.file.Sync()
+	}
+	if closeErr := w.file.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+// finishTable writes the remaining pages of the current table and records it
+// in the schema.
+func (w *sqliteWriter) finishTable() error {
+	if w.table == nil {
+		return nil
+	}
+
+	root, err := w.table.finish()
+	if err != nil {
+		return err
+	}
+	w.schema = append(w.schema, sqliteRecord([]any{"table", w.tableName, w.tableName, int64(root), w.tableSQL}))
+	w.table = nil
+
+	return nil
+}
+
+// writeSchema writes the sqlite_schema table, whose root is the first page,
+// after the header of the database.
+func (w *sqliteWriter) writeSchema() error {
+	schema := newSQLiteBTree(w, 1, sqliteHeaderSize)
+	for i, record := range w.schema {
+		if err := schema.add(int64(i+1), record); err != nil {
+			return err
+		}
+	}
+	if _, err := schema.finish(); err != nil {
+		return err
+	}
+
+	header := make([]byte, sqliteHeaderSize)
+	copy(header, "SQLite format 3\x00")
+	binary.BigEndian.PutUint16(header[16:], sqlitePageSize)
+	header[18] = 1                             // file format write version, legacy
+	header[19] = 1                             // file format read version, legacy
+	header[21] = 64                            // maximum embedded payload fraction
+	header[22] = 32                            // minimum embedded payload fraction
+	header[23] = 32                            // leaf payload fraction
+	binary.BigEndian.PutUint32(header[24:], 1) // file change counter
+	binary.BigEndian.PutUint32(header[28:], w.pages)
+	binary.BigEndian.PutUint32(header[40:], 1) // schema cookie
+	binary.BigEndian.PutUint32(header[44:], 4) // schema format number
+	binary.BigEndian.PutUint32(header[56:], 1) // UTF-8 text encoding
+	binary.BigEndian.PutUint32(header[92:], 1) // version-valid-for number
+	binary.BigEndian.PutUint32(header[96:], sqliteSchemaVersion)
+
+	_, err := w.file.WriteAt(header, 0)
+	return err
+}
+
+// writePage writes a page, allocating it if pgno is 0, and returns its number.
+func (w *sqliteWriter) writePage(pgno uint32, page []byte) (uint32, error) {
+	if pgno == 0 {
+		pgno = w.allocPage()
+	}
+
+	_, err := w.file.WriteAt(page, int64(pgno-1)*sqlitePageSize)
+	return pgno, err
+}
+
+// allocPage returns the number of a new page, skipping the pending byte page.
+func (w *sqliteWriter) allocPage() uint32 {
+	w.pages++
+	if w.pages == sqlitePendingBytePage {
+		w.pages++
+	}
+
+	return w.pages
+}
+
+// writeOverflow writes the part of a payload not stored in its cell to a chain
+// of overflow pages, and returns the number of the first one.
+func (w *sqliteWriter) writeOverflow(payload []byte) (uint32, error) {
+	first := w.allocPage()
+
+	for pgno := first; len(payload) > 0; {
+		page := make([]byte, sqlitePageSize)
+		n := copy(page[4:], payload)
+		payload = payload[n:]
+
+		var next uint32
+		if len(payload) > 0 {
+			next = w.allocPage()
+			binary.BigEndian.PutUint32(page, next)
+		}
+		if _, err := w.writePage(pgno, page); err != nil {
+			return 0, err
+		}
+		pgno = next
+	}
+
+	return first, nil
+}
+
+// sqliteChild is a page of a B-tree, along with the largest rowid it holds.
+type sqliteChild struct {
+	pgno  uint32
+	rowID int64
+}
+
+// sqliteLevel is the interior page being filled at a level of a B-tree.
+type sqliteLevel struct {
+	children []sqliteChild
+	// size is the size of the cells of the children, but the last one which
+	// is the right-most pointer of the page.
+	size int
+}
+
+// sqliteBTree is a table B-tree built from rows appended in rowid order: the
+// leaf pages are written once full, as are the interior pages above them.
+type sqliteBTree struct {
+	w *sqliteWriter
+	// root is the page of the root, allocated once written if 0, and
+	// rootOffset the offset of its header, the capacity of every page being
+	// reduced accordingly so that any of them can be the root.
+	root       uint32
+	rootOffset int
+
+	leaf      [][]byte
+	leafSize  int
+	leafRowID int64
+	levels    []*sqliteLevel
+}
+
+func newSQLiteBTree(w *sqliteWriter, root uint32, rootOffset int) *sqliteBTree {
+	return &sqliteBTree{w: w, root: root, rootOffset: rootOffset}
+}
+
+// add appends a row to the B-tree.
+func (t *sqliteBTree) add(rowID int64, record []byte) error {
+	cell, err := t.leafCell(rowID, record)
+	if err != nil {
+		return err
+	}
+
+	if t.rootOffset+sqliteLeafHeaderSize+t.leafSize+len(cell)+2 > sqlitePageSize {
+		// only the cells of the schema, whose pages are smaller, may not fit in an empty page
+		if len(t.leaf) == 0 {
+			return fmt.Errorf("row %d of %d bytes does not fit in a page", rowID, len(record))
+		}
+		if err := t.flushLeaf(); err != nil {
+			return err
+		}
+	}
+
+	t.leaf = append(t.leaf, cell)
+	t.leafSize += len(cell) + 2
+	t.leafRowID = rowID
+	return nil
+}
+
+// finish writes the remaining pages of the B-tree and returns its root.
+func (t *sqliteBTree) finish() (uint32, error) {
+	if len(t.levels) == 0 {
+		// the rows fit in a single leaf, which is the root
+		return t.w.writePage(t.root, sqlitePage(t.rootOffset, sqliteTableLeafPage, t.leaf, 0))
+	}
+	if err := t.flushLeaf(); err != nil {
+		return 0, err
+	}
+
+	// the top level has never been full, so its page is the root
+	for i := 0; ; i++ {
+		l := t.levels[i]
+		if i == len(t.levels)-1 {
+			return t.w.writePage(t.root, sqliteInterior(t.rootOffset, l.children))
+		}
+
+		pgno, err := t.w.writePage(0, sqliteInterior(0, l.children))
+		if err != nil {
+			return 0, err
+		}
+		if err := t.addChild(i+1, sqliteChild{pgno: pgno, rowID: l.children[len(l.children)-1].rowID}); err != nil {
+			return 0, err
+		}
+	}
+}
+
+func (t *sqliteBTree) flushLeaf() error {
+	pgno, err := t.w.writePage(0, sqlitePage(0, sqliteTableLeafPage, t.leaf, 0))
+	if err != nil {
+		return err
+	}
+
+	t.leaf, t.leafSize = nil, 0
+	return t.addChild(0, sqliteChild{pgno: pgno, rowID: t.leafRowID})
+}
+
+// addChild adds a page to the interior page being filled at a level. When it
+// is full, it is written with all its children but the last one, which starts
+// the next page of the level along with the new child, so that no interior
+// page is left with a single child.
+func (t *sqliteBTree) addChild(level int, child sqliteChild) error {
+	if level == len(t.levels) {
+		t.levels = append(t.levels, &sqliteLevel{})
+	}
+	l := t.levels[level]
+
+	if len(l.children) > 0 {
+		last := l.children[len(l.children)-1]
+		if t.rootOffset+sqliteInteriorHeaderSize+l.size+sqliteInteriorCellSize(last) > sqlitePageSize {
+			full := l.children[:len(l.children)-1]
+			pgno, err := t.w.writePage(0, sqliteInterior(0, full))
+			if err != nil {
+				return err
+			}
+			if err := t.addChild(level+1, sqliteChild{pgno: pgno, rowID: full[len(full)-1].rowID}); err != nil {
+				return err
+			}
+			l.children = []sqliteChild{last}
+			l.size = 0
+		}
+		l.size += sqliteInteriorCellSize(last)
+	}
+
+	l.children = append(l.children, child)
+	return nil
+}
+
+// leafCell returns the cell of a row in a leaf page, the part of its record
+// not fitting in the page being written to overflow pages.
+func (t *sqliteBTree) leafCell(rowID int64, record []byte) ([]byte, error) {
+	cell := appendSQLiteVarint(nil, uint64(len(record)))
+	cell = appendSQLiteVarint(cell, uint64(rowID))
+
+	local := sqliteLocalPayload(len(record))
+	cell = append(cell, record[:local]...)
+	if local < len(record) {
+		overflow, err := t.w.writeOverflow(record[local:])
+		if err != nil {
+			return nil, err
+		}
+		cell = binary.BigEndian.AppendUint32(cell, overflow)
+	}
+
+	return cell, nil
+}
+
+// sqliteLocalPayload returns the size of the part of a payload stored in its
+// leaf cell, as computed by SQLite.
+func sqliteLocalPayload(size int) int {
+	const (
+		usable  = sqlitePageSize
+		maxSize = usable - 35
+		minSize = (usable-12)*32/255 - 23
+	)
+
+	if size <= maxSize {
+		return size
+	}
+	if local := minSize + (size-minSize)%(usable-4); local <= maxSize {
+		return local
+	}
+	return minSize
+}
+
+// sqliteInteriorCellSize returns the size of the cell of a child in an
+// interior page, including its pointer.
+func sqliteInteriorCellSize(child sqliteChild) int {
+	return 2 + 4 + len(appendSQLiteVarint(nil, uint64(child.rowID)))
+}
+
+// sqliteInterior returns an interior page pointing to the children, the last
+// one being its right-most pointer.
+func sqliteInterior(offset int, children []sqliteChild) []byte {
+	cells := make([][]byte, len(children)-1)
+	for i, child := range children[:len(children)-1] {
+		cells[i] = appendSQLiteVarint(binary.BigEndian.AppendUint32(nil, child.pgno), uint64(child.rowID))
+	}
+
+	return sqlitePage(offset, sqliteTableInteriorPage, cells, children[len(children)-1].pgno)
+}
+
+// sqlitePage returns a B-tree page holding the cells, whose header starts at
+// offset.
+func sqlitePage(offset int, pageType byte, cells [][]byte, right uint32) []byte {
+	page := make([]byte, sqlitePageSize)
+	header := page[offset:]
+	header[0] = pageType
+	binary.BigEndian.PutUint16(header[3:], uint16(len(cells)))
+
+	pointers := sqliteLeafHeaderSize
+	if pageType == sqliteTableInteriorPage {
+		pointers = sqliteInteriorHeaderSize
+		binary.BigEndian.PutUint32(header[8:], right)
+	}
+
+	content := sqlitePageSize
+	for i, cell := range cells {
+		content -= len(cell)
+		copy(page[content:], cell)
+		binary.BigEndian.PutUint16(header[pointers+2*i:], uint16(content))
+	}
+	binary.BigEndian.PutUint16(header[5:], uint16(content))
+
+	return page
+}
+
+// sqliteRecord returns the record of the values, each either nil, a string
+// or an int64.
+func sqliteRecord(values []any) []byte {
+	var types, body []byte
+	for _, value := range values {
+		switch value := value.(type) {
+		case nil:
+			types = appendSQLiteVarint(types, 0)
+		case string:
+			types = appendSQLiteVarint(types, uint64(13+2*len(value)))
+			body = append(body, value...)
+		case int64:
+			serialType, size := sqliteIntSerialType(value)
+			types = appendSQLiteVarint(types, serialType)
+			for i := size - 1; i >= 0; i-- {
+				body = append(body, byte(value>>(8*i)))
+			}
+		default:
+			panic(fmt.Sprintf("unsupported SQLite value %T", value))
+		}
+	}
+
+	// the size of the header includes its own varint
+	headerSize := len(types) + 1
+	for len(appendSQLiteVarint(nil, uint64(headerSize)))+len(types) != headerSize {
+		headerSize = len(appendSQLiteVarint(nil, uint64(headerSize))) + len(types)
+	}
+
+	record := appendSQLiteVarint(make([]byte, 0, headerSize+len(body)), uint64(headerSize))
+	record = append(record, types...)
+	return append(record, body...)
+}
+
+// sqliteIntSerialType returns the serial type of an integer and its size.
+func sqliteIntSerialType(v int64) (uint64, int) {
+	switch {
+	case v >= -1<<7 && v < 1<<7:
+		return 1, 1
+	case v >= -1<<15 && v < 1<<15:
+		return 2, 2
+	case v >= -1<<23 && v < 1<<23:
+		return 3, 3
+	case v >= -1<<31 && v < 1<<31:
+		return 4, 4
+	case v >= -1<<47 && v < 1<<47:
+		return 5, 6
+	default:
+		return 6, 8
+	}
+}
+
+// appendSQLiteVarint appends the SQLite varint of v, big-endian with 7 bits
+// per byte, but the ninth byte holding 8 bits.
+func appendSQLiteVarint(b []byte, v uint64) []byte {
+	if v > 1<<56-1 {
+		var buf [9]byte
+		buf[8] = byte(v)
+		v >>= 8
+		for i := 7; i >= 0; i-- {
+			buf[i] = byte(v&0x7f) | 0x80
+			v >>= 7
+		}
+		return append(b, buf[:]...)
+	}
+
+	var buf [8]byte
+	n := 0
+	for {
+		buf[n] = byte(v & 0x7f)
+		n++
+		if v >>= 7; v == 0 {
+			break
+		}
+	}
+	for i := n - 1; i >= 0; i-- {
+		if i > 0 {
+			b = append(b, buf[i]|0x80)
+		} else {
+			b = append(b, buf[i])
+		}
+	}
+
+	return b
+}
+
+// sqliteQuote quotes an identifier.
+func sqliteQuote(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
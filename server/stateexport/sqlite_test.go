@@ -0,0 +1,290 @@
+package stateexport
+
+import (
+	"database/sql"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+)
+
+// sqliteReader reads the tables written by a sqliteWriter.
+type sqliteReader struct {
+	t  *testing.T
+	bz []byte
+}
+
+func (r sqliteReader) page(pgno uint32) []byte {
+	return r.bz[int(pgno-1)*sqlitePageSize : int(pgno)*sqlitePageSize]
+}
+
+// rows returns the records of the B-tree rooted at pgno, checking that their
+// rowids are increasing.
+func (r sqliteReader) rows(pgno uint32, offset int, rowIDs *[]int64) [][]any {
+	page := r.page(pgno)
+	header := page[offset:]
+	cells := int(binary.BigEndian.Uint16(header[3:]))
+
+	var records [][]any
+	switch header[0] {
+	case sqliteTableInteriorPage:
+		for i := 0; i < cells; i++ {
+			cell := page[binary.BigEndian.Uint16(header[sqliteInteriorHeaderSize+2*i:]):]
+			records = append(records, r.rows(binary.BigEndian.Uint32(cell), 0, rowIDs)...)
+			key, _ := readSQLiteVarint(cell[4:])
+			require.Equal(r.t, int64(key), (*rowIDs)[len(*rowIDs)-1])
+		}
+		return append(records, r.rows(binary.BigEndian.Uint32(header[8:]), 0, rowIDs)...)
+
+	case sqliteTableLeafPage:
+		for i := 0; i < cells; i++ {
+			cell := page[binary.BigEndian.Uint16(header[sqliteLeafHeaderSize+2*i:]):]
+			size, n := readSQLiteVarint(cell)
+			rowID, m := readSQLiteVarint(cell[n:])
+			if len(*rowIDs) > 0 {
+				require.Greater(r.t, int64(rowID), (*rowIDs)[len(*rowIDs)-1])
+			}
+			*rowIDs = append(*rowIDs, int64(rowID))
+
+			local := sqliteLocalPayload(int(size))
+			payload := append([]byte(nil), cell[n+m:n+m+local]...)
+			for next := uint32(0); len(payload) < int(size); {
+				if next == 0 {
+					next = binary.BigEndian.Uint32(cell[n+m+local:])
+				}
+				require.NotEqual(r.t, uint32(sqlitePendingBytePage), next)
+				overflow := r.page(next)
+				payload = append(payload, overflow[4:min(sqlitePageSize, 4+int(size)-len(payload))]...)
+				next = binary.BigEndian.Uint32(overflow)
+			}
+			records = append(records, readSQLiteRecord(r.t, payload))
+		}
+		return records
+
+	default:
+		r.t.Fatalf("invalid page type %d of page %d", header[0], pgno)
+		return nil
+	}
+}
+
+func readSQLiteVarint(b []byte) (uint64, int) {
+	var v uint64
+	for i := 0; i < 8; i++ {
+		v = v<<7 | uint64(b[i]&0x7f)
+		if b[i] < 0x80 {
+			return v, i + 1
+		}
+	}
+	return v<<8 | uint64(b[8]), 9
+}
+
+func readSQLiteRecord(t *testing.T, record []byte) []any {
+	t.Helper()
+
+	headerSize, n := readSQLiteVarint(record)
+	header, body := record[n:headerSize], record[headerSize:]
+
+	var values []any
+	for len(header) > 0 {
+		serialType, n := readSQLiteVarint(header)
+		header = header[n:]
+		switch {
+		case serialType == 0:
+			values = append(values, nil)
+		case serialType >= 1 && serialType <= 4:
+			var v int64
+			for _, b := range body[:serialType] {
+				v = v<<8 | int64(b)
+			}
+			values = append(values, v)
+			body = body[serialType:]
+		case serialType >= 13 && serialType%2 == 1:
+			size := (serialType - 13) / 2
+			values = append(values, string(body[:size]))
+			body = body[size:]
+		default:
+			t.Fatalf("unexpected serial type %d", serialType)
+		}
+	}
+	require.Empty(t, body)
+
+	return values
+}
+
+func TestSQLiteWriter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.db")
+	w, err := NewSQLiteWriter(path)
+	require.NoError(t, err)
+
+	// enough tables for the schema not to fit in the first page, and rows for
+	// interior pages and overflow pages
+	var expected [][][]any
+	for i := 0; i < 40; i++ {
+		columns := []string{"key", "value", fmt.Sprintf("a column with a \"long\" name %d", i)}
+		require.NoError(t, w.CreateTable(fmt.Sprintf("table_%d", i), columns))
+
+		var rows [][]any
+		for j := 0; j < i*i; j++ {
+			key, value := fmt.Sprint(j), strings.Repeat("v", j*13%(i*150+1))
+			require.NoError(t, w.WriteRow([]*string{&key, &value, nil}))
+			rows = append(rows, []any{key, value, nil})
+		}
+		expected = append(expected, rows)
+	}
+	require.Error(t, w.WriteRow([]*string{nil}))
+	require.NoError(t, w.Close())
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "SQLite format 3\x00", string(bz[:16]))
+	pages := binary.BigEndian.Uint32(bz[28:])
+	require.Equal(t, int(pages)*sqlitePageSize, len(bz))
+
+	r := sqliteReader{t: t, bz: bz}
+	schema := r.rows(1, sqliteHeaderSize, new([]int64))
+	require.Len(t, schema, len(expected))
+	require.Equal(t, byte(sqliteTableInteriorPage), bz[sqliteHeaderSize])
+
+	for i, table := range schema {
+		name := fmt.Sprintf("table_%d", i)
+		require.Equal(t, []any{"table", name, name, table[3], fmt.Sprintf(
+			`CREATE TABLE "%s"("key" TEXT, "value" TEXT, "a column with a ""long"" name %d" TEXT)`, name, i)}, table)
+
+		rows := r.rows(uint32(table[3].(int64)), 0, new([]int64))
+		if len(expected[i]) == 0 {
+			require.Empty(t, rows)
+		} else {
+			require.Equal(t, expected[i], rows)
+		}
+	}
+
+	// an existing database is not overwritten
+	_, err = NewSQLiteWriter(path)
+	require.Error(t, err)
+
+	// the database is valid for SQLite itself
+	db := openSQLiteFile(t, path)
+	var integrity string
+	require.NoError(t, db.QueryRow("PRAGMA integrity_check").Scan(&integrity))
+	require.Equal(t, "ok", integrity)
+	for i, rows := range expected {
+		columns, actual := readSQLiteTable(t, db, fmt.Sprintf("table_%d", i))
+		require.Equal(t, []string{"key", "value", fmt.Sprintf("a column with a \"long\" name %d", i)}, columns)
+		require.Len(t, actual, len(rows))
+		for j, row := range rows {
+			require.Equal(t, row[0], *actual[j][0])
+			require.Equal(t, row[1], *actual[j][1])
+			require.Nil(t, actual[j][2])
+		}
+	}
+}
+
+// openSQLiteFile opens a database written by a sqliteWriter read-only with the
+// modernc.org/sqlite implementation of SQLite, independently of the writer.
+func openSQLiteFile(t *testing.T, path string) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", "file:"+path+"?mode=ro")
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, db.Close()) })
+
+	return db
+}
+
+// readSQLiteTable returns the columns and the rows of a table of TEXT columns,
+// in rowid order.
+func readSQLiteTable(t *testing.T, db *sql.DB, table string) ([]string, [][]*string) {
+	t.Helper()
+
+	rows, err := db.Query(fmt.Sprintf("SELECT * FROM %s ORDER BY rowid", sqliteQuote(table)))
+	require.NoError(t, err)
+	defer rows.Close()
+
+	columnTypes, err := rows.ColumnTypes()
+	require.NoError(t, err)
+	columns := make([]string, len(columnTypes))
+	for i, columnType := range columnTypes {
+		require.Equal(t, "TEXT", columnType.DatabaseTypeName(), columnType.Name())
+		columns[i] = columnType.Name()
+	}
+
+	var values [][]*string
+	for rows.Next() {
+		row := make([]sql.NullString, len(columns))
+		dest := make([]any, len(columns))
+		for i := range row {
+			dest[i] = &row[i]
+		}
+		require.NoError(t, rows.Scan(dest...))
+
+		strs := make([]*string, len(columns))
+		for i, v := range row {
+			if v.Valid {
+				strs[i] = &row[i].String
+			}
+		}
+		values = append(values, strs)
+	}
+	require.NoError(t, rows.Err())
+
+	return columns, values
+}
+
+func TestSQLiteWriter_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.db")
+	w, err := NewSQLiteWriter(path)
+	require.NoError(t, err)
+
+	// values larger than a page spill to overflow pages, and enough rows for
+	// several levels of interior pages
+	columns := []string{"key", "value", "memo"}
+	var rows [][]*string
+	for i := 0; i < 5000; i++ {
+		row := []*string{str(fmt.Sprintf("key-%d", i)), nil, nil}
+		if i%3 != 0 {
+			row[1] = str(strings.Repeat(fmt.Sprint(i), i%1500))
+		}
+		if i%7 == 0 {
+			row[2] = str("")
+		} else if i%11 == 0 {
+			row[2] = str("mémo ✓")
+		}
+		rows = append(rows, row)
+	}
+
+	require.NoError(t, w.CreateTable("bank_balances", columns))
+	for _, row := range rows {
+		require.NoError(t, w.WriteRow(row))
+	}
+	require.NoError(t, w.CreateTable("bank_empty", []string{"key", "value"}))
+	require.NoError(t, w.Close())
+
+	db := openSQLiteFile(t, path)
+	var integrity string
+	require.NoError(t, db.QueryRow("PRAGMA integrity_check").Scan(&integrity))
+	require.Equal(t, "ok", integrity)
+
+	readColumns, readRows := readSQLiteTable(t, db, "bank_balances")
+	require.Equal(t, columns, readColumns)
+	require.Equal(t, rows, readRows)
+
+	readColumns, readRows = readSQLiteTable(t, db, "bank_empty")
+	require.Equal(t, []string{"key", "value"}, readColumns)
+	require.Empty(t, readRows)
+}
+
+func TestSQLiteVarint(t *testing.T) {
+	for _, v := range []uint64{0, 1, 127, 128, 1<<14 - 1, 1 << 14, 1<<56 - 1, 1 << 56, 1<<64 - 1} {
+		bz := appendSQLiteVarint(nil, v)
+		decoded, n := readSQLiteVarint(bz)
+		require.Equal(t, v, decoded)
+		require.Equal(t, len(bz), n)
+	}
+	require.Len(t, appendSQLiteVarint(nil, 1<<56-1), 8)
+	require.Len(t, appendSQLiteVarint(nil, 1<<56), 9)
+}
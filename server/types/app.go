@@ -14,6 +14,7 @@ import (
 	"cosmossdk.io/store/snapshots"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp/listeners"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
@@ -64,6 +65,14 @@ type (
 		Close() error
 	}
 
+	// CollectionDecoderApp is implemented by the applications decoding the
+	// state of their modules through their collections.Schema, so that the
+	// offline state commands, such as debug state-diff and export-state, can
+	// decode it.
+	CollectionDecoderApp interface {
+		CollectionDecoder() *listeners.CollectionDecoder
+	}
+
 	// AppCreator is a function that allows us to lazily initialize an
 	// application using various configurations.
 	AppCreator func(log.Logger, dbm.DB, io.Writer, AppOptions) Application
@@ -25,12 +25,15 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store"
+	"cosmossdk.io/store/historical"
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/oe"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/server/types"
@@ -336,6 +339,7 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 		startCmd,
 		cometCmd,
 		ExportCmd(appExport, defaultNodeHome),
+		ExportStateCmd(appCreator, defaultNodeHome),
 		version.NewVersionCommand(),
 		NewRollbackCmd(appCreator, defaultNodeHome),
 	)
@@ -365,6 +369,7 @@ func AddCommandsWithStartCmdOptions(rootCmd *cobra.Command, defaultNodeHome stri
 		startCmd,
 		cometCmd,
 		ExportCmd(appExport, defaultNodeHome),
+		ExportStateCmd(appCreator, defaultNodeHome),
 		version.NewVersionCommand(),
 		NewRollbackCmd(appCreator, defaultNodeHome),
 	)
@@ -507,6 +512,10 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 	if err != nil {
 		panic(err)
 	}
+	storePruningOpts, err := GetStorePruningOptionsFromFlags(appOpts)
+	if err != nil {
+		panic(err)
+	}
 
 	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
 	chainID := cast.ToString(appOpts.Get(flags.FlagChainID))
@@ -533,6 +542,7 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		cast.ToUint64(appOpts.Get(FlagStateSyncSnapshotInterval)),
 		cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotKeepRecent)),
 	)
+	snapshotOptions.Format = cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotFormat))
 
 	defaultMempool := baseapp.SetMempool(mempool.NoOpMempool{})
 	if maxTxs := cast.ToInt(appOpts.Get(FlagMempoolMaxTxs)); maxTxs >= 0 {
@@ -543,7 +553,26 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		)
 	}
 
-	return []func(*baseapp.BaseApp){
+	minGasPrices, err := sdk.ParseDecCoins(cast.ToString(appOpts.Get(FlagMinGasPrices)))
+	if err != nil {
+		panic(fmt.Errorf("invalid minimum gas prices: %w", err))
+	}
+
+	txSelector, err := baseapp.NewTxSelectorFromName(
+		cast.ToString(appOpts.Get(FlagMempoolTxSelector)),
+		cast.ToInt(appOpts.Get(FlagMempoolMaxTxsPerSender)),
+		minGasPrices,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	proposalLanes, err := GetProposalLanes(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	baseappOptions := []func(*baseapp.BaseApp){
 		baseapp.SetPruning(pruningOpts),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(FlagMinGasPrices))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(FlagHaltHeight))),
@@ -556,9 +585,69 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		baseapp.SetIAVLCacheSize(cast.ToInt(appOpts.Get(FlagIAVLCacheSize))),
 		baseapp.SetIAVLDisableFastNode(cast.ToBool(appOpts.Get(FlagDisableIAVLFastNode))),
 		defaultMempool,
+		baseapp.SetTxSelector(txSelector),
+		baseapp.SetOptimisticExecutionOptions(
+			oe.WithTelemetry(cast.ToBool(appOpts.Get(FlagOptimisticExecutionTelemetry))),
+			oe.WithStartOnFirstProcessProposal(cast.ToBool(appOpts.Get(FlagOptimisticExecutionStartOnFirstProcessProposal))),
+		),
 		baseapp.SetChainID(chainID),
 		baseapp.SetQueryGasLimit(cast.ToUint64(appOpts.Get(FlagQueryGasLimit))),
 	}
+	if len(proposalLanes) > 0 {
+		baseappOptions = append(baseappOptions, baseapp.SetProposalLanes(proposalLanes...))
+	}
+	for storeName, opts := range storePruningOpts {
+		baseappOptions = append(baseappOptions, baseapp.SetStorePruning(storeName, opts))
+	}
+	if cast.ToBool(appOpts.Get(FlagHistoricalQueriesEnable)) {
+		baseappOptions = append(baseappOptions, baseapp.SetHistoricalQueries(historical.Options{
+			CacheSize:            cast.ToInt(appOpts.Get(FlagHistoricalQueriesCacheSize)),
+			MaxConcurrentQueries: cast.ToInt(appOpts.Get(FlagHistoricalQueriesMaxConcurrentQueries)),
+			MinHeight:            cast.ToInt64(appOpts.Get(FlagHistoricalQueriesMinHeight)),
+			MaxDepth:             cast.ToInt64(appOpts.Get(FlagHistoricalQueriesMaxDepth)),
+		}))
+	}
+
+	return baseappOptions
+}
+
+// GetProposalLanes returns the proposal lanes configured in the mempool.lanes
+// tables of the app config.
+func GetProposalLanes(appOpts types.AppOptions) ([]baseapp.ProposalLane, error) {
+	opt := appOpts.Get(FlagMempoolLanes)
+	if opt == nil {
+		return nil, nil
+	}
+
+	rawLanes, err := cast.ToSliceE(opt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", FlagMempoolLanes, err)
+	}
+
+	lanes := make([]baseapp.ProposalLane, 0, len(rawLanes))
+	for i, rawLane := range rawLanes {
+		lane, err := cast.ToStringMapE(rawLane)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s #%d: %w", FlagMempoolLanes, i, err)
+		}
+
+		maxBlockSpace, err := sdkmath.LegacyNewDecFromStr(cast.ToString(lane["max-block-space"]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid max-block-space of %s #%d: %w", FlagMempoolLanes, i, err)
+		}
+
+		lanes = append(lanes, baseapp.ProposalLane{
+			Name:          cast.ToString(lane["name"]),
+			Match:         baseapp.MatchMsgTypes(cast.ToStringSlice(lane["msg-types"])...),
+			MaxBlockSpace: maxBlockSpace,
+		})
+	}
+
+	if err := baseapp.ValidateProposalLanes(lanes); err != nil {
+		return nil, err
+	}
+
+	return lanes, nil
 }
 
 func GetSnapshotStore(appOpts types.AppOptions) (*snapshots.Store, error) {
@@ -420,6 +420,33 @@ func TestInterceptConfigsWithBadPermissions(t *testing.T) {
 	}
 }
 
+func TestGetProposalLanes(t *testing.T) {
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := config.DefaultConfig()
+	conf.Mempool.Lanes = []config.ProposalLaneConfig{
+		{Name: "oracle", MaxBlockSpace: "0.1", MsgTypes: []string{"/cosmos.bank.v1beta1.MsgSend"}},
+	}
+	config.WriteConfigFile(confFile, conf)
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig())
+
+	lanes, err := server.GetProposalLanes(vpr)
+	require.NoError(t, err)
+	require.Len(t, lanes, 1)
+	require.Equal(t, "oracle", lanes[0].Name)
+	require.Equal(t, "0.100000000000000000", lanes[0].MaxBlockSpace.String())
+
+	lanes, err = server.GetProposalLanes(viper.New())
+	require.NoError(t, err)
+	require.Empty(t, lanes)
+
+	vpr.Set(server.FlagMempoolLanes, []interface{}{map[string]interface{}{"name": "oracle", "max-block-space": "2"}})
+	_, err = server.GetProposalLanes(vpr)
+	require.Error(t, err)
+}
+
 func TestEmptyMinGasPrices(t *testing.T) {
 	tempDir := t.TempDir()
 	err := os.Mkdir(filepath.Join(tempDir, "config"), os.ModePerm)
@@ -12,6 +12,7 @@ import (
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
 	"cosmossdk.io/client/v2/autocli"
 	clienthelpers "cosmossdk.io/client/v2/helpers"
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
@@ -28,6 +29,7 @@ import (
 	nftkeeper "cosmossdk.io/x/nft/keeper"
 	nftmodule "cosmossdk.io/x/nft/module"
 	"cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/textual"
 	"cosmossdk.io/x/upgrade"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
@@ -37,9 +39,11 @@ import (
 	"github.com/spf13/cast"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/listeners"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	mempoolservice "github.com/cosmos/cosmos-sdk/client/grpc/mempool"
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/address"
@@ -302,6 +306,10 @@ func NewSimApp(
 	txConfigOpts := tx.ConfigOptions{
 		EnabledSignModes:           enabledSignModes,
 		TextualCoinMetadataQueryFn: txmodule.NewBankKeeperCoinMetadataQueryFn(app.BankKeeper),
+		TextualCustomMessageRenderers: []textual.CustomMessageRenderer{
+			bank.ProvideTextualMessageRenderer(),
+			staking.ProvideTextualMessageRenderer(),
+		},
 	}
 	txConfig, err := tx.NewTxConfigWithOptions(
 		appCodec,
@@ -702,6 +710,20 @@ func (app *SimApp) GetStoreKeys() []storetypes.StoreKey {
 	return keys
 }
 
+// CollectionDecoder returns the decoder of the state of the modules through
+// their collections.Schema, used by the debug state-diff and export-state
+// commands.
+func (app *SimApp) CollectionDecoder() *listeners.CollectionDecoder {
+	return newCollectionDecoder(app.AccountKeeper, app.BankKeeper, map[string]collections.Schema{
+		distrtypes.StoreKey:    app.DistrKeeper.Schema,
+		minttypes.StoreKey:     app.MintKeeper.Schema,
+		govtypes.StoreKey:      app.GovKeeper.Schema,
+		evidencetypes.StoreKey: app.EvidenceKeeper.Schema,
+		crisistypes.StoreKey:   app.CrisisKeeper.Schema,
+		circuittypes.StoreKey:  app.CircuitKeeper.Schema,
+	})
+}
+
 // GetSubspace returns a param subspace for a given module name.
 //
 // NOTE: This is solely to be used for testing purposes.
@@ -728,6 +750,9 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 	// Register node gRPC service for grpc-gateway.
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register mempool gRPC service for grpc-gateway.
+	mempoolservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
 	// Register grpc-gateway routes for all modules.
 	app.BasicModuleManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
@@ -755,6 +780,7 @@ func (app *SimApp) RegisterTendermintService(clientCtx client.Context) {
 
 func (app *SimApp) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
 	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter(), cfg)
+	mempoolservice.RegisterMempoolService(app.GRPCQueryRouter(), app.Mempool(), app.TxEncode)
 }
 
 // GetMaccPerms returns a copy of the module account permissions
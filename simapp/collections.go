@@ -0,0 +1,36 @@
+package simapp
+
+import (
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/listeners"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// newCollectionDecoder returns the decoder of the state of the modules through
+// their collections.Schema, by store key, adding the schemas of the auth and
+// bank modules, whose accounts, balances and supply keys are decoded as well.
+func newCollectionDecoder(
+	accountKeeper authkeeper.AccountKeeper,
+	bankKeeper bankkeeper.BaseKeeper,
+	schemas map[string]collections.Schema,
+) *listeners.CollectionDecoder {
+	schemas[authtypes.StoreKey] = accountKeeper.Schema
+	schemas[banktypes.StoreKey] = bankKeeper.Schema
+	decoder := listeners.NewCollectionDecoder(schemas)
+
+	for _, err := range []error{
+		listeners.RegisterKeyCodec(decoder, authtypes.StoreKey, "accounts", accountKeeper.Accounts.KeyCodec()),
+		listeners.RegisterKeyCodec(decoder, banktypes.StoreKey, "balances", bankKeeper.Balances.KeyCodec()),
+		listeners.RegisterKeyCodec(decoder, banktypes.StoreKey, "supply", bankKeeper.Supply.KeyCodec()),
+	} {
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return decoder
+}
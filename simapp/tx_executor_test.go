@@ -0,0 +1,166 @@
+package simapp
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// TestParallelTxExecutorDeterminism replays the same blocks of bank sends on a
+// SimApp executing txs sequentially and on one executing them in parallel, and
+// checks that the results and app hashes are the same. Most txs pay fees, so
+// that they conflict on the fee collector account, and some txs spend funds
+// received earlier in the same block.
+func TestParallelTxExecutorDeterminism(t *testing.T) {
+	const (
+		chainID     = "simapp-parallel"
+		numAccounts = 10
+		numBlocks   = 5
+	)
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	// the last account only holds what it receives in the blocks
+	poor := numAccounts
+	var (
+		privs    = make([]cryptotypes.PrivKey, numAccounts+1)
+		addrs    = make([]sdk.AccAddress, numAccounts+1)
+		accs     = make([]authtypes.GenesisAccount, numAccounts+1)
+		balances = make([]banktypes.Balance, numAccounts+1)
+	)
+	for i := range privs {
+		amount := sdkmath.NewInt(100000000000000)
+		if i == poor {
+			amount = sdkmath.OneInt()
+		}
+
+		privs[i] = secp256k1.GenPrivKeyFromSecret([]byte(fmt.Sprintf("account-%d", i)))
+		addrs[i] = sdk.AccAddress(privs[i].PubKey().Address())
+		accs[i] = authtypes.NewBaseAccount(addrs[i], privs[i].PubKey(), uint64(i), 0)
+		balances[i] = banktypes.Balance{
+			Address: addrs[i].String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount)),
+		}
+	}
+
+	newApp := func(opts ...func(*baseapp.BaseApp)) *SimApp {
+		appOptions := make(simtestutil.AppOptionsMap, 0)
+		appOptions[flags.FlagHome] = t.TempDir()
+
+		opts = append(opts, baseapp.SetChainID(chainID))
+		app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOptions, opts...)
+
+		genesisState, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), app.DefaultGenesis(), valSet, accs, balances...)
+		require.NoError(t, err)
+		stateBytes, err := json.MarshalIndent(genesisState, "", " ")
+		require.NoError(t, err)
+
+		_, err = app.InitChain(&abci.RequestInitChain{
+			ChainId:         chainID,
+			Validators:      []abci.ValidatorUpdate{},
+			ConsensusParams: simtestutil.DefaultConsensusParams,
+			AppStateBytes:   stateBytes,
+		})
+		require.NoError(t, err)
+
+		return app
+	}
+
+	sequential := newApp()
+	parallel := newApp(baseapp.SetTxExecutor(baseapp.NewParallelTxExecutor(4)))
+	txConfig := sequential.TxConfig()
+
+	r := rand.New(rand.NewSource(1))
+	seqs := make([]uint64, numAccounts+1)
+	newSend := func(from, to int, amount, fee int64) []byte {
+		msg := banktypes.NewMsgSend(addrs[from], addrs[to], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)))
+		tx, err := simtestutil.GenSignedMockTx(r, txConfig, []sdk.Msg{msg}, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, fee)),
+			simtestutil.DefaultGenTxGas, chainID, []uint64{uint64(from)}, []uint64{seqs[from]}, privs[from])
+		require.NoError(t, err)
+
+		bz, err := txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		return bz
+	}
+
+	for height := int64(1); height <= numBlocks; height++ {
+		var (
+			txs     [][]byte
+			failing = make(map[int]bool)
+		)
+		for i := 0; i < 3*numAccounts; i++ {
+			// senders send several txs per block, and recipients overlap
+			from, to := r.Intn(numAccounts), r.Intn(numAccounts)
+			txs = append(txs, newSend(from, to, 1+r.Int63n(1000), r.Int63n(100)))
+			seqs[from]++
+
+			if i%10 == 0 {
+				// a tx with a sequence in the future fails
+				failing[len(txs)] = true
+				seqs[from]++
+				txs = append(txs, newSend(from, to, 1+r.Int63n(1000), r.Int63n(100)))
+				seqs[from]--
+			}
+
+			if i%10 == 5 {
+				// the poor account spends the funds it just received, which
+				// only succeeds once the funding tx is committed, then fails
+				// to spend more than it holds, still paying the fee
+				txs = append(txs, newSend(from, poor, 1000, 10))
+				seqs[from]++
+				txs = append(txs, newSend(poor, from, 800, 100))
+				seqs[poor]++
+				failing[len(txs)] = true
+				txs = append(txs, newSend(poor, from, 1000, 50))
+				seqs[poor]++
+			}
+		}
+		failing[len(txs)] = true
+		txs = append(txs, []byte("not a tx"))
+
+		req := &abci.RequestFinalizeBlock{
+			Height:             height,
+			Txs:                txs,
+			NextValidatorsHash: valSet.Hash(),
+		}
+		expected, err := sequential.FinalizeBlock(req)
+		require.NoError(t, err)
+		actual, err := parallel.FinalizeBlock(req)
+		require.NoError(t, err)
+
+		for i, res := range expected.TxResults {
+			require.Equal(t, failing[i], !res.IsOK(), "tx %d: %s", i, res.Log)
+		}
+		require.Equal(t, expected.TxResults, actual.TxResults)
+		require.Equal(t, expected.AppHash, actual.AppHash)
+
+		_, err = sequential.Commit()
+		require.NoError(t, err)
+		_, err = parallel.Commit()
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, sequential.LastCommitID(), parallel.LastCommitID())
+}
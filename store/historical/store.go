@@ -0,0 +1,198 @@
+package historical
+
+import (
+	"sync"
+
+	"github.com/hashicorp/golang-lru/simplelru"
+	"google.golang.org/grpc/codes"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/types"
+)
+
+var (
+	// ErrTooManyQueries is returned when the queries at a height exceed MaxConcurrentQueries.
+	ErrTooManyQueries = errors.RegisterWithGRPCCode(types.StoreCodespace, 8, codes.ResourceExhausted, "too many concurrent queries")
+
+	// ErrHeightNotQueryable is returned when a height is out of the bounds of the options.
+	ErrHeightNotQueryable = errors.RegisterWithGRPCCode(types.StoreCodespace, 9, codes.OutOfRange, "height not queryable")
+)
+
+// MultiStore is the multistore whose past versions are queried, implemented by rootmulti.Store.
+type MultiStore interface {
+	LatestVersion() int64
+	GetCommitKVStore(key types.StoreKey) types.CommitKVStore
+	LoadImmutableStores(version int64) (map[types.StoreKey]types.KVStore, error)
+	CacheMultiStoreFromStores(stores map[types.StoreKey]types.KVStore) types.CacheMultiStore
+}
+
+// Options defines the bounds of the historical queries.
+type Options struct {
+	// CacheSize is the number of versions whose stores are kept loaded.
+	CacheSize int
+	// MaxConcurrentQueries is the number of queries served at once at a single height,
+	// 0 for no limit.
+	MaxConcurrentQueries int
+	// MinHeight is the lowest queryable height, 0 for no bound.
+	MinHeight int64
+	// MaxDepth is the number of heights below the latest one that are queryable, 0 for
+	// no bound.
+	MaxDepth int64
+}
+
+// DefaultOptions returns the default historical query options.
+func DefaultOptions() Options {
+	return Options{
+		CacheSize:            16,
+		MaxConcurrentQueries: 32,
+	}
+}
+
+// Store serves read-only queries at past heights of a multistore, keeping the stores of
+// the most recently queried versions loaded instead of loading them for every query.
+type Store struct {
+	ms   MultiStore
+	opts Options
+
+	mtx sync.Mutex
+	// versions holds the *version loaded, by height.
+	versions *simplelru.LRU
+	// queries holds the number of queries being served, by height.
+	queries map[int64]int
+}
+
+// version holds the stores loaded at a height, once loaded is closed.
+type version struct {
+	loaded chan struct{}
+	stores map[types.StoreKey]types.KVStore
+	err    error
+}
+
+// NewStore returns a Store serving the queries at past heights of the multistore.
+func NewStore(ms MultiStore, opts Options) (*Store, error) {
+	versions, err := simplelru.NewLRU(opts.CacheSize, nil)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidRequest, "historical query cache size %d: %s", opts.CacheSize, err)
+	}
+
+	return &Store{
+		ms:       ms,
+		opts:     opts,
+		versions: versions,
+		queries:  make(map[int64]int),
+	}, nil
+}
+
+// CacheMultiStoreWithVersion returns a cache multistore branching the stores at a past
+// height, along with a function to call once the query is done, which releases its slot
+// among the concurrent queries at that height.
+func (s *Store) CacheMultiStoreWithVersion(height int64) (types.CacheMultiStore, func(), error) {
+	if err := s.checkHeight(height); err != nil {
+		return nil, nil, err
+	}
+
+	release, err := s.acquire(height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stores, err := s.loadStores(height)
+	if err != nil {
+		release()
+		return nil, nil, err
+	}
+
+	return s.ms.CacheMultiStoreFromStores(stores), release, nil
+}
+
+// checkHeight returns an error if the height is out of the bounds of the options.
+func (s *Store) checkHeight(height int64) error {
+	if s.opts.MinHeight > 0 && height < s.opts.MinHeight {
+		return errors.Wrapf(ErrHeightNotQueryable, "height %d is lower than the minimum height %d", height, s.opts.MinHeight)
+	}
+	if latest := s.ms.LatestVersion(); s.opts.MaxDepth > 0 && latest-height > s.opts.MaxDepth {
+		return errors.Wrapf(ErrHeightNotQueryable, "height %d is more than %d heights below the latest height %d", height, s.opts.MaxDepth, latest)
+	}
+
+	return nil
+}
+
+// acquire takes a slot among the concurrent queries at the height.
+func (s *Store) acquire(height int64) (func(), error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.opts.MaxConcurrentQueries > 0 && s.queries[height] >= s.opts.MaxConcurrentQueries {
+		return nil, errors.Wrapf(ErrTooManyQueries, "%d queries at height %d", s.queries[height], height)
+	}
+	s.queries[height]++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.mtx.Lock()
+			defer s.mtx.Unlock()
+
+			if s.queries[height]--; s.queries[height] == 0 {
+				delete(s.queries, height)
+			}
+		})
+	}, nil
+}
+
+// loadStores returns the stores at the height, loading them unless cached. Concurrent
+// queries at a height not cached wait for the stores to be loaded once.
+func (s *Store) loadStores(height int64) (map[types.StoreKey]types.KVStore, error) {
+	for {
+		s.mtx.Lock()
+		cached, ok := s.versions.Get(height)
+		if !ok {
+			v := &version{loaded: make(chan struct{})}
+			s.versions.Add(height, v)
+			s.mtx.Unlock()
+
+			v.stores, v.err = s.ms.LoadImmutableStores(height)
+			close(v.loaded)
+			if v.err != nil {
+				s.evict(height, v)
+			}
+			return v.stores, v.err
+		}
+		s.mtx.Unlock()
+
+		v := cached.(*version)
+		<-v.loaded
+		if v.err != nil {
+			return nil, v.err
+		}
+		// the stores may have been pruned since they were loaded, in which case they are
+		// loaded again to leave out the pruned ones, or fail
+		if s.isPruned(height, v) {
+			s.evict(height, v)
+			continue
+		}
+		return v.stores, nil
+	}
+}
+
+// isPruned returns true if the height has been pruned from any of the stores loaded.
+func (s *Store) isPruned(height int64, v *version) bool {
+	for key := range v.stores {
+		store, ok := s.ms.GetCommitKVStore(key).(interface{ VersionExists(int64) bool })
+		if ok && !store.VersionExists(height) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// evict removes the version from the cache, unless it has been replaced already.
+func (s *Store) evict(height int64, v *version) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if cached, ok := s.versions.Peek(height); ok && cached == v {
+		s.versions.Remove(height)
+	}
+}
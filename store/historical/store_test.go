@@ -0,0 +1,152 @@
+package historical_test
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/historical"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/types"
+)
+
+var storeKey = types.NewKVStoreKey("store")
+
+// countingStore counts the versions loaded by the rootmulti store.
+type countingStore struct {
+	*rootmulti.Store
+	loads atomic.Int32
+}
+
+func (s *countingStore) LoadImmutableStores(version int64) (map[types.StoreKey]types.KVStore, error) {
+	s.loads.Add(1)
+	return s.Store.LoadImmutableStores(version)
+}
+
+func newStore(t *testing.T, heights int) *countingStore {
+	t.Helper()
+
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	ms.MountStoreWithDB(storeKey, types.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	for i := 1; i <= heights; i++ {
+		ms.GetKVStore(storeKey).Set([]byte("height"), []byte(fmt.Sprint(i)))
+		ms.Commit()
+	}
+
+	return &countingStore{Store: ms}
+}
+
+func TestStoreCache(t *testing.T) {
+	ms := newStore(t, 5)
+	store, err := historical.NewStore(ms, historical.Options{CacheSize: 2})
+	require.NoError(t, err)
+
+	for _, height := range []int64{3, 3, 2, 3, 1, 2} {
+		cms, release, err := store.CacheMultiStoreWithVersion(height)
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprint(height)), cms.GetKVStore(storeKey).Get([]byte("height")))
+
+		// the writes of a query are not visible to the others
+		cms.GetKVStore(storeKey).Set([]byte("height"), []byte("0"))
+		release()
+	}
+	// 3 and 2 are loaded once, then 1 evicts 2, the least recently used
+	require.Equal(t, int32(4), ms.loads.Load())
+
+	// failed loads are not cached
+	for i := 0; i < 2; i++ {
+		_, _, err = store.CacheMultiStoreWithVersion(10)
+		require.Error(t, err)
+	}
+	require.Equal(t, int32(6), ms.loads.Load())
+
+	_, err = historical.NewStore(ms, historical.Options{})
+	require.Error(t, err)
+}
+
+func TestStoreConcurrentLoads(t *testing.T) {
+	ms := newStore(t, 5)
+	store, err := historical.NewStore(ms, historical.Options{CacheSize: 2})
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cms, release, err := store.CacheMultiStoreWithVersion(4)
+			require.NoError(t, err)
+			defer release()
+			require.Equal(t, []byte("4"), cms.GetKVStore(storeKey).Get([]byte("height")))
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, int32(1), ms.loads.Load())
+}
+
+func TestStoreBounds(t *testing.T) {
+	ms := newStore(t, 10)
+	store, err := historical.NewStore(ms, historical.Options{CacheSize: 2, MinHeight: 3, MaxDepth: 5})
+	require.NoError(t, err)
+
+	for height := int64(1); height <= 10; height++ {
+		_, release, err := store.CacheMultiStoreWithVersion(height)
+		if height < 5 {
+			require.ErrorIs(t, err, historical.ErrHeightNotQueryable, "height %d", height)
+			continue
+		}
+		require.NoError(t, err, "height %d", height)
+		release()
+	}
+}
+
+func TestStoreConcurrentQueries(t *testing.T) {
+	ms := newStore(t, 5)
+	store, err := historical.NewStore(ms, historical.Options{CacheSize: 2, MaxConcurrentQueries: 2})
+	require.NoError(t, err)
+
+	_, release1, err := store.CacheMultiStoreWithVersion(3)
+	require.NoError(t, err)
+	_, release2, err := store.CacheMultiStoreWithVersion(3)
+	require.NoError(t, err)
+	_, _, err = store.CacheMultiStoreWithVersion(3)
+	require.ErrorIs(t, err, historical.ErrTooManyQueries)
+
+	// the limit is per height
+	_, release3, err := store.CacheMultiStoreWithVersion(4)
+	require.NoError(t, err)
+	release3()
+
+	// releasing twice frees a single slot
+	release1()
+	release1()
+	_, release4, err := store.CacheMultiStoreWithVersion(3)
+	require.NoError(t, err)
+	_, _, err = store.CacheMultiStoreWithVersion(3)
+	require.ErrorIs(t, err, historical.ErrTooManyQueries)
+	release2()
+	release4()
+}
+
+func TestStorePruned(t *testing.T) {
+	ms := newStore(t, 5)
+	store, err := historical.NewStore(ms, historical.Options{CacheSize: 2})
+	require.NoError(t, err)
+
+	_, release, err := store.CacheMultiStoreWithVersion(2)
+	require.NoError(t, err)
+	release()
+
+	// the cached version is dropped once pruned
+	require.NoError(t, ms.PruneStores(2))
+	_, _, err = store.CacheMultiStoreWithVersion(2)
+	require.Error(t, err)
+	require.Equal(t, int32(2), ms.loads.Load())
+}
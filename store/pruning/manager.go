@@ -20,6 +20,8 @@ type Manager struct {
 	logger           log.Logger
 	opts             types.PruningOptions
 	snapshotInterval uint64
+	// storeOpts holds the pruning strategies of the stores not pruned with opts, by store name.
+	storeOpts map[string]types.PruningOptions
 	// Snapshots are taken in a separate goroutine from the regular execution
 	// and can be delivered asynchrounously via HandleSnapshotHeight.
 	// Therefore, we sync access to pruneSnapshotHeights with this mutex.
@@ -51,6 +53,7 @@ func NewManager(db dbm.DB, logger log.Logger) *Manager {
 		db:                   db,
 		logger:               logger,
 		opts:                 types.NewPruningOptions(types.PruningNothing),
+		storeOpts:            make(map[string]types.PruningOptions),
 		pruneSnapshotHeights: []int64{0},
 	}
 }
@@ -65,12 +68,45 @@ func (m *Manager) GetOptions() types.PruningOptions {
 	return m.opts
 }
 
+// SetStoreOptions sets the pruning strategy of a single store, overriding the one of the manager.
+func (m *Manager) SetStoreOptions(storeName string, opts types.PruningOptions) {
+	m.storeOpts[storeName] = opts
+}
+
+// GetStoreOptions fetches the pruning strategy of a store, which is the one of the manager unless
+// overridden with SetStoreOptions.
+func (m *Manager) GetStoreOptions(storeName string) types.PruningOptions {
+	if opts, ok := m.storeOpts[storeName]; ok {
+		return opts
+	}
+	return m.opts
+}
+
+// HasStoreOptions returns true if some stores have a pruning strategy of their own, so that the
+// heights may be pruned from some stores only.
+func (m *Manager) HasStoreOptions() bool {
+	return len(m.storeOpts) > 0
+}
+
+// prunesNothing returns true if no store is pruned.
+func (m *Manager) prunesNothing() bool {
+	if m.opts.GetPruningStrategy() != types.PruningNothing {
+		return false
+	}
+	for _, opts := range m.storeOpts {
+		if opts.GetPruningStrategy() != types.PruningNothing {
+			return false
+		}
+	}
+	return true
+}
+
 // HandleSnapshotHeight persists the snapshot height to be pruned at the next appropriate
 // height defined by the pruning strategy. It flushes the update to disk and panics if the flush fails.
-// The input height must be greater than 0, and the pruning strategy must not be set to pruning nothing.
-// If either of these conditions is not met, this function does nothing.
+// The input height must be greater than 0, and the pruning strategy of some stores must not be set to
+// pruning nothing. If either of these conditions is not met, this function does nothing.
 func (m *Manager) HandleSnapshotHeight(height int64) {
-	if m.opts.GetPruningStrategy() == types.PruningNothing || height <= 0 {
+	if m.prunesNothing() || height <= 0 {
 		return
 	}
 
@@ -101,19 +137,29 @@ func (m *Manager) SetSnapshotInterval(snapshotInterval uint64) {
 
 // GetPruningHeight returns the height which can prune upto if it is able to prune at the given height.
 func (m *Manager) GetPruningHeight(height int64) int64 {
-	if m.opts.GetPruningStrategy() == types.PruningNothing {
+	return m.getPruningHeight(m.opts, height)
+}
+
+// GetStorePruningHeight returns the height which the store can prune upto if it is able to prune at
+// the given height, according to its own pruning strategy.
+func (m *Manager) GetStorePruningHeight(storeName string, height int64) int64 {
+	return m.getPruningHeight(m.GetStoreOptions(storeName), height)
+}
+
+func (m *Manager) getPruningHeight(opts types.PruningOptions, height int64) int64 {
+	if opts.GetPruningStrategy() == types.PruningNothing {
 		return 0
 	}
-	if m.opts.Interval <= 0 {
+	if opts.Interval <= 0 {
 		return 0
 	}
 
-	if height%int64(m.opts.Interval) != 0 || height <= int64(m.opts.KeepRecent) {
+	if height%int64(opts.Interval) != 0 || height <= int64(opts.KeepRecent) {
 		return 0
 	}
 
 	// Consider the snapshot height
-	pruneHeight := height - 1 - int64(m.opts.KeepRecent) // we should keep the current height at least
+	pruneHeight := height - 1 - int64(opts.KeepRecent) // we should keep the current height at least
 
 	m.pruneSnapshotHeightsMx.RLock()
 	defer m.pruneSnapshotHeightsMx.RUnlock()
@@ -138,7 +184,7 @@ func (m *Manager) GetPruningHeight(height int64) int64 {
 
 // LoadSnapshotHeights loads the snapshot heights from the database as a crash recovery.
 func (m *Manager) LoadSnapshotHeights(db dbm.DB) error {
-	if m.opts.GetPruningStrategy() == types.PruningNothing {
+	if m.prunesNothing() {
 		return nil
 	}
 
@@ -196,6 +196,28 @@ func TestPruningHeight_Inputs(t *testing.T) {
 	}
 }
 
+func TestStoreOptions(t *testing.T) {
+	manager := pruning.NewManager(db.NewMemDB(), log.NewNopLogger())
+	manager.SetOptions(types.NewPruningOptions(types.PruningNothing))
+	require.False(t, manager.HasStoreOptions())
+
+	manager.SetStoreOptions("wasm", types.NewCustomPruningOptions(10, 10))
+	require.True(t, manager.HasStoreOptions())
+	require.Equal(t, types.NewCustomPruningOptions(10, 10), manager.GetStoreOptions("wasm"))
+	require.Equal(t, types.NewPruningOptions(types.PruningNothing), manager.GetStoreOptions("bank"))
+
+	require.Equal(t, int64(0), manager.GetPruningHeight(100))
+	require.Equal(t, int64(0), manager.GetStorePruningHeight("bank", 100))
+	require.Equal(t, int64(89), manager.GetStorePruningHeight("wasm", 100))
+	require.Equal(t, int64(0), manager.GetStorePruningHeight("wasm", 101))
+
+	// snapshot heights are kept for the stores pruned while the others prune nothing
+	manager.SetSnapshotInterval(50)
+	require.Equal(t, int64(49), manager.GetStorePruningHeight("wasm", 70))
+	manager.HandleSnapshotHeight(50)
+	require.Equal(t, int64(59), manager.GetStorePruningHeight("wasm", 70))
+}
+
 func TestHandleSnapshotHeight_DbErr_Panic(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
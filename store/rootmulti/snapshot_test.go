@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"os"
 	"testing"
 
 	dbm "github.com/cosmos/cosmos-db"
@@ -122,8 +123,8 @@ func assertStoresEqual(t *testing.T, expect, actual types.CommitKVStore, msgAndA
 func TestMultistoreSnapshot_Checksum(t *testing.T) {
 	// Chunks from different nodes must fit together, so all nodes must produce identical chunks.
 	// This checksum test makes sure that the byte stream remains identical. If the test fails
-	// without having changed the data (e.g. because the Protobuf or zlib encoding changes),
-	// snapshottypes.CurrentFormat must be bumped.
+	// without having changed the data (e.g. because the Protobuf, zlib or zstd encoding changes),
+	// the snapshot format must be bumped.
 	store := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 5, 10000)
 	version := uint64(store.LastCommitID().Version)
 
@@ -139,12 +140,29 @@ func TestMultistoreSnapshot_Checksum(t *testing.T) {
 			"a4a864e6c02c9fca5837ec80dc84f650b25276ed7e4820cf7516ced9f9901b86",
 			"980925390cc50f14998ecb1e87de719ca9dd7e72f5fefbe445397bf670f36c31",
 		}},
+		{snapshottypes.ParallelFormat, []string{
+			"22fbdb6b71f35d5640e3ccefc9f7e3772c8648318485183fae95dfbcc303f3a8",
+			"7e977d2f5f053f85e62bd22eea95a12536d7128350a81b5caa7bff135ea45269",
+			"2d44e1bae480cf3368f62e8cf4affe384829e051a6c9b921fa054f49fbc0085a",
+			"36d4a1f09661ee727f89dae74b64742191d57dfece2b36a4fc79d2457858d5b3",
+			"1cca389bd5c90f899730b5d2e4e9d2117663a60389fabb9009e684ea49064faa",
+			"e9d73c94d2e76cfcc955ed2fa9f62285925fccab3664819fb822a1da34ce6688",
+		}},
 	}
 	for _, tc := range testcases {
 		tc := tc
 		t.Run(fmt.Sprintf("Format %v", tc.format), func(t *testing.T) {
 			ch := make(chan io.ReadCloser)
 			go func() {
+				if tc.format == snapshottypes.ParallelFormat {
+					streamWriter := snapshots.NewParallelStreamWriter(ch)
+					defer streamWriter.Close()
+					require.NotNil(t, streamWriter)
+					err := store.Snapshot(version, streamWriter)
+					require.NoError(t, err)
+					return
+				}
+
 				streamWriter := snapshots.NewStreamWriter(ch)
 				defer streamWriter.Close()
 				require.NotNil(t, streamWriter)
@@ -233,6 +251,162 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 	}
 }
 
+func TestMultistoreSnapshotRestoreParallel(t *testing.T) {
+	source := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 5, 3000)
+	version := uint64(source.LastCommitID().Version)
+
+	opts := snapshottypes.NewSnapshotOptions(0, 0)
+	opts.Format = snapshottypes.ParallelFormat
+	sourceStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	sourceManager := snapshots.NewManager(sourceStore, opts, source, nil, log.NewNopLogger())
+	snapshot, err := sourceManager.Create(version)
+	require.NoError(t, err)
+	require.Equal(t, snapshottypes.ParallelFormat, snapshot.Format)
+	require.Greater(t, snapshot.Chunks, uint32(1))
+
+	// restore it as state sync does, chunk by chunk
+	target := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	for _, key := range source.StoreKeysByName() {
+		target.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, target.LoadLatestVersion())
+	targetStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	targetManager := snapshots.NewManager(targetStore, snapshottypes.NewSnapshotOptions(0, 0), target, nil, log.NewNopLogger())
+
+	require.NoError(t, targetManager.Restore(*snapshot))
+	done := false
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := sourceManager.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		done, err = targetManager.RestoreChunk(chunk)
+		require.NoError(t, err)
+	}
+	require.True(t, done)
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for _, key := range source.StoreKeysByName() {
+		assertStoresEqual(t, source.GetStoreByName(key.Name()).(types.CommitKVStore),
+			target.GetStoreByName(key.Name()).(types.CommitKVStore), "store %q not equal", key.Name())
+	}
+
+	_, err = target.Restore(version, 2, nil)
+	require.ErrorIs(t, err, snapshottypes.ErrUnknownFormat)
+}
+
+func TestMultistoreSnapshotVerify(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+	version := uint64(source.LastCommitID().Version)
+
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	manager := snapshots.NewManager(snapshotStore, snapshottypes.NewSnapshotOptions(0, 0), source, nil, log.NewNopLogger())
+	snapshot, err := manager.Create(version)
+	require.NoError(t, err)
+
+	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	verified, err := manager.VerifySnapshot(version, snapshot.Format, target)
+	require.NoError(t, err)
+	require.Equal(t, snapshot, verified)
+	require.Equal(t, source.LastCommitID(), target.LastCommitID())
+
+	_, err = manager.VerifySnapshot(version+1, snapshot.Format, newMultiStoreWithMixedMounts(dbm.NewMemDB()))
+	require.ErrorIs(t, err, snapshottypes.ErrInvalidMetadata)
+
+	// corrupted chunks are detected before restoring them
+	path := snapshotStore.PathChunk(version, snapshot.Format, 0)
+	chunk, err := os.ReadFile(path)
+	require.NoError(t, err)
+	chunk[len(chunk)/2] ^= 0xff
+	require.NoError(t, os.WriteFile(path, chunk, 0o600))
+	_, err = manager.VerifySnapshot(version, snapshot.Format, newMultiStoreWithMixedMounts(dbm.NewMemDB()))
+	require.ErrorIs(t, err, snapshottypes.ErrChunkHashMismatch)
+}
+
+func TestMultistoreSnapshotRestoreIncremental(t *testing.T) {
+	source := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	r := rand.New(rand.NewSource(1))
+	commit := func(writes int) {
+		for _, name := range []string{"iavl1", "iavl2"} {
+			store := source.GetStoreByName(name).(types.CommitKVStore)
+			for i := 0; i < writes; i++ {
+				key := []byte(fmt.Sprintf("key%03d", r.Intn(500)))
+				if r.Intn(4) == 0 {
+					store.Delete(key)
+				} else {
+					store.Set(key, []byte(fmt.Sprintf("value%d", r.Int())))
+				}
+			}
+		}
+		source.Commit()
+	}
+
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	sourceManager := snapshots.NewManager(snapshotStore, snapshottypes.NewSnapshotOptions(0, 0), source, nil, log.NewNopLogger())
+
+	commit(500)
+	_, err = sourceManager.Create(1)
+	require.NoError(t, err)
+	for height := uint64(2); height <= 3; height++ {
+		commit(10)
+		_, err = sourceManager.CreateIncremental(height, height-1)
+		require.NoError(t, err)
+	}
+
+	_, err = sourceManager.CreateIncremental(4, 3)
+	require.Error(t, err, "future height")
+	commit(10)
+	_, err = sourceManager.CreateIncremental(4, 2)
+	require.NoError(t, err)
+
+	// incremental snapshots only hold the changed nodes
+	full, err := snapshotStore.Get(1, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+	incremental, err := snapshotStore.Get(4, snapshottypes.IncrementalFormat)
+	require.NoError(t, err)
+	fullSize, incrementalSize := snapshotSize(t, snapshotStore, full), snapshotSize(t, snapshotStore, incremental)
+	require.Less(t, incrementalSize*2, fullSize)
+
+	// the bases of the retained incremental snapshots are not pruned
+	pruned, err := snapshotStore.Prune(1)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, pruned)
+	snapshotList, err := snapshotStore.List()
+	require.NoError(t, err)
+	require.Len(t, snapshotList, 3)
+
+	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	targetManager := snapshots.NewManager(snapshotStore, snapshottypes.NewSnapshotOptions(0, 0), target, nil, log.NewNopLogger())
+	require.NoError(t, targetManager.RestoreLocalSnapshot(4, snapshottypes.IncrementalFormat))
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for _, key := range source.StoreKeysByName() {
+		sourceStore := source.GetStoreByName(key.Name()).(types.CommitKVStore)
+		targetStore := target.GetStoreByName(key.Name()).(types.CommitKVStore)
+		if sourceStore.GetStoreType() == types.StoreTypeIAVL {
+			assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+		}
+	}
+}
+
+func snapshotSize(t *testing.T, store *snapshots.Store, snapshot *snapshottypes.Snapshot) int {
+	t.Helper()
+
+	size := 0
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := store.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		bz, err := io.ReadAll(chunk)
+		require.NoError(t, err)
+		require.NoError(t, chunk.Close())
+		size += len(bz)
+	}
+
+	return size
+}
+
 func benchmarkMultistoreSnapshot(b *testing.B, stores uint8, storeKeys uint64) {
 	b.Helper()
 	b.Skip("Noisy with slow setup time, please see https://github.com/cosmos/cosmos-sdk/issues/8855.")
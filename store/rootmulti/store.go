@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"runtime"
 	"sort"
 	"strings"
 	"sync"
@@ -39,6 +40,14 @@ const (
 
 const iavlDisablefastNodeDefault = false
 
+const (
+	// restoreBatchSize is the number of nodes passed at once to the importer of a store when
+	// restoring stores concurrently.
+	restoreBatchSize = 1024
+	// restoreBatchBuffer is the number of batches read ahead for the importer of a store.
+	restoreBatchBuffer = 4
+)
+
 // keysFromStoreKeyMap returns a slice of keys for the provided map lexically sorted by StoreKey.Name()
 func keysFromStoreKeyMap[V any](m map[types.StoreKey]V) []types.StoreKey {
 	keys := make([]types.StoreKey, 0, len(m))
@@ -113,6 +122,17 @@ func (rs *Store) SetPruning(pruningOpts pruningtypes.PruningOptions) {
 	rs.pruningManager.SetOptions(pruningOpts)
 }
 
+// SetStorePruning sets the pruning strategy of a single sub-store, overriding the one set with
+// SetPruning.
+func (rs *Store) SetStorePruning(storeName string, pruningOpts pruningtypes.PruningOptions) {
+	rs.pruningManager.SetStoreOptions(storeName, pruningOpts)
+}
+
+// GetStorePruning fetches the pruning strategy of a sub-store.
+func (rs *Store) GetStorePruning(storeName string) pruningtypes.PruningOptions {
+	return rs.pruningManager.GetStoreOptions(storeName)
+}
+
 // SetMetrics sets the metrics gatherer for the store package
 func (rs *Store) SetMetrics(metrics metrics.StoreMetrics) {
 	rs.metrics = metrics
@@ -567,12 +587,29 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 
 // CacheMultiStoreWithVersion is analogous to CacheMultiStore except that it
 // attempts to load stores at a given version (height). An error is returned if
-// any store cannot be loaded. This should only be used for querying and
-// iterating at past heights.
+// any store cannot be loaded, unless some stores have pruning strategies of their
+// own, in which case the stores pruned at that version are left out. This should
+// only be used for querying and iterating at past heights.
 func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
-	cachedStores := make(map[types.StoreKey]types.CacheWrapper)
+	stores, err := rs.LoadImmutableStores(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.CacheMultiStoreFromStores(stores), nil
+}
+
+// LoadImmutableStores loads the read-only stores at a given version (height), see
+// CacheMultiStoreWithVersion. The IAVL stores returned may be shared by concurrent
+// queries, as long as each of them branches the stores with CacheMultiStoreFromStores.
+func (rs *Store) LoadImmutableStores(version int64) (map[types.StoreKey]types.KVStore, error) {
+	stores := make(map[types.StoreKey]types.KVStore)
 	var commitInfo *types.CommitInfo
 	storeInfos := map[string]bool{}
+	// prunedErr is the error of loading a store pruned at this version while others may not be,
+	// returned if no IAVL store holds the version.
+	var prunedErr error
+	loaded := false
 	for key, store := range rs.stores {
 		var cacheStore types.KVStore
 		switch store.GetStoreType() {
@@ -585,6 +622,7 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 			// version does not exist or is pruned, an error should be returned.
 			var err error
 			cacheStore, err = store.(*iavl.Store).GetImmutable(version)
+			loaded = loaded || err == nil
 			// if we got error from loading a module store
 			// we fetch commit info of this version
 			// we use commit info to check if the store existed at this version or not
@@ -603,26 +641,88 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 				}
 
 				// If the store existed at this version, it means there's actually an error
-				// getting the root store at this version.
+				// getting the root store at this version, unless the stores are pruned with
+				// different strategies. Such stores are replaced by a store failing with an
+				// explicit error when accessed, so that the stores still holding the version
+				// can be queried.
 				if storeInfos[key.Name()] {
-					return nil, err
+					if !rs.pruningManager.HasStoreOptions() {
+						return nil, err
+					}
+					prunedErr = err
+					stores[key] = prunedStore{err: fmt.Errorf("store %s pruned at height %d", key.Name(), version)}
 				}
+				// The store did not exist at this version, e.g. it was added by an
+				// upgrade, and is left out.
+				continue
 			}
 
 		default:
 			cacheStore = store
 		}
 
+		stores[key] = cacheStore
+	}
+	if prunedErr != nil && !loaded {
+		return nil, prunedErr
+	}
+
+	return stores, nil
+}
+
+var _ types.KVStore = prunedStore{}
+
+// prunedStore stands for a store pruned at a version still held by other stores,
+// panicking with its error when accessed.
+type prunedStore struct {
+	err error
+}
+
+// PrunedStoreError returns the error of a store loaded by LoadImmutableStores at a
+// version it is pruned at, which panics when accessed, or nil.
+func PrunedStoreError(store types.KVStore) error {
+	if s, ok := store.(prunedStore); ok {
+		return s.err
+	}
+
+	return nil
+}
+
+func (s prunedStore) GetStoreType() types.StoreType { return types.StoreTypeIAVL }
+
+func (s prunedStore) CacheWrap() types.CacheWrap { panic(s.err) }
+
+func (s prunedStore) CacheWrapWithTrace(io.Writer, types.TraceContext) types.CacheWrap {
+	panic(s.err)
+}
+
+func (s prunedStore) Get([]byte) []byte { panic(s.err) }
+
+func (s prunedStore) Has([]byte) bool { panic(s.err) }
+
+func (s prunedStore) Set(_, _ []byte) { panic(s.err) }
+
+func (s prunedStore) Delete([]byte) { panic(s.err) }
+
+func (s prunedStore) Iterator(_, _ []byte) types.Iterator { panic(s.err) }
+
+func (s prunedStore) ReverseIterator(_, _ []byte) types.Iterator { panic(s.err) }
+
+// CacheMultiStoreFromStores branches stores loaded with LoadImmutableStores into a
+// cache multistore.
+func (rs *Store) CacheMultiStoreFromStores(stores map[types.StoreKey]types.KVStore) types.CacheMultiStore {
+	cachedStores := make(map[types.StoreKey]types.CacheWrapper, len(stores))
+	for key, store := range stores {
 		// Wire the listenkv.Store to allow listeners to observe the writes from the cache store,
 		// set same listeners on cache store will observe duplicated writes.
 		if rs.ListeningEnabled(key) {
-			cacheStore = listenkv.NewStore(cacheStore, key, rs.listeners[key])
+			store = listenkv.NewStore(store, key, rs.listeners[key])
 		}
 
-		cachedStores[key] = cacheStore
+		cachedStores[key] = store
 	}
 
-	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.getTracingContext()), nil
+	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.getTracingContext())
 }
 
 // GetStore returns a mounted Store for a given StoreKey. If the StoreKey does
@@ -664,10 +764,18 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 }
 
 func (rs *Store) handlePruning(version int64) error {
-	pruneHeight := rs.pruningManager.GetPruningHeight(version)
 	rs.logger.Debug("prune start", "height", version)
 	defer rs.logger.Debug("prune end", "height", version)
-	return rs.PruneStores(pruneHeight)
+
+	if !rs.pruningManager.HasStoreOptions() {
+		return rs.PruneStores(rs.pruningManager.GetPruningHeight(version))
+	}
+
+	pruningHeights := make(map[string]int64, len(rs.stores))
+	for key := range rs.stores {
+		pruningHeights[key.Name()] = rs.pruningManager.GetStorePruningHeight(key.Name(), version)
+	}
+	return rs.PruneStoresTo(pruningHeights)
 }
 
 // PruneStores prunes all history upto the specific height of the multi store.
@@ -679,8 +787,22 @@ func (rs *Store) PruneStores(pruningHeight int64) (err error) {
 
 	rs.logger.Debug("pruning store", "heights", pruningHeight)
 
+	pruningHeights := make(map[string]int64, len(rs.stores))
+	for key := range rs.stores {
+		pruningHeights[key.Name()] = pruningHeight
+	}
+	return rs.PruneStoresTo(pruningHeights)
+}
+
+// PruneStoresTo prunes the history of each sub-store upto its height in pruningHeights, by store
+// name. The stores missing from pruningHeights, or with a height less than or equal to 0, are not
+// pruned.
+func (rs *Store) PruneStoresTo(pruningHeights map[string]int64) (err error) {
 	for key, store := range rs.stores {
-		rs.logger.Debug("pruning store", "key", key) // Also log store.name (a private variable)?
+		pruningHeight := pruningHeights[key.Name()]
+		if pruningHeight <= 0 {
+			continue
+		}
 
 		// If the store is wrapped with an inter-block cache, we must first unwrap
 		// it to get the underlying IAVL store.
@@ -688,6 +810,8 @@ func (rs *Store) PruneStores(pruningHeight int64) (err error) {
 			continue
 		}
 
+		rs.logger.Debug("pruning store", "key", key, "height", pruningHeight)
+
 		store = rs.GetCommitKVStore(key)
 
 		err := store.(*iavl.Store).DeleteVersionsTo(pruningHeight)
@@ -906,11 +1030,24 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 	return nil
 }
 
+// SupportedFormats implements snapshottypes.FormatSupporter.
+func (rs *Store) SupportedFormats() []uint32 {
+	return []uint32{snapshottypes.CurrentFormat, snapshottypes.ParallelFormat}
+}
+
 // Restore implements snapshottypes.Snapshotter.
 // returns next snapshot item and error.
 func (rs *Store) Restore(
 	height uint64, format uint32, protoReader protoio.Reader,
 ) (snapshottypes.SnapshotItem, error) {
+	switch format {
+	case snapshottypes.CurrentFormat:
+	case snapshottypes.ParallelFormat:
+		return rs.restoreConcurrently(height, protoReader)
+	default:
+		return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+
 	// Import nodes into stores. The first item is expected to be a SnapshotItem containing
 	// a SnapshotStoreItem, telling us which store to import into. The following items will contain
 	// SnapshotNodeItem (i.e. ExportNode) until we reach the next SnapshotStoreItem or EOF.
@@ -952,25 +1089,11 @@ loop:
 				rs.logger.Error("failed to restore; received IAVL node item before store item")
 				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(types.ErrLogic, "received IAVL node item before store item")
 			}
-			if item.IAVL.Height > math.MaxInt8 {
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "node height %v cannot exceed %v",
-					item.IAVL.Height, math.MaxInt8)
-			}
-			node := &iavltree.ExportNode{
-				Key:     item.IAVL.Key,
-				Value:   item.IAVL.Value,
-				Height:  int8(item.IAVL.Height),
-				Version: item.IAVL.Version,
-			}
-			// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
-			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
-			if node.Key == nil {
-				node.Key = []byte{}
-			}
-			if node.Height == 0 && node.Value == nil {
-				node.Value = []byte{}
+			node, err := newExportNode(item.IAVL)
+			if err != nil {
+				return snapshottypes.SnapshotItem{}, err
 			}
-			err := importer.Add(node)
+			err = importer.Add(node)
 			if err != nil {
 				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "IAVL node import failed")
 			}
@@ -992,6 +1115,172 @@ loop:
 	return snapshotItem, rs.LoadLatestVersion()
 }
 
+// restoreConcurrently restores the stores of a snapshot concurrently: each store is imported by
+// its own goroutine while the nodes of the next stores are read, up to GOMAXPROCS stores at once.
+func (rs *Store) restoreConcurrently(height uint64, protoReader protoio.Reader) (snapshottypes.SnapshotItem, error) {
+	var (
+		wg       sync.WaitGroup
+		failOnce sync.Once
+		failErr  error
+		failed   = make(chan struct{})
+		slots    = make(chan struct{}, runtime.GOMAXPROCS(0))
+	)
+	fail := func(err error) {
+		failOnce.Do(func() {
+			failErr = err
+			close(failed)
+		})
+	}
+
+	// nodes passes the batches of nodes read to the importer of the current store
+	var nodes chan []*iavltree.ExportNode
+	var batch []*iavltree.ExportNode
+	send := func() bool {
+		if len(batch) == 0 {
+			return true
+		}
+		select {
+		case nodes <- batch:
+			batch = make([]*iavltree.ExportNode, 0, restoreBatchSize)
+			return true
+		case <-failed:
+			return false
+		}
+	}
+	endStore := func() bool {
+		if nodes == nil {
+			return true
+		}
+		ok := send()
+		close(nodes)
+		nodes = nil
+		return ok
+	}
+
+	var snapshotItem snapshottypes.SnapshotItem
+loop:
+	for {
+		snapshotItem = snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&snapshotItem)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			fail(errorsmod.Wrap(err, "invalid protobuf message"))
+			break
+		}
+
+		switch item := snapshotItem.Item.(type) {
+		case *snapshottypes.SnapshotItem_Store:
+			if !endStore() {
+				break loop
+			}
+			store, ok := rs.GetStoreByName(item.Store.Name).(*iavl.Store)
+			if !ok || store == nil {
+				fail(errorsmod.Wrapf(types.ErrLogic, "cannot import into non-IAVL store %q", item.Store.Name))
+				break loop
+			}
+
+			select {
+			case slots <- struct{}{}:
+			case <-failed:
+				break loop
+			}
+			importer, err := store.Import(int64(height))
+			if err != nil {
+				<-slots
+				fail(errorsmod.Wrap(err, "import failed"))
+				break loop
+			}
+			rs.logger.Debug("restoring snapshot", "store", item.Store.Name)
+
+			nodes = make(chan []*iavltree.ExportNode, restoreBatchBuffer)
+			batch = make([]*iavltree.ExportNode, 0, restoreBatchSize)
+			wg.Add(1)
+			go func(name string, importer *iavltree.Importer, nodes <-chan []*iavltree.ExportNode) {
+				defer wg.Done()
+				defer func() { <-slots }()
+				defer importer.Close()
+
+				if err := importNodes(importer, nodes, failed); err != nil {
+					fail(errorsmod.Wrapf(err, "store %q", name))
+				}
+			}(item.Store.Name, importer, nodes)
+
+		case *snapshottypes.SnapshotItem_IAVL:
+			if nodes == nil {
+				rs.logger.Error("failed to restore; received IAVL node item before store item")
+				fail(errorsmod.Wrap(types.ErrLogic, "received IAVL node item before store item"))
+				break loop
+			}
+			node, err := newExportNode(item.IAVL)
+			if err != nil {
+				fail(err)
+				break loop
+			}
+			batch = append(batch, node)
+			if len(batch) == restoreBatchSize && !send() {
+				break loop
+			}
+
+		default:
+			break loop
+		}
+	}
+
+	endStore()
+	wg.Wait()
+	if failErr != nil {
+		return snapshottypes.SnapshotItem{}, failErr
+	}
+
+	rs.flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)))
+	return snapshotItem, rs.LoadLatestVersion()
+}
+
+// importNodes imports the batches of nodes of a store, then commits it unless the restore failed.
+func importNodes(importer *iavltree.Importer, nodes <-chan []*iavltree.ExportNode, failed <-chan struct{}) error {
+	for batch := range nodes {
+		for _, node := range batch {
+			if err := importer.Add(node); err != nil {
+				return errorsmod.Wrap(err, "IAVL node import failed")
+			}
+		}
+	}
+
+	select {
+	case <-failed:
+		return nil
+	default:
+	}
+	if err := importer.Commit(); err != nil {
+		return errorsmod.Wrap(err, "IAVL commit failed")
+	}
+	return nil
+}
+
+// newExportNode returns the IAVL node of a snapshot item.
+func newExportNode(item *snapshottypes.SnapshotIAVLItem) (*iavltree.ExportNode, error) {
+	if item.Height > math.MaxInt8 {
+		return nil, errorsmod.Wrapf(types.ErrLogic, "node height %v cannot exceed %v",
+			item.Height, math.MaxInt8)
+	}
+	node := &iavltree.ExportNode{
+		Key:     item.Key,
+		Value:   item.Value,
+		Height:  int8(item.Height),
+		Version: item.Version,
+	}
+	// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
+	// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+	if node.Key == nil {
+		node.Key = []byte{}
+	}
+	if node.Height == 0 && node.Value == nil {
+		node.Value = []byte{}
+	}
+	return node, nil
+}
+
 func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
 	var db dbm.DB
 
@@ -544,6 +544,51 @@ func TestMultiStore_Pruning(t *testing.T) {
 	}
 }
 
+func TestMultiStore_StorePruning(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 10))
+	ms.SetStorePruning(testStoreKey1.Name(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetStorePruning(testStoreKey3.Name(), pruningtypes.NewPruningOptions(pruningtypes.PruningEverything))
+	require.Equal(t, pruningtypes.NewCustomPruningOptions(2, 10), ms.GetStorePruning(testStoreKey2.Name()))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := int64(0); i < 20; i++ {
+		ms.GetKVStore(testStoreKey1).Set([]byte("key"), []byte(fmt.Sprint(i)))
+		ms.Commit()
+	}
+
+	store1 := ms.GetStoreByName(testStoreKey1.Name()).(*iavl.Store)
+	store2 := ms.GetStoreByName(testStoreKey2.Name()).(*iavl.Store)
+	store3 := ms.GetStoreByName(testStoreKey3.Name()).(*iavl.Store)
+	for v := int64(1); v <= 20; v++ {
+		require.True(t, store1.VersionExists(v), "store1 height %d", v)
+		require.Equal(t, v > 17, store2.VersionExists(v), "store2 height %d", v)
+		require.Equal(t, v > 17, store3.VersionExists(v), "store3 height %d", v)
+	}
+
+	// the stores holding a pruned height can still be queried
+	cms, err := ms.CacheMultiStoreWithVersion(5)
+	require.NoError(t, err)
+	require.Equal(t, []byte("4"), cms.GetKVStore(testStoreKey1).Get([]byte("key")))
+	require.PanicsWithError(t, "store store2 pruned at height 5", func() {
+		cms.GetKVStore(testStoreKey2).Get([]byte("key"))
+	})
+	stores, err := ms.LoadImmutableStores(5)
+	require.NoError(t, err)
+	require.NoError(t, PrunedStoreError(stores[testStoreKey1]))
+	require.EqualError(t, PrunedStoreError(stores[testStoreKey2]), "store store2 pruned at height 5")
+
+	// prune the stores offline, with their own heights
+	require.NoError(t, ms.PruneStoresTo(map[string]int64{testStoreKey1.Name(): 10, testStoreKey3.Name(): 19}))
+	require.False(t, store1.VersionExists(10))
+	require.True(t, store1.VersionExists(11))
+	require.True(t, store2.VersionExists(19))
+	require.False(t, store3.VersionExists(19))
+
+	_, err = ms.CacheMultiStoreWithVersion(10)
+	require.Error(t, err)
+}
+
 func TestMultiStore_Pruning_SameHeightsTwice(t *testing.T) {
 	const (
 		numVersions int64  = 10
@@ -0,0 +1,349 @@
+package snapshots
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// An incremental snapshot has the layout of a full snapshot, except that:
+//
+//   - it starts with a header item giving the height of its base snapshot;
+//   - the IAVL nodes not created since the base height are replaced by instructions to copy the
+//     next nodes of the same store in the base snapshot, or to skip them.
+//
+// As IAVL nodes are immutable, the nodes of a tree which were not created since the base height
+// are shared with the tree at the base height, and appear in the same order in both exports, so
+// these instructions are enough to rebuild the full snapshot from the base one.
+//
+// The header and the instructions are encoded as SnapshotIAVLItem with a negative height, never
+// found in a full snapshot, and the number of nodes, or the base height, as version.
+const (
+	incrementalHeaderHeight int32 = -1
+	incrementalCopyHeight   int32 = -2
+	incrementalSkipHeight   int32 = -3
+)
+
+func incrementalItem(height int32, version uint64) *types.SnapshotItem {
+	return &types.SnapshotItem{
+		Item: &types.SnapshotItem_IAVL{
+			IAVL: &types.SnapshotIAVLItem{
+				Height:  height,
+				Version: int64(version),
+			},
+		},
+	}
+}
+
+// readIncrementalHeader reads the header of an incremental snapshot, returning its base height.
+func readIncrementalHeader(protoReader protoio.Reader) (uint64, error) {
+	var item types.SnapshotItem
+	if err := protoReader.ReadMsg(&item); err != nil {
+		return 0, errors.Wrap(err, "failed to read incremental snapshot header")
+	}
+
+	header := item.GetIAVL()
+	if header == nil || header.Height != incrementalHeaderHeight || header.Version <= 0 {
+		return 0, errors.Wrap(types.ErrInvalidMetadata, "invalid incremental snapshot header")
+	}
+
+	return uint64(header.Version), nil
+}
+
+// itemCursor reads the IAVL nodes of a full snapshot store by store.
+type itemCursor struct {
+	protoReader protoio.Reader
+	next        *types.SnapshotItem
+	inStore     bool
+}
+
+func newItemCursor(protoReader protoio.Reader) *itemCursor {
+	return &itemCursor{protoReader: protoReader}
+}
+
+// peek returns the next item without consuming it, or nil at the end of the stream.
+func (c *itemCursor) peek() (*types.SnapshotItem, error) {
+	if c.next == nil {
+		item := &types.SnapshotItem{}
+		err := c.protoReader.ReadMsg(item)
+		if err == io.EOF {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+		c.next = item
+	}
+
+	return c.next, nil
+}
+
+// seekStore moves the cursor to the nodes of the named store, returning false if the stream
+// does not contain it. Stores are sorted by name.
+func (c *itemCursor) seekStore(name string) (bool, error) {
+	c.inStore = false
+	for {
+		item, err := c.peek()
+		if err != nil || item == nil {
+			return false, err
+		}
+
+		switch item := item.Item.(type) {
+		case *types.SnapshotItem_Store:
+			if cmp := strings.Compare(item.Store.Name, name); cmp > 0 {
+				return false, nil
+			} else if cmp == 0 {
+				c.next = nil
+				c.inStore = true
+				return true, nil
+			}
+
+		case *types.SnapshotItem_IAVL:
+
+		default:
+			// end of the stores
+			return false, nil
+		}
+
+		c.next = nil
+	}
+}
+
+// nextNode returns the next node of the current store, or nil at the end of the store.
+func (c *itemCursor) nextNode() (*types.SnapshotIAVLItem, error) {
+	if !c.inStore {
+		return nil, nil
+	}
+
+	item, err := c.peek()
+	if err != nil || item == nil {
+		return nil, err
+	}
+	node := item.GetIAVL()
+	if node == nil {
+		c.inStore = false
+		return nil, nil
+	}
+
+	c.next = nil
+	return node, nil
+}
+
+var _ protoio.Writer = (*incrementalWriter)(nil)
+
+// incrementalWriter writes the items of a full snapshot as an incremental snapshot, given the
+// items of the full snapshot at the base height.
+type incrementalWriter struct {
+	protoWriter protoio.Writer
+	base        *itemCursor
+	baseHeight  uint64
+
+	// skips and copies are the pending numbers of base nodes to skip, then copy.
+	skips, copies uint64
+}
+
+func newIncrementalWriter(protoWriter protoio.Writer, base protoio.Reader, baseHeight uint64) (*incrementalWriter, error) {
+	if err := protoWriter.WriteMsg(incrementalItem(incrementalHeaderHeight, baseHeight)); err != nil {
+		return nil, err
+	}
+
+	return &incrementalWriter{
+		protoWriter: protoWriter,
+		base:        newItemCursor(base),
+		baseHeight:  baseHeight,
+	}, nil
+}
+
+// WriteMsg implements protoio.Writer.
+func (w *incrementalWriter) WriteMsg(msg proto.Message) error {
+	item, ok := msg.(*types.SnapshotItem)
+	if !ok {
+		return errors.Wrapf(storetypes.ErrLogic, "unexpected snapshot message %T", msg)
+	}
+
+	switch it := item.Item.(type) {
+	case *types.SnapshotItem_Store:
+		if _, err := w.base.seekStore(it.Store.Name); err != nil {
+			return err
+		}
+
+	case *types.SnapshotItem_IAVL:
+		if it.IAVL.Version > int64(w.baseHeight) {
+			break
+		}
+
+		// the node is shared with the base tree, find it in the base snapshot
+		skips, err := w.skipTo(it.IAVL)
+		if err != nil {
+			return err
+		}
+		if skips > 0 {
+			if err := w.flushCopies(); err != nil {
+				return err
+			}
+			w.skips += skips
+		}
+		w.copies++
+		return nil
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return w.protoWriter.WriteMsg(item)
+}
+
+// skipTo consumes the base nodes up to the given node, returning the number of nodes skipped.
+func (w *incrementalWriter) skipTo(node *types.SnapshotIAVLItem) (uint64, error) {
+	skips := uint64(0)
+	for {
+		baseNode, err := w.base.nextNode()
+		if err != nil {
+			return 0, err
+		}
+		if baseNode == nil {
+			return 0, errors.Wrapf(types.ErrInvalidMetadata,
+				"node %x of version %d not found in base snapshot at height %d", node.Key, node.Version, w.baseHeight)
+		}
+		if baseNode.Version == node.Version && baseNode.Height == node.Height &&
+			bytes.Equal(baseNode.Key, node.Key) && bytes.Equal(baseNode.Value, node.Value) {
+			return skips, nil
+		}
+		skips++
+	}
+}
+
+// flushCopies writes the pending skip and copy instructions.
+func (w *incrementalWriter) flushCopies() error {
+	if w.copies == 0 {
+		return nil
+	}
+	if w.skips > 0 {
+		if err := w.protoWriter.WriteMsg(incrementalItem(incrementalSkipHeight, w.skips)); err != nil {
+			return err
+		}
+	}
+	if err := w.protoWriter.WriteMsg(incrementalItem(incrementalCopyHeight, w.copies)); err != nil {
+		return err
+	}
+
+	w.skips, w.copies = 0, 0
+	return nil
+}
+
+// Flush writes the pending instructions. The skips not followed by copies are dropped.
+func (w *incrementalWriter) Flush() error {
+	if err := w.flushCopies(); err != nil {
+		return err
+	}
+
+	w.skips = 0
+	return nil
+}
+
+var _ protoio.ReadCloser = (*incrementalReader)(nil)
+
+// incrementalReader reads an incremental snapshot as the full snapshot it was taken from, given
+// the items of the full snapshot at its base height.
+type incrementalReader struct {
+	delta      protoio.ReadCloser
+	base       *itemCursor
+	baseCloser protoio.ReadCloser
+	copies     uint64
+}
+
+// newIncrementalReader returns the reader of an incremental snapshot whose header was read. It
+// closes both readers when closed.
+func newIncrementalReader(delta, base protoio.ReadCloser) *incrementalReader {
+	return &incrementalReader{
+		delta:      delta,
+		base:       newItemCursor(base),
+		baseCloser: base,
+	}
+}
+
+// ReadMsg implements protoio.Reader.
+func (r *incrementalReader) ReadMsg(msg proto.Message) error {
+	item, ok := msg.(*types.SnapshotItem)
+	if !ok {
+		return errors.Wrapf(storetypes.ErrLogic, "unexpected snapshot message %T", msg)
+	}
+
+	for {
+		if r.copies > 0 {
+			node, err := r.baseNode()
+			if err != nil {
+				return err
+			}
+			r.copies--
+			*item = types.SnapshotItem{Item: &types.SnapshotItem_IAVL{IAVL: node}}
+			return nil
+		}
+
+		if err := r.delta.ReadMsg(item); err != nil {
+			return err
+		}
+
+		switch it := item.Item.(type) {
+		case *types.SnapshotItem_Store:
+			if _, err := r.base.seekStore(it.Store.Name); err != nil {
+				return err
+			}
+			return nil
+
+		case *types.SnapshotItem_IAVL:
+			switch it.IAVL.Height {
+			case incrementalCopyHeight:
+				if it.IAVL.Version <= 0 {
+					return errors.Wrapf(types.ErrInvalidMetadata, "invalid incremental snapshot copy of %d nodes", it.IAVL.Version)
+				}
+				r.copies = uint64(it.IAVL.Version)
+
+			case incrementalSkipHeight:
+				for n := it.IAVL.Version; n > 0; n-- {
+					if _, err := r.baseNode(); err != nil {
+						return err
+					}
+				}
+
+			default:
+				if it.IAVL.Height < 0 {
+					return errors.Wrapf(types.ErrInvalidMetadata, "invalid incremental snapshot node height %d", it.IAVL.Height)
+				}
+				return nil
+			}
+
+		default:
+			return nil
+		}
+	}
+}
+
+// baseNode returns the next node of the current store in the base snapshot.
+func (r *incrementalReader) baseNode() (*types.SnapshotIAVLItem, error) {
+	node, err := r.base.nextNode()
+	if err != nil {
+		return nil, err
+	}
+	if node == nil {
+		return nil, errors.Wrap(types.ErrInvalidMetadata, "incremental snapshot refers to missing base snapshot nodes")
+	}
+
+	return node, nil
+}
+
+// Close implements io.Closer.
+func (r *incrementalReader) Close() error {
+	err := r.delta.Close()
+	if err2 := r.baseCloser.Close(); err == nil {
+		err = err2
+	}
+
+	return err
+}
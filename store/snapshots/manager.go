@@ -11,6 +11,8 @@ import (
 	"sort"
 	"sync"
 
+	protoio "github.com/cosmos/gogoproto/io"
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store/snapshots/types"
@@ -172,6 +174,11 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 	}
 	defer m.end()
 
+	format := m.snapshotFormat()
+	if format != types.CurrentFormat && format != types.ParallelFormat {
+		return nil, errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", format)
+	}
+
 	latest, err := m.store.GetLatest()
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to examine latest snapshot")
@@ -183,15 +190,55 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
-	go m.createSnapshot(height, ch)
+	go m.createSnapshot(height, format, ch)
 
-	return m.store.Save(height, types.CurrentFormat, ch)
+	return m.store.Save(height, format, ch)
+}
+
+// snapshotFormat returns the format of the snapshots to take.
+func (m *Manager) snapshotFormat() uint32 {
+	if m.opts.Format == 0 {
+		return types.CurrentFormat
+	}
+	return m.opts.Format
+}
+
+// streamWriter is the stream pipeline serializing the items of a snapshot.
+type streamWriter interface {
+	protoio.WriteCloser
+	CloseWithError(err error)
+}
+
+// newStreamWriter returns the stream pipeline serializing the items of a snapshot of the given
+// format, or nil if it failed, in which case the error is passed to the channel.
+func newStreamWriter(format uint32, ch chan<- io.ReadCloser) streamWriter {
+	if format == types.ParallelFormat {
+		if sw := NewParallelStreamWriter(ch); sw != nil {
+			return sw
+		}
+		return nil
+	}
+
+	if sw := NewStreamWriter(ch); sw != nil {
+		return sw
+	}
+	return nil
+}
+
+// newStreamReader returns the stream pipeline deserializing the items of a snapshot of the given
+// format.
+func newStreamReader(format uint32, chunks <-chan io.ReadCloser) (protoio.ReadCloser, error) {
+	if format == types.ParallelFormat {
+		return NewParallelStreamReader(chunks)
+	}
+
+	return NewStreamReader(chunks)
 }
 
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
 // the produced chunks are written to the channel.
-func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
-	streamWriter := NewStreamWriter(ch)
+func (m *Manager) createSnapshot(height uint64, format uint32, ch chan<- io.ReadCloser) {
+	streamWriter := newStreamWriter(format, ch)
 	if streamWriter == nil {
 		return
 	}
@@ -205,6 +252,14 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 		streamWriter.CloseWithError(err)
 		return
 	}
+	if err := m.snapshotExtensions(height, streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+}
+
+// snapshotExtensions writes the extension snapshots into the stream.
+func (m *Manager) snapshotExtensions(height uint64, streamWriter protoio.Writer) error {
 	for _, name := range m.sortedExtensionNames() {
 		extension := m.extensions[name]
 		// write extension metadata
@@ -217,17 +272,147 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 			},
 		})
 		if err != nil {
-			streamWriter.CloseWithError(err)
-			return
+			return err
 		}
 		payloadWriter := func(payload []byte) error {
 			return types.WriteExtensionPayload(streamWriter, payload)
 		}
 		if err := extension.SnapshotExtension(height, payloadWriter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateIncremental creates an incremental snapshot on top of the snapshot at baseHeight and
+// returns its metadata. The snapshot only holds the IAVL nodes created since baseHeight, but
+// taking it still reads the whole state at both heights.
+func (m *Manager) CreateIncremental(height, baseHeight uint64) (*types.Snapshot, error) {
+	if m == nil {
+		return nil, errorsmod.Wrap(storetypes.ErrLogic, "no snapshot store configured")
+	}
+
+	defer m.multistore.PruneSnapshotHeight(int64(height))
+
+	err := m.begin(opSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	defer m.end()
+
+	if baseHeight >= height {
+		return nil, errorsmod.Wrapf(storetypes.ErrLogic,
+			"base height %v must be lower than the snapshot height %v", baseHeight, height)
+	}
+	latest, err := m.store.GetLatest()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to examine latest snapshot")
+	}
+	if latest != nil && latest.Height >= height {
+		return nil, errorsmod.Wrapf(storetypes.ErrConflict,
+			"a more recent snapshot already exists at height %v", latest.Height)
+	}
+
+	base, err := m.openSnapshot(baseHeight)
+	if err != nil {
+		return nil, err
+	}
+	defer base.Close()
+
+	ch := make(chan io.ReadCloser)
+	go m.createIncrementalSnapshot(height, baseHeight, base, ch)
+
+	return m.store.Save(height, types.IncrementalFormat, ch)
+}
+
+// createIncrementalSnapshot writes the chunks of an incremental snapshot to the channel, given the
+// items of the full snapshot at the base height.
+func (m *Manager) createIncrementalSnapshot(height, baseHeight uint64, base protoio.Reader, ch chan<- io.ReadCloser) {
+	streamWriter := NewStreamWriter(ch)
+	if streamWriter == nil {
+		return
+	}
+	defer func() {
+		if err := streamWriter.Close(); err != nil {
 			streamWriter.CloseWithError(err)
-			return
 		}
+	}()
+
+	incrementalWriter, err := newIncrementalWriter(streamWriter, base, baseHeight)
+	if err != nil {
+		streamWriter.CloseWithError(err)
+		return
 	}
+	if err := m.multistore.Snapshot(height, incrementalWriter); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+	if err := incrementalWriter.Flush(); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+	if err := m.snapshotExtensions(height, streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+}
+
+// openSnapshot returns the items of the full snapshot at height from the local snapshots,
+// rebuilding it from its base if it is incremental. The reader must be closed.
+func (m *Manager) openSnapshot(height uint64) (protoio.ReadCloser, error) {
+	for _, format := range []uint32{types.CurrentFormat, types.ParallelFormat, types.IncrementalFormat} {
+		snapshot, chunks, err := m.store.Load(height, format)
+		if err != nil {
+			return nil, err
+		}
+		if snapshot == nil {
+			continue
+		}
+
+		protoReader, _, err := m.newItemReader(format, chunks)
+		if err != nil {
+			DrainChunks(chunks)
+			return nil, err
+		}
+		return protoReader, nil
+	}
+
+	return nil, errorsmod.Wrapf(types.ErrInvalidMetadata, "no local snapshot at height %v", height)
+}
+
+// newItemReader returns the reader of the items of a snapshot of the given format, along with the
+// format the multistore restores them from: the items of an incremental snapshot are those of the
+// full snapshot rebuilt on top of its base.
+func (m *Manager) newItemReader(format uint32, chunks <-chan io.ReadCloser) (protoio.ReadCloser, uint32, error) {
+	streamReader, err := newStreamReader(format, chunks)
+	if err != nil {
+		return nil, 0, err
+	}
+	if format != types.IncrementalFormat {
+		return streamReader, format, nil
+	}
+
+	protoReader, err := m.openIncremental(streamReader)
+	if err != nil {
+		streamReader.Close()
+		return nil, 0, err
+	}
+	return protoReader, types.CurrentFormat, nil
+}
+
+// openIncremental returns the items of the full snapshot an incremental snapshot was taken
+// from, rebuilding it from its base in the local snapshots.
+func (m *Manager) openIncremental(delta protoio.ReadCloser) (protoio.ReadCloser, error) {
+	baseHeight, err := readIncrementalHeader(delta)
+	if err != nil {
+		return nil, err
+	}
+	base, err := m.openSnapshot(baseHeight)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to open base snapshot of incremental snapshot")
+	}
+
+	return newIncrementalReader(delta, base), nil
 }
 
 // List lists snapshots, mirroring ABCI ListSnapshots. It can be concurrent with other operations.
@@ -275,7 +460,7 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	defer m.mtx.Unlock()
 
 	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	if !m.isFormatSupported(snapshot.Format) {
 		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
 	if snapshot.Height == 0 {
@@ -344,7 +529,7 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 	}
 
 	var nextItem types.SnapshotItem
-	streamReader, err := NewStreamReader(chChunks)
+	streamReader, format, err := m.newItemReader(snapshot.Format, chChunks)
 	if err != nil {
 		return err
 	}
@@ -363,7 +548,7 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		return payload.Payload, nil
 	}
 
-	nextItem, err = m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
+	nextItem, err = m.multistore.Restore(snapshot.Height, format, streamReader)
 	if err != nil {
 		return errorsmod.Wrap(err, "multistore restore")
 	}
@@ -483,6 +668,34 @@ func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 	return m.doRestoreSnapshot(*snapshot, ch)
 }
 
+// VerifySnapshot checks the chunks of the local snapshot at height against its metadata, then
+// restores its stores into target, e.g. a multistore over a temporary database, so that the
+// resulting store hashes can be compared with the commit info of the height. Neither the chunks
+// of the bases of incremental snapshots nor the extension payloads are checked.
+func (m *Manager) VerifySnapshot(height uint64, format uint32, target types.Snapshotter) (*types.Snapshot, error) {
+	snapshot, err := m.store.verifyChunks(height, format)
+	if err != nil {
+		return nil, err
+	}
+
+	_, chunks, err := m.store.Load(height, format)
+	if err != nil {
+		return nil, err
+	}
+	protoReader, itemFormat, err := m.newItemReader(format, chunks)
+	if err != nil {
+		DrainChunks(chunks)
+		return nil, err
+	}
+	defer protoReader.Close()
+
+	if _, err := target.Restore(height, itemFormat, protoReader); err != nil {
+		return nil, errorsmod.Wrap(err, "multistore restore")
+	}
+
+	return snapshot, nil
+}
+
 // sortedExtensionNames sort extension names for deterministic iteration.
 func (m *Manager) sortedExtensionNames() []string {
 	names := make([]string, 0, len(m.extensions))
@@ -494,8 +707,20 @@ func (m *Manager) sortedExtensionNames() []string {
 	return names
 }
 
+// isFormatSupported returns if the multistore supports restoration from given format. Incremental
+// snapshots are restored on top of their base in the local snapshots.
+func (m *Manager) isFormatSupported(format uint32) bool {
+	if format == types.IncrementalFormat {
+		return true
+	}
+	if snapshotter, ok := m.multistore.(types.FormatSupporter); ok {
+		return IsFormatSupported(snapshotter, format)
+	}
+	return format == types.CurrentFormat
+}
+
 // IsFormatSupported returns if the snapshotter supports restoration from given format.
-func IsFormatSupported(snapshotter types.ExtensionSnapshotter, format uint32) bool {
+func IsFormatSupported(snapshotter types.FormatSupporter, format uint32) bool {
 	for _, i := range snapshotter.SupportedFormats() {
 		if i == format {
 			return true
@@ -164,6 +164,16 @@ func TestManager_Restore(t *testing.T) {
 	require.Error(t, err)
 	require.ErrorIs(t, err, types.ErrUnknownFormat)
 
+	// Restore errors on formats not supported by the multistore
+	err = manager.Restore(types.Snapshot{
+		Height:   3,
+		Format:   types.ParallelFormat,
+		Hash:     []byte{1, 2, 3},
+		Chunks:   uint32(len(chunks)),
+		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
+	})
+	require.ErrorIs(t, err, types.ErrUnknownFormat)
+
 	// Restore errors on no chunks
 	err = manager.Restore(types.Snapshot{Height: 3, Format: types.CurrentFormat, Hash: []byte{1, 2, 3}})
 	require.Error(t, err)
@@ -0,0 +1,272 @@
+package snapshots
+
+import (
+	"bytes"
+	"io"
+	"runtime"
+	"sync"
+
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/klauspost/compress/zstd"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// Do not change compression level without new snapshot format (must be uniform across nodes)
+const parallelCompressionLevel = zstd.SpeedDefault
+
+// parallelism is the number of chunks compressed or decompressed at once.
+var parallelism = runtime.GOMAXPROCS(0)
+
+var _ protoio.WriteCloser = (*ParallelStreamWriter)(nil)
+
+// ParallelStreamWriter set up a stream pipeline to serialize snapshot nodes into chunks compressed
+// independently, and thus in parallel:
+// Exported Items -> delimited Protobuf -> fixed-size segments -> zstd workers -> chan io.ReadCloser
+//
+// The segments are cut every snapshotChunkSize bytes of the protobuf stream, whatever the items, so
+// that all nodes produce identical chunks.
+type ParallelStreamWriter struct {
+	ch          chan<- io.ReadCloser
+	encoder     *zstd.Encoder
+	protoWriter protoio.WriteCloser
+	segment     []byte
+	// chunks holds the chunks being compressed, in order.
+	chunks chan chan []byte
+	done   chan struct{}
+	closed bool
+}
+
+// NewParallelStreamWriter set up a stream pipeline to serialize snapshot DB records.
+func NewParallelStreamWriter(ch chan<- io.ReadCloser) *ParallelStreamWriter {
+	encoder, err := zstd.NewWriter(nil,
+		zstd.WithEncoderLevel(parallelCompressionLevel),
+		zstd.WithEncoderConcurrency(parallelism),
+	)
+	if err != nil {
+		pr, pw := io.Pipe()
+		_ = pw.CloseWithError(errors.Wrap(err, "zstd failure"))
+		ch <- pr
+		close(ch)
+		return nil
+	}
+
+	sw := &ParallelStreamWriter{
+		ch:      ch,
+		encoder: encoder,
+		chunks:  make(chan chan []byte, parallelism),
+		done:    make(chan struct{}),
+	}
+	sw.protoWriter = protoio.NewDelimitedWriter(segmentWriter{sw})
+	go sw.emit()
+	return sw
+}
+
+// segmentWriter cuts the protobuf stream into segments, it must not be an io.Closer so that
+// closing the protobuf writer does not close the stream.
+type segmentWriter struct {
+	sw *ParallelStreamWriter
+}
+
+// Write implements io.Writer.
+func (w segmentWriter) Write(data []byte) (int, error) {
+	sw := w.sw
+	if sw.closed {
+		return 0, errors.Wrap(storetypes.ErrLogic, "cannot write to closed ParallelStreamWriter")
+	}
+
+	n := len(data)
+	for len(data) > 0 {
+		if sw.segment == nil {
+			sw.segment = make([]byte, 0, snapshotChunkSize)
+		}
+		size := int(snapshotChunkSize) - len(sw.segment)
+		if size > len(data) {
+			size = len(data)
+		}
+		sw.segment = append(sw.segment, data[:size]...)
+		data = data[size:]
+
+		if len(sw.segment) == int(snapshotChunkSize) {
+			sw.compress()
+		}
+	}
+
+	return n, nil
+}
+
+// compress compresses the current segment in the background, blocking if too many chunks are
+// being compressed already.
+func (sw *ParallelStreamWriter) compress() {
+	segment := sw.segment
+	sw.segment = nil
+
+	chunk := make(chan []byte, 1)
+	sw.chunks <- chunk
+	go func() {
+		chunk <- sw.encoder.EncodeAll(segment, make([]byte, 0, len(segment)/2))
+	}()
+}
+
+// emit passes the compressed chunks to the channel, in order.
+func (sw *ParallelStreamWriter) emit() {
+	defer close(sw.done)
+	for chunk := range sw.chunks {
+		sw.ch <- io.NopCloser(bytes.NewReader(<-chunk))
+	}
+}
+
+// WriteMsg implements protoio.Write interface
+func (sw *ParallelStreamWriter) WriteMsg(msg proto.Message) error {
+	return sw.protoWriter.WriteMsg(msg)
+}
+
+// finish waits for the pending chunks to be passed to the channel.
+func (sw *ParallelStreamWriter) finish() {
+	sw.closed = true
+	close(sw.chunks)
+	<-sw.done
+	_ = sw.encoder.Close()
+}
+
+// Close implements io.Closer interface
+func (sw *ParallelStreamWriter) Close() error {
+	if sw.closed {
+		return nil
+	}
+	if len(sw.segment) > 0 {
+		sw.compress()
+	}
+	sw.finish()
+	close(sw.ch)
+	return nil
+}
+
+// CloseWithError pass error to the reader of the chunks
+func (sw *ParallelStreamWriter) CloseWithError(err error) {
+	if sw.closed {
+		return
+	}
+	sw.finish()
+	pr, pw := io.Pipe()
+	_ = pw.CloseWithError(err) // CloseWithError always returns nil
+	sw.ch <- pr
+	close(sw.ch)
+}
+
+var _ protoio.ReadCloser = (*ParallelStreamReader)(nil)
+
+// ParallelStreamReader set up a restore stream pipeline, decompressing the chunks in parallel
+// chan io.ReadCloser -> zstd workers -> delimited Protobuf -> ExportNode
+type ParallelStreamReader struct {
+	decoder     *zstd.Decoder
+	protoReader protoio.ReadCloser
+	// segments holds the chunks being decompressed, in order.
+	segments chan chan decodedSegment
+	segment  *bytes.Reader
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+type decodedSegment struct {
+	data []byte
+	err  error
+}
+
+// NewParallelStreamReader set up a restore stream pipeline.
+func NewParallelStreamReader(chunks <-chan io.ReadCloser) (*ParallelStreamReader, error) {
+	decoder, err := zstd.NewReader(nil,
+		zstd.WithDecoderConcurrency(parallelism),
+		zstd.WithDecoderMaxMemory(snapshotChunkSize),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "zstd failure")
+	}
+
+	sr := &ParallelStreamReader{
+		decoder:  decoder,
+		segments: make(chan chan decodedSegment, parallelism),
+		stop:     make(chan struct{}),
+	}
+	sr.protoReader = protoio.NewDelimitedReader(segmentReader{sr}, snapshotMaxItemSize)
+	go sr.decompress(chunks)
+	return sr, nil
+}
+
+// decompress decompresses the chunks in the background, until they are all read or the reader
+// is closed.
+func (sr *ParallelStreamReader) decompress(chunks <-chan io.ReadCloser) {
+	defer close(sr.segments)
+	defer DrainChunks(chunks)
+
+	for chunk := range chunks {
+		segment := make(chan decodedSegment, 1)
+		select {
+		case sr.segments <- segment:
+		case <-sr.stop:
+			_ = chunk.Close()
+			return
+		}
+
+		data, err := io.ReadAll(chunk)
+		if err2 := chunk.Close(); err == nil {
+			err = err2
+		}
+		if err != nil {
+			segment <- decodedSegment{err: err}
+			return
+		}
+		go func() {
+			data, err := sr.decoder.DecodeAll(data, nil)
+			if err != nil {
+				err = errors.Wrap(err, "zstd failure")
+			}
+			segment <- decodedSegment{data: data, err: err}
+		}()
+	}
+}
+
+// segmentReader reads the decompressed segments as a single stream, it must not be an io.Closer
+// so that closing the protobuf reader does not close the stream.
+type segmentReader struct {
+	sr *ParallelStreamReader
+}
+
+// Read implements io.Reader.
+func (r segmentReader) Read(p []byte) (int, error) {
+	sr := r.sr
+	for sr.segment == nil || sr.segment.Len() == 0 {
+		segment, ok := <-sr.segments
+		if !ok {
+			return 0, io.EOF
+		}
+		decoded := <-segment
+		if decoded.err != nil {
+			return 0, decoded.err
+		}
+		sr.segment = bytes.NewReader(decoded.data)
+	}
+
+	return sr.segment.Read(p)
+}
+
+// ReadMsg implements protoio.Reader interface
+func (sr *ParallelStreamReader) ReadMsg(msg proto.Message) error {
+	return sr.protoReader.ReadMsg(msg)
+}
+
+// Close implements io.Closer interface
+func (sr *ParallelStreamReader) Close() error {
+	sr.stopOnce.Do(func() {
+		close(sr.stop)
+		// wait for the pending decompressions before closing the decoder
+		for segment := range sr.segments {
+			<-segment
+		}
+		sr.decoder.Close()
+	})
+
+	return sr.protoReader.Close()
+}
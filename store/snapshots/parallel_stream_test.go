@@ -0,0 +1,115 @@
+package snapshots_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+)
+
+// parallelSnapshotItems returns items spanning several chunks of the parallel format.
+func parallelSnapshotItems() []*snapshottypes.SnapshotItem {
+	r := rand.New(rand.NewSource(1))
+	items := []*snapshottypes.SnapshotItem{}
+	for i := 0; i < 250; i++ {
+		value := make([]byte, 100_000)
+		// half random bytes, so that the chunks are partly compressible
+		r.Read(value[:len(value)/2])
+		items = append(items, &snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_IAVL{
+				IAVL: &snapshottypes.SnapshotIAVLItem{
+					Key:     []byte(fmt.Sprintf("key%03d", i)),
+					Value:   value,
+					Version: int64(i),
+				},
+			},
+		})
+	}
+	return items
+}
+
+func writeParallelStream(t *testing.T, items []*snapshottypes.SnapshotItem) [][]byte {
+	t.Helper()
+
+	ch := make(chan io.ReadCloser)
+	go func() {
+		streamWriter := snapshots.NewParallelStreamWriter(ch)
+		for _, item := range items {
+			if err := streamWriter.WriteMsg(item); err != nil {
+				streamWriter.CloseWithError(err)
+				return
+			}
+		}
+		_ = streamWriter.Close()
+	}()
+
+	return readChunks(ch)
+}
+
+func TestParallelStream(t *testing.T) {
+	items := parallelSnapshotItems()
+	chunks := writeParallelStream(t, items)
+	require.Len(t, chunks, 3)
+
+	// all nodes must produce identical chunks
+	require.Equal(t, chunks, writeParallelStream(t, items))
+
+	streamReader, err := snapshots.NewParallelStreamReader(makeChunks(chunks))
+	require.NoError(t, err)
+	for _, expected := range items {
+		var item snapshottypes.SnapshotItem
+		require.NoError(t, streamReader.ReadMsg(&item))
+		require.Equal(t, expected.GetIAVL(), item.GetIAVL())
+	}
+	var item snapshottypes.SnapshotItem
+	require.Equal(t, io.EOF, streamReader.ReadMsg(&item))
+	require.NoError(t, streamReader.Close())
+
+	// closing before the end of the stream drains the chunks
+	streamReader, err = snapshots.NewParallelStreamReader(makeChunks(chunks))
+	require.NoError(t, err)
+	require.NoError(t, streamReader.ReadMsg(&item))
+	require.NoError(t, streamReader.Close())
+
+	// corrupted chunks fail to decompress
+	corrupted := append([][]byte{}, chunks...)
+	corrupted[1] = append([]byte{}, chunks[1]...)
+	corrupted[1][len(corrupted[1])/2] ^= 0xff
+	streamReader, err = snapshots.NewParallelStreamReader(makeChunks(corrupted))
+	require.NoError(t, err)
+	for err == nil {
+		err = streamReader.ReadMsg(&item)
+	}
+	require.ErrorContains(t, err, "zstd failure")
+	require.NoError(t, streamReader.Close())
+}
+
+func TestParallelStreamWriter_CloseWithError(t *testing.T) {
+	theErr := errors.New("boom")
+	ch := make(chan io.ReadCloser)
+	go func() {
+		streamWriter := snapshots.NewParallelStreamWriter(ch)
+		for _, item := range parallelSnapshotItems()[:150] {
+			require.NoError(t, streamWriter.WriteMsg(item))
+		}
+		streamWriter.CloseWithError(theErr)
+	}()
+
+	var err error
+	chunks := 0
+	for chunk := range ch {
+		if _, err = io.ReadAll(chunk); err != nil {
+			break
+		}
+		chunks++
+	}
+	require.Equal(t, theErr, err)
+	require.Equal(t, 1, chunks)
+	snapshots.DrainChunks(ch)
+}
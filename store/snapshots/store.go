@@ -1,6 +1,7 @@
 package snapshots
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
 	"hash"
@@ -164,6 +165,70 @@ func (s *Store) Load(height uint64, format uint32) (*types.Snapshot, <-chan io.R
 	return snapshot, ch, nil
 }
 
+// IncrementalBase returns the height of the base snapshot of an incremental snapshot.
+func (s *Store) IncrementalBase(height uint64) (uint64, error) {
+	snapshot, chunks, err := s.Load(height, types.IncrementalFormat)
+	if err != nil {
+		return 0, err
+	}
+	if snapshot == nil {
+		return 0, errors.Wrapf(types.ErrInvalidMetadata, "no incremental snapshot at height %v", height)
+	}
+
+	streamReader, err := NewStreamReader(chunks)
+	if err != nil {
+		DrainChunks(chunks)
+		return 0, err
+	}
+	defer streamReader.Close()
+
+	return readIncrementalHeader(streamReader)
+}
+
+// verifyChunks checks the chunks of a snapshot against the hashes of its metadata.
+func (s *Store) verifyChunks(height uint64, format uint32) (*types.Snapshot, error) {
+	snapshot, err := s.Get(height, format)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, errors.Wrapf(types.ErrInvalidMetadata, "no snapshot at height %v format %v", height, format)
+	}
+	if uint32(len(snapshot.Metadata.ChunkHashes)) != snapshot.Chunks {
+		return nil, errors.Wrapf(types.ErrInvalidMetadata, "snapshot has %v chunk hashes, but %v chunks",
+			len(snapshot.Metadata.ChunkHashes), snapshot.Chunks)
+	}
+
+	snapshotHasher := sha256.New()
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		err := func() error {
+			chunk, err := s.loadChunkFile(height, format, i)
+			if err != nil {
+				return err
+			}
+			defer chunk.Close()
+
+			chunkHasher := sha256.New()
+			if _, err := io.Copy(io.MultiWriter(chunkHasher, snapshotHasher), chunk); err != nil {
+				return errors.Wrapf(err, "failed to read chunk %v", i)
+			}
+			if hash := chunkHasher.Sum(nil); !bytes.Equal(hash, snapshot.Metadata.ChunkHashes[i]) {
+				return errors.Wrapf(types.ErrChunkHashMismatch, "chunk %v: expected %x, got %x",
+					i, snapshot.Metadata.ChunkHashes[i], hash)
+			}
+			return nil
+		}()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if hash := snapshotHasher.Sum(nil); !bytes.Equal(hash, snapshot.Hash) {
+		return nil, errors.Wrapf(types.ErrChunkHashMismatch, "snapshot: expected %x, got %x", snapshot.Hash, hash)
+	}
+
+	return snapshot, nil
+}
+
 // LoadChunk loads a chunk from disk, or returns nil if it does not exist. The caller must call
 // Close() on it when done.
 func (s *Store) LoadChunk(height uint64, format, chunk uint32) (io.ReadCloser, error) {
@@ -181,7 +246,8 @@ func (s *Store) loadChunkFile(height uint64, format, chunk uint32) (io.ReadClose
 	return os.Open(path)
 }
 
-// Prune removes old snapshots. The given number of most recent heights (regardless of format) are retained.
+// Prune removes old snapshots. The given number of most recent heights (regardless of format) are retained,
+// along with the bases of the retained incremental snapshots.
 func (s *Store) Prune(retain uint32) (uint64, error) {
 	iter, err := s.db.ReverseIterator(encodeKey(0, 0), encodeKey(uint64(math.MaxUint64), math.MaxUint32))
 	if err != nil {
@@ -192,6 +258,7 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 	pruned := uint64(0)
 	prunedHeights := make(map[uint64]bool)
 	skip := make(map[uint64]bool)
+	bases := make(map[uint64]bool)
 	for ; iter.Valid(); iter.Next() {
 		height, format, err := decodeKey(iter.Key())
 		if err != nil {
@@ -199,6 +266,16 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 		}
 		if skip[height] || uint32(len(skip)) < retain {
 			skip[height] = true
+		}
+		if skip[height] || bases[height] {
+			// the bases of the retained incremental snapshots are older, so they are retained too
+			if format == types.IncrementalFormat {
+				base, err := s.IncrementalBase(height)
+				if err != nil {
+					return 0, errors.Wrap(err, "failed to prune snapshots")
+				}
+				bases[base] = true
+			}
 			continue
 		}
 		err = s.Delete(height, format)
@@ -4,3 +4,13 @@ package types
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
 const CurrentFormat uint32 = 3
+
+// IncrementalFormat is the format of the incremental snapshots, holding only the IAVL nodes
+// created since a base snapshot of the same node, and restored on top of it. Incremental
+// snapshots are local to the node holding their base, so they are not offered to state sync.
+const IncrementalFormat uint32 = 4
+
+// ParallelFormat is the format of the snapshots whose chunks are compressed independently with
+// zstd, so that they are compressed and decompressed in parallel, and whose stores are restored
+// concurrently. It holds the same items as CurrentFormat.
+const ParallelFormat uint32 = 5
@@ -8,6 +8,9 @@ type SnapshotOptions struct {
 
 	// KeepRecent defines how many snapshots to keep in heights.
 	KeepRecent uint32
+
+	// Format defines the format of the snapshots taken, CurrentFormat if 0.
+	Format uint32
 }
 
 func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
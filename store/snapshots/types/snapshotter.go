@@ -26,6 +26,13 @@ type Snapshotter interface {
 	Restore(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
 }
 
+// FormatSupporter is implemented by the snapshotters restoring several formats. The Snapshotter
+// which do not implement it only restore CurrentFormat.
+type FormatSupporter interface {
+	// SupportedFormats returns a list of formats it can restore from.
+	SupportedFormats() []uint32
+}
+
 // ExtensionPayloadReader read extension payloads,
 // it returns io.EOF when reached either end of stream or the extension boundaries.
 type ExtensionPayloadReader = func() ([]byte, error)
@@ -210,6 +210,10 @@ type CommitMultiStore interface {
 	// SetIAVLDisableFastNode enables/disables fastnode feature on iavl.
 	SetIAVLDisableFastNode(disable bool)
 
+	// SetStorePruning sets the pruning strategy of the named store, overriding the
+	// one set with SetPruning.
+	SetStorePruning(storeName string, opts pruningtypes.PruningOptions)
+
 	// RollbackToVersion rollback the db to specific version(height).
 	RollbackToVersion(version int64) error
 
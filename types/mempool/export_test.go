@@ -0,0 +1,8 @@
+package mempool
+
+import "time"
+
+// SetNow sets the clock used by the mempool to expire txs by age.
+func (mp *PriorityNonceMempool[C]) SetNow(now func() time.Time) {
+	mp.now = now
+}
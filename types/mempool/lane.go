@@ -0,0 +1,444 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/huandu/skiplist"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ Mempool            = (*LaneMempool)(nil)
+	_ InspectableMempool = (*LaneMempool)(nil)
+	_ Iterator           = (*LaneIterator)(nil)
+)
+
+// ErrNoMatchingLane is returned when inserting a tx that matches no lane of a
+// LaneMempool.
+var ErrNoMatchingLane = errors.New("no mempool lane matches tx")
+
+type (
+	// LaneConfig defines a lane of a LaneMempool.
+	LaneConfig struct {
+		// Name identifies the lane.
+		Name string
+
+		// Match returns true if the transaction belongs to the lane. A transaction
+		// belongs to the first lane that matches it. A nil Match matches every
+		// transaction, which is typically used by the last, default lane.
+		Match func(ctx context.Context, tx sdk.Tx) bool
+
+		// MaxBlockSpace is the ratio of the lane in the iteration returned by
+		// Select. It must be positive.
+		MaxBlockSpace sdkmath.LegacyDec
+
+		// Mempool stores the transactions of the lane and defines their ordering.
+		// It should not cap the number of transactions itself, see MaxTx.
+		Mempool Mempool
+
+		// MaxTx sets the maximum number of transactions of the lane. If MaxTx > 0
+		// and the lane is full, inserting a transaction evicts the lowest priority
+		// transaction of the lane instead of being rejected, unless the inserted
+		// transaction has the lowest priority itself. The transactions of the
+		// same sender with a higher nonce than the evicted one are evicted along
+		// with it, since they cannot be included anymore. If MaxTx == 0 the lane
+		// is unbounded.
+		MaxTx int
+
+		// TxPriority defines the transaction priority used to choose the evicted
+		// transaction. It defaults to NewDefaultTxPriority.
+		TxPriority TxPriority[int64]
+	}
+
+	// LaneMempoolConfig defines the configuration used to configure the
+	// LaneMempool.
+	LaneMempoolConfig struct {
+		// Lanes defines the lanes of the mempool, in matching order.
+		Lanes []LaneConfig
+
+		// SignerExtractor is an implementation which retrieves signer data from a sdk.Tx
+		SignerExtractor SignerExtractionAdapter
+	}
+
+	// LaneMempool is a mempool implementation composing several mempools, or
+	// lanes. Each transaction is stored in the first lane matching it, and Select
+	// interleaves the transactions of the lanes according to their MaxBlockSpace
+	// ratios, each lane keeping its own ordering.
+	LaneMempool struct {
+		mtx             sync.Mutex
+		lanes           []*lane
+		signerExtractor SignerExtractionAdapter
+	}
+
+	// LaneIterator defines an iterator that is used for LaneMempool iteration on
+	// Select(). It interleaves the lane iterators using a smooth weighted
+	// round-robin over the lane ratios.
+	LaneIterator struct {
+		iterators []Iterator
+		weights   []sdkmath.LegacyDec
+		credits   []sdkmath.LegacyDec
+		current   int
+	}
+
+	// lane is a lane of a LaneMempool along with its eviction index.
+	lane struct {
+		cfg LaneConfig
+
+		// priorityIndex orders the transactions of the lane by ascending priority,
+		// newest first among equal priorities, so that its front is the next
+		// transaction to evict.
+		priorityIndex *skiplist.SkipList
+		// keys maps the sender and nonce of each transaction to its key in
+		// priorityIndex.
+		keys map[laneTxID]laneTxKey
+		// nonces maps each sender to the nonces of its transactions in the lane.
+		nonces map[string]map[uint64]struct{}
+		seq    uint64
+	}
+
+	laneTxID struct {
+		sender string
+		nonce  uint64
+	}
+
+	laneTx struct {
+		id laneTxID
+		tx sdk.Tx
+	}
+
+	laneTxKey struct {
+		priority int64
+		seq      uint64
+	}
+)
+
+// NewLaneMempool returns a LaneMempool with the given configuration. It panics
+// if the configuration is invalid.
+func NewLaneMempool(cfg LaneMempoolConfig) *LaneMempool {
+	if len(cfg.Lanes) == 0 {
+		panic("lane mempool requires at least one lane")
+	}
+	if cfg.SignerExtractor == nil {
+		cfg.SignerExtractor = NewDefaultSignerExtractionAdapter()
+	}
+
+	names := make(map[string]struct{}, len(cfg.Lanes))
+	lanes := make([]*lane, len(cfg.Lanes))
+	for i, lc := range cfg.Lanes {
+		if lc.Name == "" {
+			panic("lane name cannot be empty")
+		}
+		if _, ok := names[lc.Name]; ok {
+			panic(fmt.Sprintf("duplicate lane %q", lc.Name))
+		}
+		names[lc.Name] = struct{}{}
+
+		if lc.Mempool == nil {
+			panic(fmt.Sprintf("lane %q has no mempool", lc.Name))
+		}
+		if lc.MaxBlockSpace.IsNil() || !lc.MaxBlockSpace.IsPositive() {
+			panic(fmt.Sprintf("lane %q max block space must be positive", lc.Name))
+		}
+		if lc.TxPriority.GetTxPriority == nil {
+			lc.TxPriority = NewDefaultTxPriority()
+		}
+
+		lanes[i] = newLane(lc)
+	}
+
+	return &LaneMempool{
+		lanes:           lanes,
+		signerExtractor: cfg.SignerExtractor,
+	}
+}
+
+func newLane(cfg LaneConfig) *lane {
+	return &lane{
+		cfg: cfg,
+		priorityIndex: skiplist.New(skiplist.GreaterThanFunc(func(a, b any) int {
+			keyA := a.(laneTxKey)
+			keyB := b.(laneTxKey)
+
+			res := cfg.TxPriority.Compare(keyA.priority, keyB.priority)
+			if res != 0 {
+				return res
+			}
+
+			return skiplist.Uint64.Compare(keyB.seq, keyA.seq)
+		})),
+		keys:   make(map[laneTxID]laneTxKey),
+		nonces: make(map[string]map[uint64]struct{}),
+	}
+}
+
+// Insert inserts the transaction in the first lane matching it, returning
+// ErrNoMatchingLane if there is none. If the lane is full its lowest priority
+// transaction is evicted along with the higher nonces of its sender, and
+// ErrMempoolTxMaxCapacity is returned if the inserted transaction is among them.
+func (mp *LaneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	id, err := mp.txID(tx)
+	if err != nil {
+		return err
+	}
+
+	for _, l := range mp.lanes {
+		if l.cfg.Match != nil && !l.cfg.Match(ctx, tx) {
+			continue
+		}
+
+		if err := l.insert(ctx, id, tx); err != nil {
+			return err
+		}
+
+		// the transaction replaces any transaction with the same sender and
+		// nonce stored in another lane
+		for _, other := range mp.lanes {
+			if other != l {
+				if err := other.removeTx(id); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	return ErrNoMatchingLane
+}
+
+// Select returns an iterator interleaving the transactions of the lanes
+// according to their MaxBlockSpace ratios. The passed in list of transactions
+// are ignored.
+//
+// NOTE: It is not safe to use this iterator while removing transactions from
+// the underlying mempool.
+func (mp *LaneMempool) Select(ctx context.Context, _ [][]byte) Iterator {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	iterator := &LaneIterator{
+		iterators: make([]Iterator, len(mp.lanes)),
+		weights:   make([]sdkmath.LegacyDec, len(mp.lanes)),
+		credits:   make([]sdkmath.LegacyDec, len(mp.lanes)),
+	}
+	for i, l := range mp.lanes {
+		iterator.iterators[i] = l.cfg.Mempool.Select(ctx, nil)
+		iterator.weights[i] = l.cfg.MaxBlockSpace
+		iterator.credits[i] = sdkmath.LegacyZeroDec()
+	}
+
+	return iterator.next()
+}
+
+// CountTx returns the number of transactions in all the lanes.
+func (mp *LaneMempool) CountTx() int {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	var count int
+	for _, l := range mp.lanes {
+		count += l.cfg.Mempool.CountTx()
+	}
+
+	return count
+}
+
+// CountLaneTx returns the number of transactions in the lane with the given
+// name, or zero if there is no such lane.
+func (mp *LaneMempool) CountLaneTx(name string) int {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	for _, l := range mp.lanes {
+		if l.cfg.Name == name {
+			return l.cfg.Mempool.CountTx()
+		}
+	}
+
+	return 0
+}
+
+// InspectTxs calls fn for each transaction in the mempool, lane by lane and by
+// descending priority within a lane, until fn returns true.
+func (mp *LaneMempool) InspectTxs(fn func(TxInfo) bool) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	for _, l := range mp.lanes {
+		for node := l.priorityIndex.Back(); node != nil; node = node.Prev() {
+			entry := node.Value.(laneTx)
+			if fn(TxInfo{
+				Tx:       entry.tx,
+				Sender:   entry.id.sender,
+				Nonce:    entry.id.nonce,
+				Priority: strconv.FormatInt(node.Key().(laneTxKey).priority, 10),
+				Lane:     l.cfg.Name,
+			}) {
+				return
+			}
+		}
+	}
+}
+
+// Remove removes the transaction from the lane it was inserted in.
+func (mp *LaneMempool) Remove(tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	id, err := mp.txID(tx)
+	if err != nil {
+		return err
+	}
+
+	for _, l := range mp.lanes {
+		if _, ok := l.keys[id]; ok {
+			return l.removeTx(id)
+		}
+	}
+
+	return ErrTxNotFound
+}
+
+// txID returns the sender and nonce of the transaction's first signer.
+func (mp *LaneMempool) txID(tx sdk.Tx) (laneTxID, error) {
+	sigs, err := mp.signerExtractor.GetSigners(tx)
+	if err != nil {
+		return laneTxID{}, err
+	}
+	if len(sigs) == 0 {
+		return laneTxID{}, fmt.Errorf("tx must have at least one signer")
+	}
+
+	return laneTxID{sender: sigs[0].Signer.String(), nonce: sigs[0].Sequence}, nil
+}
+
+func (l *lane) insert(ctx context.Context, id laneTxID, tx sdk.Tx) error {
+	if err := l.cfg.Mempool.Insert(ctx, tx); err != nil {
+		return err
+	}
+
+	// a transaction with the same sender and nonce was replaced
+	l.remove(id)
+
+	l.seq++
+	key := laneTxKey{priority: l.cfg.TxPriority.GetTxPriority(ctx, tx), seq: l.seq}
+	l.priorityIndex.Set(key, laneTx{id: id, tx: tx})
+	l.keys[id] = key
+	nonces, ok := l.nonces[id.sender]
+	if !ok {
+		nonces = make(map[uint64]struct{})
+		l.nonces[id.sender] = nonces
+	}
+	nonces[id.nonce] = struct{}{}
+
+	if l.cfg.MaxTx <= 0 || len(l.keys) <= l.cfg.MaxTx {
+		return nil
+	}
+
+	// the transactions of the sender following the evicted one cannot be
+	// included without it, so they are evicted too
+	front := l.priorityIndex.Front().Value.(laneTx).id
+	var evicted []laneTxID
+	for nonce := range l.nonces[front.sender] {
+		if nonce >= front.nonce {
+			evicted = append(evicted, laneTxID{sender: front.sender, nonce: nonce})
+		}
+	}
+
+	inserted := true
+	for _, evictedID := range evicted {
+		if err := l.removeTx(evictedID); err != nil {
+			return err
+		}
+		if evictedID == id {
+			inserted = false
+		}
+	}
+
+	if !inserted {
+		return ErrMempoolTxMaxCapacity
+	}
+
+	return nil
+}
+
+// removeTx removes the transaction with the given sender and nonce from the
+// lane, if any.
+func (l *lane) removeTx(id laneTxID) error {
+	key, ok := l.keys[id]
+	if !ok {
+		return nil
+	}
+
+	if err := l.cfg.Mempool.Remove(l.priorityIndex.Get(key).Value.(laneTx).tx); err != nil {
+		return err
+	}
+
+	l.remove(id)
+	return nil
+}
+
+// remove removes the transaction with the given sender and nonce from the
+// eviction index of the lane.
+func (l *lane) remove(id laneTxID) {
+	key, ok := l.keys[id]
+	if !ok {
+		return
+	}
+
+	l.priorityIndex.Remove(key)
+	delete(l.keys, id)
+
+	delete(l.nonces[id.sender], id.nonce)
+	if len(l.nonces[id.sender]) == 0 {
+		delete(l.nonces, id.sender)
+	}
+}
+
+// Next returns the next transaction of the lane whose turn it is, or nil once
+// every lane is exhausted.
+func (i *LaneIterator) Next() Iterator {
+	i.iterators[i.current] = i.iterators[i.current].Next()
+	return i.next()
+}
+
+// Tx returns the transaction at the current position of the iterator.
+func (i *LaneIterator) Tx() sdk.Tx {
+	return i.iterators[i.current].Tx()
+}
+
+// next moves the iterator to the lane with the highest credit among the lanes
+// that are not exhausted, after crediting each of them with its weight.
+func (i *LaneIterator) next() Iterator {
+	total := sdkmath.LegacyZeroDec()
+	next := -1
+	for j, it := range i.iterators {
+		if it == nil {
+			continue
+		}
+
+		total = total.Add(i.weights[j])
+		i.credits[j] = i.credits[j].Add(i.weights[j])
+		if next < 0 || i.credits[j].GT(i.credits[next]) {
+			next = j
+		}
+	}
+
+	if next < 0 {
+		return nil
+	}
+
+	i.credits[next] = i.credits[next].Sub(total)
+	i.current = next
+	return i
+}
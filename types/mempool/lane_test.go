@@ -0,0 +1,159 @@
+package mempool_test
+
+import (
+	"context"
+	"math/rand"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func (s *MempoolTestSuite) TestLaneMempoolSelect() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+
+	mp := mempool.NewLaneMempool(mempool.LaneMempoolConfig{
+		Lanes: []mempool.LaneConfig{
+			{
+				Name: "sa",
+				Match: func(_ context.Context, tx sdk.Tx) bool {
+					return tx.(testTx).address.Equals(sa)
+				},
+				MaxBlockSpace: sdkmath.LegacyNewDecWithPrec(25, 2),
+				Mempool:       mempool.DefaultPriorityMempool(),
+			},
+			{
+				Name:          "default",
+				MaxBlockSpace: sdkmath.LegacyNewDecWithPrec(75, 2),
+				Mempool:       mempool.DefaultPriorityMempool(),
+			},
+		},
+	})
+
+	for i := 0; i < 4; i++ {
+		require.NoError(t, mp.Insert(ctx, testTx{id: i, nonce: uint64(i), address: sa}))
+		require.NoError(t, mp.Insert(ctx, testTx{id: 10 + i, nonce: uint64(i), address: sb}))
+	}
+	require.Equal(t, 8, mp.CountTx())
+	require.Equal(t, 4, mp.CountLaneTx("sa"))
+	require.Equal(t, 4, mp.CountLaneTx("default"))
+	require.Equal(t, 0, mp.CountLaneTx("unknown"))
+
+	// the default lane gets three txs for each tx of the sa lane, until it is
+	// exhausted
+	var order []int
+	for iterator := mp.Select(ctx, nil); iterator != nil; iterator = iterator.Next() {
+		order = append(order, iterator.Tx().(testTx).id)
+	}
+	require.Equal(t, []int{10, 0, 11, 12, 13, 1, 2, 3}, order)
+
+	require.NoError(t, mp.Remove(testTx{nonce: 0, address: sa}))
+	require.Equal(t, 3, mp.CountLaneTx("sa"))
+	require.ErrorIs(t, mp.Remove(testTx{nonce: 0, address: sa}), mempool.ErrTxNotFound)
+}
+
+func (s *MempoolTestSuite) TestLaneMempoolEviction() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 4)
+
+	mp := mempool.NewLaneMempool(mempool.LaneMempoolConfig{
+		Lanes: []mempool.LaneConfig{
+			{
+				Name: "first",
+				Match: func(_ context.Context, tx sdk.Tx) bool {
+					return tx.(testTx).address.Equals(accounts[0].Address)
+				},
+				MaxBlockSpace: sdkmath.LegacyOneDec(),
+				Mempool:       mempool.DefaultPriorityMempool(),
+			},
+			{
+				Name:          "default",
+				MaxBlockSpace: sdkmath.LegacyOneDec(),
+				Mempool:       mempool.DefaultPriorityMempool(),
+				MaxTx:         2,
+			},
+		},
+	})
+
+	insert := func(priority int64, address sdk.AccAddress) error {
+		return mp.Insert(ctx.WithPriority(priority), testTx{priority: priority, address: address})
+	}
+
+	require.NoError(t, insert(5, accounts[1].Address))
+	require.NoError(t, insert(1, accounts[2].Address))
+
+	// a full lane evicts its lowest priority tx
+	require.NoError(t, insert(3, accounts[3].Address))
+	require.Equal(t, 2, mp.CountLaneTx("default"))
+	require.ErrorIs(t, mp.Remove(testTx{address: accounts[2].Address}), mempool.ErrTxNotFound)
+
+	// unless the inserted tx has the lowest priority itself
+	require.ErrorIs(t, insert(0, accounts[2].Address), mempool.ErrMempoolTxMaxCapacity)
+	require.Equal(t, 2, mp.CountLaneTx("default"))
+
+	// other lanes are not affected
+	require.NoError(t, insert(0, accounts[0].Address))
+	require.Equal(t, 1, mp.CountLaneTx("first"))
+	require.Equal(t, 3, mp.CountTx())
+
+	var priorities []int64
+	for iterator := mp.Select(ctx, nil); iterator != nil; iterator = iterator.Next() {
+		priorities = append(priorities, iterator.Tx().(testTx).priority)
+	}
+	require.Equal(t, []int64{0, 5, 3}, priorities)
+}
+
+func (s *MempoolTestSuite) TestLaneMempoolEvictionNonces() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+
+	mp := mempool.NewLaneMempool(mempool.LaneMempoolConfig{
+		Lanes: []mempool.LaneConfig{
+			{
+				Name:          "default",
+				MaxBlockSpace: sdkmath.LegacyOneDec(),
+				Mempool:       mempool.DefaultPriorityMempool(),
+				MaxTx:         3,
+			},
+		},
+	})
+
+	insert := func(priority int64, nonce uint64, address sdk.AccAddress) error {
+		return mp.Insert(ctx.WithPriority(priority), testTx{priority: priority, nonce: nonce, address: address})
+	}
+
+	// the two nonces of the first sender straddle the priority of the evicted tx
+	require.NoError(t, insert(1, 0, accounts[0].Address))
+	require.NoError(t, insert(5, 1, accounts[0].Address))
+	require.NoError(t, insert(3, 0, accounts[1].Address))
+
+	// evicting the lowest nonce evicts the higher nonces of the sender too
+	require.NoError(t, insert(4, 0, accounts[2].Address))
+	require.Equal(t, 2, mp.CountTx())
+	require.ErrorIs(t, mp.Remove(testTx{nonce: 0, address: accounts[0].Address}), mempool.ErrTxNotFound)
+	require.ErrorIs(t, mp.Remove(testTx{nonce: 1, address: accounts[0].Address}), mempool.ErrTxNotFound)
+
+	// the inserted tx is rejected if it follows the evicted tx
+	require.NoError(t, insert(2, 1, accounts[1].Address))
+	require.ErrorIs(t, insert(6, 2, accounts[1].Address), mempool.ErrMempoolTxMaxCapacity)
+	require.Equal(t, 2, mp.CountTx())
+	require.ErrorIs(t, mp.Remove(testTx{nonce: 1, address: accounts[1].Address}), mempool.ErrTxNotFound)
+
+	var priorities []int64
+	for iterator := mp.Select(ctx, nil); iterator != nil; iterator = iterator.Next() {
+		priorities = append(priorities, iterator.Tx().(testTx).priority)
+	}
+	require.Equal(t, []int64{4, 3}, priorities)
+}
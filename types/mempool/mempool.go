@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -37,7 +39,59 @@ type Iterator interface {
 	Tx() sdk.Tx
 }
 
+// EvictingMempool defines a mempool that evicts transactions on its own, e.g.
+// when they are replaced or expire. BaseApp checks rechecked transactions
+// against it, so that evicted transactions are dropped by CometBFT too.
+type EvictingMempool interface {
+	Mempool
+
+	// CheckEvicted returns the reason the transaction was evicted from the
+	// mempool, as one of the eviction errors, or nil if it was not evicted.
+	CheckEvicted(context.Context, sdk.Tx) error
+}
+
+// InspectableMempool defines a mempool whose contents can be inspected, e.g. by
+// the cosmos.mempool.v1.Query gRPC service.
+type InspectableMempool interface {
+	Mempool
+
+	// InspectTxs calls fn for each transaction in the mempool, in mempool order
+	// when the mempool has a deterministic one, until fn returns true. fn must
+	// not call the mempool.
+	InspectTxs(fn func(TxInfo) (stop bool))
+}
+
+// TxInfo describes a transaction held in an InspectableMempool.
+type TxInfo struct {
+	Tx sdk.Tx
+	// Sender and Nonce are the address and sequence of the first signer.
+	Sender string
+	Nonce  uint64
+	// Priority is the formatted priority of the transaction, or empty if the
+	// mempool does not prioritize transactions.
+	Priority string
+	// Lane is the name of the lane holding the transaction, or empty if the
+	// mempool has no lanes.
+	Lane string
+}
+
+// Codespace defines the codespace of the mempool eviction errors.
+const Codespace = "mempool"
+
 var (
 	ErrTxNotFound           = errors.New("tx not found in mempool")
 	ErrMempoolTxMaxCapacity = errors.New("pool reached max tx capacity")
+
+	// ErrTxReplaced is the eviction reason of a tx replaced by another tx with
+	// the same sender and nonce.
+	ErrTxReplaced = errorsmod.Register(Codespace, 2, "tx replaced by a tx with the same sender and nonce")
+	// ErrTxExpiredHeight is the eviction reason of a tx that stayed in the
+	// mempool for more blocks than allowed.
+	ErrTxExpiredHeight = errorsmod.Register(Codespace, 3, "tx expired after max block height age")
+	// ErrTxExpiredAge is the eviction reason of a tx that stayed in the mempool
+	// for longer than allowed.
+	ErrTxExpiredAge = errorsmod.Register(Codespace, 4, "tx expired after max wall-clock age")
+	// ErrTxReplacementUnderpriced is returned when inserting a tx that does not
+	// bump the fee of the tx it would replace enough.
+	ErrTxReplacementUnderpriced = errorsmod.Register(Codespace, 5, "replacement tx fee bump too low")
 )
@@ -50,6 +50,9 @@ type testTx struct {
 	priority int64
 	nonce    uint64
 	address  sdk.AccAddress
+	fee      sdk.Coins
+	// signature is the tx signature, if any
+	signature []byte
 	// useful for debugging
 	strAddress string
 }
@@ -59,9 +62,14 @@ func (tx testTx) GetSigners() ([][]byte, error) { panic("not implemented") }
 func (tx testTx) GetPubKeys() ([]cryptotypes.PubKey, error) { panic("not implemented") }
 
 func (tx testTx) GetSignaturesV2() (res []txsigning.SignatureV2, err error) {
+	var data txsigning.SignatureData
+	if tx.signature != nil {
+		data = &txsigning.SingleSignatureData{Signature: tx.signature}
+	}
+
 	res = append(res, txsigning.SignatureV2{
 		PubKey:   testPubKey{address: tx.address},
-		Data:     nil,
+		Data:     data,
 		Sequence: tx.nonce,
 	})
 
@@ -71,6 +79,7 @@ func (tx testTx) GetSignaturesV2() (res []txsigning.SignatureV2, err error) {
 var (
 	_ sdk.Tx                  = (*testTx)(nil)
 	_ signing.SigVerifiableTx = (*testTx)(nil)
+	_ sdk.FeeTx               = (*testTx)(nil)
 	_ cryptotypes.PubKey      = (*testPubKey)(nil)
 )
 
@@ -80,6 +89,14 @@ func (tx testTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
 
 func (tx testTx) ValidateBasic() error { return nil }
 
+func (tx testTx) GetGas() uint64 { return 0 }
+
+func (tx testTx) GetFee() sdk.Coins { return tx.fee }
+
+func (tx testTx) FeePayer() []byte { return tx.address }
+
+func (tx testTx) FeeGranter() []byte { return nil }
+
 func (tx testTx) String() string {
 	return fmt.Sprintf("tx a: %s, p: %d, n: %d", tx.address, tx.priority, tx.nonce)
 }
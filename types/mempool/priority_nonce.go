@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"sync"
+	"time"
 
 	"github.com/huandu/skiplist"
 
@@ -12,8 +13,9 @@ import (
 )
 
 var (
-	_ Mempool  = (*PriorityNonceMempool[int64])(nil)
-	_ Iterator = (*PriorityNonceIterator[int64])(nil)
+	_ Mempool            = (*PriorityNonceMempool[int64])(nil)
+	_ InspectableMempool = (*PriorityNonceMempool[int64])(nil)
+	_ Iterator           = (*PriorityNonceIterator[int64])(nil)
 )
 
 type (
@@ -42,6 +44,26 @@ type (
 
 		// SignerExtractor is an implementation which retrieves signer data from a sdk.Tx
 		SignerExtractor SignerExtractionAdapter
+
+		// OnEvict is a callback to be called when a tx is evicted from the mempool,
+		// with one of ErrTxReplaced, ErrTxExpiredHeight or ErrTxExpiredAge as
+		// reason. It is not called for txs removed with Remove.
+		OnEvict func(tx sdk.Tx, reason error)
+
+		// ReplaceByFeeBump sets the minimum fee increase, in percent, required for
+		// a tx to replace a tx with the same sender and nonce. Every fee
+		// denomination of the replaced tx must be bumped by at least this much.
+		// If zero, replacement is only governed by TxReplacement.
+		ReplaceByFeeBump uint64
+
+		// TxTTLBlocks sets the number of blocks after which a tx expires and is
+		// evicted from the mempool, based on the block height of the context
+		// passed to Insert and CheckEvicted. If zero, txs do not expire by height.
+		TxTTLBlocks uint64
+
+		// TxTTL sets the wall-clock age after which a tx expires and is evicted
+		// from the mempool. If zero, txs do not expire by age.
+		TxTTL time.Duration
 	}
 
 	// PriorityNonceMempool is a mempool implementation that stores txs
@@ -58,6 +80,19 @@ type (
 		senderIndices  map[string]*skiplist.SkipList
 		scores         map[txMeta[C]]txMeta[C]
 		cfg            PriorityNonceMempoolConfig[C]
+
+		// arrivalIndex orders the txs by arrival, oldest first, to find expired
+		// txs, and arrivals holds the arrival of each tx by sender and nonce.
+		arrivalIndex *skiplist.SkipList
+		arrivals     map[txMeta[C]]txArrival
+		arrivalSeq   uint64
+
+		// evictions records the txs evicted on expiry until they are rechecked,
+		// evictionOrder bounding their number.
+		evictions     map[txMeta[C]]txEviction
+		evictionOrder []txMeta[C]
+
+		now func() time.Time
 	}
 
 	// PriorityNonceIterator defines an iterator that is used for mempool iteration
@@ -169,6 +204,10 @@ func NewPriorityMempool[C comparable](cfg PriorityNonceMempoolConfig[C]) *Priori
 		senderIndices:  make(map[string]*skiplist.SkipList),
 		scores:         make(map[txMeta[C]]txMeta[C]),
 		cfg:            cfg,
+		arrivalIndex:   skiplist.New(skiplist.Uint64),
+		arrivals:       make(map[txMeta[C]]txArrival),
+		evictions:      make(map[txMeta[C]]txEviction),
+		now:            time.Now,
 	}
 
 	return mp
@@ -197,17 +236,25 @@ func (mp *PriorityNonceMempool[C]) NextSenderTx(sender string) sdk.Tx {
 // transaction's first signature.
 //
 // Transactions are unique by sender and nonce. Inserting a duplicate tx is an
-// O(log n) no-op.
+// O(log n) no-op, as is re-inserting a tx carrying the same signatures as the
+// one already in the mempool, which keeps its original arrival.
 //
 // Inserting a duplicate tx with a different priority overwrites the existing tx,
-// changing the total order of the mempool.
+// changing the total order of the mempool, unless it does not bump the fee of
+// the existing tx by ReplaceByFeeBump percent.
+//
+// Expired txs are evicted before inserting the tx.
 func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
+	if mp.cfg.MaxTx < 0 {
+		return nil
+	}
+
+	mp.evictExpired(ctx)
+
 	if mp.cfg.MaxTx > 0 && mp.priorityIndex.Len() >= mp.cfg.MaxTx {
 		return ErrMempoolTxMaxCapacity
-	} else if mp.cfg.MaxTx < 0 {
-		return nil
 	}
 
 	sigs, err := mp.cfg.SignerExtractor.GetSigners(tx)
@@ -242,7 +289,12 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	// This O(log n) remove operation is rare and only happens when a tx's priority
 	// changes.
 	sk := txMeta[C]{nonce: nonce, sender: sender}
+	var replacedTx sdk.Tx
 	if oldScore, txExists := mp.scores[sk]; txExists {
+		if identicalTxs(senderIndex.Get(key).Value.(sdk.Tx), tx) {
+			return nil
+		}
+
 		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, senderIndex.Get(key).Value.(sdk.Tx), tx) {
 			return fmt.Errorf(
 				"tx doesn't fit the replacement rule, oldPriority: %v, newPriority: %v, oldTx: %v, newTx: %v",
@@ -253,6 +305,11 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 			)
 		}
 
+		replacedTx = senderIndex.Get(key).Value.(sdk.Tx)
+		if mp.cfg.ReplaceByFeeBump > 0 && !hasFeeBump(replacedTx, tx, mp.cfg.ReplaceByFeeBump) {
+			return ErrTxReplacementUnderpriced.Wrapf("fees must be bumped by at least %d%%", mp.cfg.ReplaceByFeeBump)
+		}
+
 		mp.priorityIndex.Remove(txMeta[C]{
 			nonce:    nonce,
 			sender:   sender,
@@ -260,6 +317,7 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 			weight:   oldScore.weight,
 		})
 		mp.priorityCounts[oldScore.priority]--
+		mp.removeArrival(sk)
 	}
 
 	mp.priorityCounts[priority]++
@@ -270,6 +328,11 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 
 	mp.scores[sk] = txMeta[C]{priority: priority}
 	mp.priorityIndex.Set(key, tx)
+	mp.addArrival(ctx, sk)
+
+	if replacedTx != nil {
+		mp.onEvict(replacedTx, ErrTxReplaced)
+	}
 
 	return nil
 }
@@ -419,6 +482,25 @@ func senderWeight[C comparable](txPriority TxPriority[C], senderCursor *skiplist
 	return weight
 }
 
+// InspectTxs calls fn for each transaction in the mempool, by descending
+// priority, until fn returns true.
+func (mp *PriorityNonceMempool[C]) InspectTxs(fn func(TxInfo) bool) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	for node := mp.priorityIndex.Front(); node != nil; node = node.Next() {
+		key := node.Key().(txMeta[C])
+		if fn(TxInfo{
+			Tx:       node.Value.(sdk.Tx),
+			Sender:   key.sender,
+			Nonce:    key.nonce,
+			Priority: fmt.Sprint(key.priority),
+		}) {
+			return
+		}
+	}
+}
+
 // CountTx returns the number of transactions in the mempool.
 func (mp *PriorityNonceMempool[C]) CountTx() int {
 	mp.mtx.Lock()
@@ -444,23 +526,35 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 	nonce := sig.Sequence
 
 	scoreKey := txMeta[C]{nonce: nonce, sender: sender}
-	score, ok := mp.scores[scoreKey]
-	if !ok {
+	if _, ok := mp.scores[scoreKey]; !ok {
 		return ErrTxNotFound
 	}
-	tk := txMeta[C]{nonce: nonce, priority: score.priority, sender: sender, weight: score.weight}
 
-	senderTxs, ok := mp.senderIndices[sender]
-	if !ok {
+	if _, ok := mp.senderIndices[sender]; !ok {
 		return fmt.Errorf("sender %s not found", sender)
 	}
 
+	mp.remove(scoreKey)
+
+	return nil
+}
+
+// remove removes the tx with the sender and nonce of scoreKey from the mempool
+// indices and returns it. The tx must exist.
+func (mp *PriorityNonceMempool[C]) remove(scoreKey txMeta[C]) sdk.Tx {
+	score := mp.scores[scoreKey]
+	tk := txMeta[C]{nonce: scoreKey.nonce, priority: score.priority, sender: scoreKey.sender, weight: score.weight}
+
+	senderTxs := mp.senderIndices[scoreKey.sender]
+	tx := senderTxs.Get(tk).Value.(sdk.Tx)
+
 	mp.priorityIndex.Remove(tk)
 	senderTxs.Remove(tk)
 	delete(mp.scores, scoreKey)
 	mp.priorityCounts[score.priority]--
+	mp.removeArrival(scoreKey)
 
-	return nil
+	return tx
 }
 
 func IsEmpty[C comparable](mempool Mempool) error {
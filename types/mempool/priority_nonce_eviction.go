@@ -0,0 +1,237 @@
+package mempool
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txsigning "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+var _ EvictingMempool = (*PriorityNonceMempool[int64])(nil)
+
+// maxEvictionRecords bounds the number of expired txs a PriorityNonceMempool
+// remembers until they are rechecked.
+const maxEvictionRecords = 10_000
+
+type (
+	// txArrival records when a tx was inserted in the mempool.
+	txArrival struct {
+		seq    uint64
+		height int64
+		time   time.Time
+	}
+
+	// txEviction records a tx evicted from the mempool and the reason why.
+	txEviction struct {
+		tx     sdk.Tx
+		reason error
+	}
+)
+
+// CheckEvicted returns the reason the tx was evicted from the mempool, or nil
+// if it was not. A tx still in the mempool is evicted if it has expired.
+//
+// A tx is considered replaced if the mempool holds a tx with the same sender
+// and nonce but different signatures.
+func (mp *PriorityNonceMempool[C]) CheckEvicted(ctx context.Context, tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	sigs, err := mp.cfg.SignerExtractor.GetSigners(tx)
+	if err != nil {
+		return err
+	}
+	if len(sigs) == 0 {
+		return nil
+	}
+
+	sk := txMeta[C]{nonce: sigs[0].Sequence, sender: sigs[0].Signer.String()}
+	if _, ok := mp.scores[sk]; ok {
+		storedTx := mp.senderIndices[sk.sender].Get(sk).Value.(sdk.Tx)
+		if !sameSignatures(storedTx, tx) {
+			return ErrTxReplaced
+		}
+
+		if reason := mp.expiry(ctx, mp.arrivals[sk]); reason != nil {
+			mp.onEvict(mp.remove(sk), reason)
+			return reason
+		}
+
+		return nil
+	}
+
+	if eviction, ok := mp.evictions[sk]; ok && sameSignatures(eviction.tx, tx) {
+		delete(mp.evictions, sk)
+		return eviction.reason
+	}
+
+	return nil
+}
+
+func (mp *PriorityNonceMempool[C]) addArrival(ctx context.Context, sk txMeta[C]) {
+	arrival := txArrival{time: mp.now()}
+	if mp.cfg.TxTTLBlocks > 0 {
+		arrival.height = sdk.UnwrapSDKContext(ctx).BlockHeight()
+	}
+
+	mp.arrivalSeq++
+	arrival.seq = mp.arrivalSeq
+	mp.arrivals[sk] = arrival
+	mp.arrivalIndex.Set(arrival.seq, sk)
+}
+
+func (mp *PriorityNonceMempool[C]) removeArrival(sk txMeta[C]) {
+	arrival, ok := mp.arrivals[sk]
+	if !ok {
+		return
+	}
+
+	mp.arrivalIndex.Remove(arrival.seq)
+	delete(mp.arrivals, sk)
+}
+
+// expiry returns the reason the tx arrived at the given time has expired, or
+// nil if it has not.
+func (mp *PriorityNonceMempool[C]) expiry(ctx context.Context, arrival txArrival) error {
+	if mp.cfg.TxTTLBlocks > 0 && sdk.UnwrapSDKContext(ctx).BlockHeight() >= arrival.height+int64(mp.cfg.TxTTLBlocks) {
+		return ErrTxExpiredHeight
+	}
+
+	if mp.cfg.TxTTL > 0 && mp.now().Sub(arrival.time) >= mp.cfg.TxTTL {
+		return ErrTxExpiredAge
+	}
+
+	return nil
+}
+
+// evictExpired evicts the expired txs, oldest first, and records them so that
+// CheckEvicted reports them.
+func (mp *PriorityNonceMempool[C]) evictExpired(ctx context.Context) {
+	if mp.cfg.TxTTLBlocks == 0 && mp.cfg.TxTTL == 0 {
+		return
+	}
+
+	for node := mp.arrivalIndex.Front(); node != nil; node = mp.arrivalIndex.Front() {
+		sk := node.Value.(txMeta[C])
+		reason := mp.expiry(ctx, mp.arrivals[sk])
+		if reason == nil {
+			// txs arriving later cannot have expired either
+			return
+		}
+
+		tx := mp.remove(sk)
+		mp.evictions[sk] = txEviction{tx: tx, reason: reason}
+		mp.evictionOrder = append(mp.evictionOrder, sk)
+		if len(mp.evictionOrder) > maxEvictionRecords {
+			delete(mp.evictions, mp.evictionOrder[0])
+			mp.evictionOrder = mp.evictionOrder[1:]
+		}
+
+		mp.onEvict(tx, reason)
+	}
+}
+
+func (mp *PriorityNonceMempool[C]) onEvict(tx sdk.Tx, reason error) {
+	if mp.cfg.OnEvict != nil {
+		mp.cfg.OnEvict(tx, reason)
+	}
+}
+
+// hasFeeBump returns true if the fee of newTx bumps every fee denomination of
+// oldTx by at least bump percent.
+func hasFeeBump(oldTx, newTx sdk.Tx, bump uint64) bool {
+	oldFeeTx, ok := oldTx.(sdk.FeeTx)
+	if !ok {
+		return false
+	}
+	newFeeTx, ok := newTx.(sdk.FeeTx)
+	if !ok {
+		return false
+	}
+
+	newFee := newFeeTx.GetFee()
+	ratio := sdkmath.NewIntFromUint64(100 + bump)
+	for _, coin := range oldFeeTx.GetFee() {
+		// round the required fee up, so that any positive bump is enforced
+		required := coin.Amount.Mul(ratio).AddRaw(99).QuoRaw(100)
+		if newFee.AmountOf(coin.Denom).LT(required) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// identicalTxs returns true if both txs carry the same, non-empty, signatures,
+// i.e. they are the same tx resubmitted.
+func identicalTxs(a, b sdk.Tx) bool {
+	sigsA, okA := signatureBytes(a)
+	sigsB, okB := signatureBytes(b)
+	if !okA || !okB || len(sigsA) == 0 || len(sigsA) != len(sigsB) {
+		return false
+	}
+
+	for i := range sigsA {
+		if len(sigsA[i]) == 0 || !bytes.Equal(sigsA[i], sigsB[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sameSignatures returns true if both txs carry the same signatures. Txs
+// whose signatures cannot be retrieved are considered the same.
+func sameSignatures(a, b sdk.Tx) bool {
+	sigsA, okA := signatureBytes(a)
+	sigsB, okB := signatureBytes(b)
+	if !okA || !okB || len(sigsA) != len(sigsB) {
+		return !okA || !okB
+	}
+
+	for i := range sigsA {
+		if !bytes.Equal(sigsA[i], sigsB[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func signatureBytes(tx sdk.Tx) ([][]byte, bool) {
+	sigTx, ok := tx.(signing.SigVerifiableTx)
+	if !ok {
+		return nil, false
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return nil, false
+	}
+
+	var res [][]byte
+	for _, sig := range sigs {
+		res = appendSignatureBytes(res, sig.Data)
+	}
+
+	return res, true
+}
+
+func appendSignatureBytes(res [][]byte, data txsigning.SignatureData) [][]byte {
+	switch data := data.(type) {
+	case *txsigning.SingleSignatureData:
+		return append(res, data.Signature)
+
+	case *txsigning.MultiSignatureData:
+		for _, sig := range data.Signatures {
+			res = appendSignatureBytes(res, sig)
+		}
+	}
+
+	return res
+}
@@ -891,3 +891,92 @@ func TestNextSenderTx_TxReplacement(t *testing.T) {
 	iter := mp.Select(ctx, nil)
 	require.Equal(t, txs[3], iter.Tx())
 }
+
+func TestPriorityNonceMempool_ReplaceByFee(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa := accounts[0].Address
+
+	var evicted []error
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:       mempool.NewDefaultTxPriority(),
+			ReplaceByFeeBump: 10,
+			OnEvict: func(_ sdk.Tx, reason error) {
+				evicted = append(evicted, reason)
+			},
+		},
+	)
+
+	txs := []testTx{
+		{id: 0, nonce: 1, address: sa, fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("atom", 10)), signature: []byte("0")},
+		// atom is not bumped
+		{id: 1, nonce: 1, address: sa, fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 200), sdk.NewInt64Coin("atom", 10)), signature: []byte("1")},
+		// stake is bumped by less than 10%
+		{id: 2, nonce: 1, address: sa, fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 109), sdk.NewInt64Coin("atom", 11)), signature: []byte("2")},
+		{id: 3, nonce: 1, address: sa, fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 110), sdk.NewInt64Coin("atom", 11)), signature: []byte("3")},
+	}
+
+	require.NoError(t, mp.Insert(ctx, txs[0]))
+	require.ErrorIs(t, mp.Insert(ctx, txs[1]), mempool.ErrTxReplacementUnderpriced)
+	require.ErrorIs(t, mp.Insert(ctx, txs[2]), mempool.ErrTxReplacementUnderpriced)
+	require.Empty(t, evicted)
+
+	require.NoError(t, mp.Insert(ctx, txs[3]))
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, []error{mempool.ErrTxReplaced}, evicted)
+	require.Equal(t, txs[3], mp.Select(ctx, nil).Tx())
+
+	// re-inserting the same tx is a no-op rather than an underpriced replacement
+	require.NoError(t, mp.Insert(ctx, txs[3]))
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, []error{mempool.ErrTxReplaced}, evicted)
+
+	// the replaced tx is reported as such when rechecked
+	require.ErrorIs(t, mp.CheckEvicted(ctx, txs[0]), mempool.ErrTxReplaced)
+	require.NoError(t, mp.CheckEvicted(ctx, txs[3]))
+}
+
+func TestPriorityNonceMempool_TxTTL(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+
+	var evicted []error
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:  mempool.NewDefaultTxPriority(),
+			TxTTLBlocks: 2,
+			TxTTL:       time.Minute,
+			OnEvict: func(_ sdk.Tx, reason error) {
+				evicted = append(evicted, reason)
+			},
+		},
+	)
+	now := time.Now()
+	mp.SetNow(func() time.Time { return now })
+
+	txs := []testTx{
+		{id: 0, nonce: 1, address: accounts[0].Address},
+		{id: 1, nonce: 1, address: accounts[1].Address},
+		{id: 2, nonce: 1, address: accounts[2].Address},
+	}
+
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(1), txs[0]))
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(2), txs[1]))
+	require.NoError(t, mp.CheckEvicted(ctx.WithBlockHeight(2), txs[0]))
+
+	// the first tx expires by height when the next tx is inserted
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(3), txs[2]))
+	require.Equal(t, 2, mp.CountTx())
+	require.Equal(t, []error{mempool.ErrTxExpiredHeight}, evicted)
+
+	// the eviction is reported once when rechecked
+	require.ErrorIs(t, mp.CheckEvicted(ctx.WithBlockHeight(3), txs[0]), mempool.ErrTxExpiredHeight)
+	require.NoError(t, mp.CheckEvicted(ctx.WithBlockHeight(3), txs[0]))
+
+	// the remaining txs expire by age when rechecked
+	now = now.Add(time.Minute)
+	require.ErrorIs(t, mp.CheckEvicted(ctx.WithBlockHeight(3), txs[2]), mempool.ErrTxExpiredAge)
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, []error{mempool.ErrTxExpiredHeight, mempool.ErrTxExpiredAge}, evicted)
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"math/rand" // #nosec // math/rand is used for random selection and seeded from crypto/rand
+	"sort"
 	"sync"
 
 	"github.com/huandu/skiplist"
@@ -15,8 +16,9 @@ import (
 )
 
 var (
-	_ Mempool  = (*SenderNonceMempool)(nil)
-	_ Iterator = (*senderNonceMempoolIterator)(nil)
+	_ Mempool            = (*SenderNonceMempool)(nil)
+	_ InspectableMempool = (*SenderNonceMempool)(nil)
+	_ Iterator           = (*senderNonceMempoolIterator)(nil)
 )
 
 var DefaultMaxTx = -1
@@ -188,6 +190,28 @@ func (snm *SenderNonceMempool) Select(_ context.Context, _ [][]byte) Iterator {
 	return iter.Next()
 }
 
+// InspectTxs calls fn for each transaction in the mempool, by sender and then
+// by ascending nonce, until fn returns true. As Select picks senders at random,
+// the mempool has no deterministic order.
+func (snm *SenderNonceMempool) InspectTxs(fn func(TxInfo) bool) {
+	snm.mtx.Lock()
+	defer snm.mtx.Unlock()
+
+	senders := make([]string, 0, len(snm.senders))
+	for sender := range snm.senders {
+		senders = append(senders, sender)
+	}
+	sort.Strings(senders)
+
+	for _, sender := range senders {
+		for node := snm.senders[sender].Front(); node != nil; node = node.Next() {
+			if fn(TxInfo{Tx: node.Value.(sdk.Tx), Sender: sender, Nonce: node.Key().(uint64)}) {
+				return
+			}
+		}
+	}
+}
+
 // CountTx returns the total count of txs in the mempool.
 func (snm *SenderNonceMempool) CountTx() int {
 	snm.mtx.Lock()
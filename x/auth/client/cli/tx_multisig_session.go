@@ -0,0 +1,736 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/version"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// errNotMultisigSession is returned when reading a file which is not a
+// multisig signing session, e.g. a transaction.
+var errNotMultisigSession = errors.New("not a multisig signing session")
+
+// multisigSession is a signing session of a transaction of a multisig account,
+// collecting the signatures of its members until its threshold is reached.
+// The session holds the signer data of the multisig account, its signatures
+// being verifiable offline.
+type multisigSession struct {
+	ChainID       string `json:"chain_id"`
+	AccountNumber uint64 `json:"account_number,string"`
+	Sequence      uint64 `json:"sequence,string"`
+	// Multisig is the address of the multisig account.
+	Multisig  string          `json:"multisig"`
+	Threshold uint32          `json:"threshold"`
+	PubKey    json.RawMessage `json:"pub_key"`
+	// Tx is the unsigned transaction.
+	Tx      json.RawMessage  `json:"tx"`
+	Members []multisigMember `json:"members"`
+	// TxHash is the hash of the transaction once broadcast.
+	TxHash string `json:"tx_hash,omitempty"`
+}
+
+// multisigMember is a member of a multisig account, in the order of the keys
+// of the multisig public key, and its signature once signed.
+type multisigMember struct {
+	Address   string          `json:"address"`
+	SignMode  string          `json:"sign_mode,omitempty"`
+	Signature json.RawMessage `json:"signature,omitempty"`
+}
+
+// GetMultisigCommand returns the multisig signing session commands.
+func GetMultisigCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisig",
+		Short: "Collect the signatures of the members of a multisig account in a signing session",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Collect the signatures of a transaction of a multisig account in a local session file,
+instead of passing signature files around: the file holds the unsigned transaction, the multisig
+public key and its threshold, the account number and sequence it is signed with, and the
+signature and sign mode of each member who signed.
+
+Once the threshold is reached, the signatures are combined and the transaction broadcast.
+The session files can be verified offline with the validate-signatures command.
+
+Example:
+$ %[1]s tx multisig init unsigned.json k1k2k3 session.json
+$ %[1]s tx multisig add-sig session.json --from k1
+$ %[1]s tx multisig add-sig session.json k2sig.json
+$ %[1]s tx multisig status session.json
+`, version.AppName),
+		),
+		RunE: client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetMultisigInitCommand(),
+		GetMultisigAddSigCommand(),
+		GetMultisigStatusCommand(),
+		GetMultisigFinalizeCommand(),
+	)
+
+	return cmd
+}
+
+// GetMultisigInitCommand returns the command starting a multisig signing session.
+func GetMultisigInitCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init [file] [multisig] [session-file]",
+		Short: "Start a signing session of a transaction of a multisig account",
+		Long: `Start a signing session of the transaction generated offline in [file], of which the multisig
+account [multisig], a key name or address of the keyring, must be the only signer.
+
+The account number and sequence of the multisig account are queried, unless the --offline
+flag is set, in which case they must be set with --account-number and --sequence.
+`,
+		PreRun: preSignCmd,
+		RunE:   makeMultisigInitCmd(),
+		Args:   cobra.ExactArgs(3),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func makeMultisigInitCmd() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+		clientCtx, txF, stdTx, err := readTxAndInitContexts(clientCtx, cmd, args[0])
+		if err != nil {
+			return err
+		}
+
+		multisigAddr, multisigName, _, err := client.GetFromFields(clientCtx, txF.Keybase(), args[1])
+		if err != nil {
+			return fmt.Errorf("error getting account from keybase: %w", err)
+		}
+		k, err := getMultisigRecord(clientCtx, multisigName)
+		if err != nil {
+			return err
+		}
+		pubKey, err := k.GetPubKey()
+		if err != nil {
+			return err
+		}
+		multisigPub, ok := pubKey.(*kmultisig.LegacyAminoPubKey)
+		if !ok {
+			return fmt.Errorf("%s is not a multisig key", multisigName)
+		}
+
+		signers, err := stdTx.(authsigning.SigVerifiableTx).GetSigners()
+		if err != nil {
+			return err
+		}
+		if len(signers) != 1 || !bytes.Equal(signers[0], multisigAddr) {
+			return fmt.Errorf("the multisig account %s must be the only signer of the transaction", multisigAddr)
+		}
+
+		if txF.ChainID() == "" {
+			return fmt.Errorf("set the chain id with either the --chain-id flag or config file")
+		}
+		if !clientCtx.Offline {
+			accNum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, multisigAddr)
+			if err != nil {
+				return err
+			}
+
+			txF = txF.WithAccountNumber(accNum).WithSequence(seq)
+		}
+
+		// the session holds the unsigned transaction
+		txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(stdTx)
+		if err != nil {
+			return err
+		}
+		if err := txBuilder.SetSignatures(); err != nil {
+			return err
+		}
+		txJSON, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+		if err != nil {
+			return err
+		}
+		pubKeyJSON, err := clientCtx.Codec.MarshalInterfaceJSON(multisigPub)
+		if err != nil {
+			return err
+		}
+
+		session := &multisigSession{
+			ChainID:       txF.ChainID(),
+			AccountNumber: txF.AccountNumber(),
+			Sequence:      txF.Sequence(),
+			Multisig:      multisigAddr.String(),
+			Threshold:     multisigPub.Threshold,
+			PubKey:        pubKeyJSON,
+			Tx:            txJSON,
+		}
+		for _, pk := range multisigPub.GetPubKeys() {
+			session.Members = append(session.Members, multisigMember{Address: sdk.AccAddress(pk.Address()).String()})
+		}
+
+		if _, err := os.Stat(args[2]); err == nil {
+			return fmt.Errorf("session file %s already exists", args[2])
+		}
+		if err := session.write(args[2]); err != nil {
+			return err
+		}
+
+		printMultisigSession(cmd, clientCtx, session, false)
+		return nil
+	}
+}
+
+// GetMultisigAddSigCommand returns the command adding signatures to a multisig
+// signing session.
+func GetMultisigAddSigCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-sig [session-file] [[signature-file]...]",
+		Short: "Add the signatures of members of a multisig account to a signing session",
+		Long: `Sign the transaction of a multisig signing session with the --from key, or add the signatures
+read from [signature-file], e.g. generated by the sign --multisig command, to the session.
+
+The signatures are verified against the transaction before being added. They must use the
+SIGN_MODE_LEGACY_AMINO_JSON sign mode, the only one whose sign bytes are the same for the members
+and the multisig account. Once the threshold is reached, the signatures are combined and the transaction broadcast, unless the
+--offline flag is set, see the finalize command.
+`,
+		RunE: makeMultisigAddSigCmd(),
+		Args: cobra.MinimumNArgs(1),
+	}
+
+	cmd.Flags().Bool(flagOverwrite, false, "Overwrite the signatures of the members who already signed")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func makeMultisigAddSigCmd() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		session, err := readMultisigSession(args[0])
+		if err != nil {
+			return err
+		}
+		if session.TxHash != "" {
+			return fmt.Errorf("the transaction of the session was already broadcast: %s", session.TxHash)
+		}
+		multisigPub, err := session.pubKey(clientCtx)
+		if err != nil {
+			return err
+		}
+		stdTx, err := session.tx(clientCtx)
+		if err != nil {
+			return err
+		}
+
+		var sigs []signingtypes.SignatureV2
+		if len(args) > 1 {
+			for _, file := range args[1:] {
+				fileSigs, err := unmarshalSignatureJSON(clientCtx, file)
+				if err != nil {
+					return err
+				}
+				sigs = append(sigs, fileSigs...)
+			}
+		} else {
+			if clientCtx.FromName == "" {
+				return fmt.Errorf("set the key to sign with with the --%s flag, or the signature files", flags.FlagFrom)
+			}
+			sig, err := session.sign(cmd, clientCtx, stdTx)
+			if err != nil {
+				return err
+			}
+			sigs = append(sigs, sig)
+		}
+
+		overwrite, _ := cmd.Flags().GetBool(flagOverwrite)
+		for _, sig := range sigs {
+			i := memberIndex(multisigPub, sig.PubKey)
+			if i < 0 {
+				return fmt.Errorf("%s is not a member of the multisig account", sdk.AccAddress(sig.PubKey.Address()))
+			}
+			if session.Members[i].Signature != nil && !overwrite {
+				return fmt.Errorf("%s already signed, set --%s to replace its signature", session.Members[i].Address, flagOverwrite)
+			}
+			if err := session.verifySignature(cmd.Context(), clientCtx, stdTx, sig); err != nil {
+				return fmt.Errorf("couldn't verify signature for address %s: %w", session.Members[i].Address, err)
+			}
+			if err := session.setSignature(clientCtx, i, sig); err != nil {
+				return err
+			}
+		}
+
+		if err := session.write(args[0]); err != nil {
+			return err
+		}
+
+		printMultisigSession(cmd, clientCtx, session, false)
+		if session.signed() < int(session.Threshold) || clientCtx.Offline {
+			return nil
+		}
+
+		return finalizeMultisigSession(cmd, clientCtx, args[0], session)
+	}
+}
+
+// GetMultisigStatusCommand returns the command printing the status of a
+// multisig signing session.
+func GetMultisigStatusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status [session-file]",
+		Short: "Print the members of a multisig account who signed in a signing session",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			session, err := readMultisigSession(args[0])
+			if err != nil {
+				return err
+			}
+
+			printMultisigSession(cmd, clientCtx, session, false)
+			return nil
+		},
+		Args: cobra.ExactArgs(1),
+	}
+
+	return cmd
+}
+
+// GetMultisigFinalizeCommand returns the command combining the signatures of
+// a multisig signing session and broadcasting its transaction.
+func GetMultisigFinalizeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize [session-file]",
+		Short: "Combine the signatures of a multisig signing session and broadcast its transaction",
+		Long: `Combine the signatures of a multisig signing session which reached its threshold, and broadcast
+the signed transaction, or print it if the --generate-only flag is set.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			session, err := readMultisigSession(args[0])
+			if err != nil {
+				return err
+			}
+
+			return finalizeMultisigSession(cmd, clientCtx, args[0], session)
+		},
+		Args: cobra.ExactArgs(1),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// finalizeMultisigSession combines the signatures of a session and broadcasts
+// its transaction, or prints it in generate only mode.
+func finalizeMultisigSession(cmd *cobra.Command, clientCtx client.Context, path string, session *multisigSession) error {
+	if session.TxHash != "" {
+		return fmt.Errorf("the transaction of the session was already broadcast: %s", session.TxHash)
+	}
+	if signed := session.signed(); signed < int(session.Threshold) {
+		return fmt.Errorf("%d signatures collected, the threshold is %d", signed, session.Threshold)
+	}
+
+	txBuilder, err := session.combine(cmd.Context(), clientCtx)
+	if err != nil {
+		return err
+	}
+
+	if clientCtx.GenerateOnly {
+		json, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+		if err != nil {
+			return err
+		}
+
+		cmd.Printf("%s\n", json)
+		return nil
+	}
+	if clientCtx.Offline {
+		return fmt.Errorf("cannot broadcast in offline mode, set --%s to print the signed transaction", flags.FlagGenerateOnly)
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+
+	if res.Code == 0 {
+		session.TxHash = res.TxHash
+		if err := session.write(path); err != nil {
+			return err
+		}
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// printMultisigSession prints the members of the multisig account of a session
+// and their signatures, verifying them if verify is true. It returns false if
+// a signature fails verification.
+func printMultisigSession(cmd *cobra.Command, clientCtx client.Context, session *multisigSession, verify bool) bool {
+	cmd.Printf("Multisig: %s\n", session.Multisig)
+	cmd.Printf("Chain ID: %s, account number: %d, sequence: %d\n", session.ChainID, session.AccountNumber, session.Sequence)
+	cmd.Printf("Threshold: %d of %d members, %d signed\n", session.Threshold, len(session.Members), session.signed())
+	if session.TxHash != "" {
+		cmd.Printf("Broadcast: %s\n", session.TxHash)
+	}
+
+	var stdTx sdk.Tx
+	success := true
+	if verify {
+		var err error
+		if stdTx, err = session.tx(clientCtx); err != nil {
+			cmd.PrintErrf("failed to decode transaction: %v\n", err)
+			return false
+		}
+	}
+
+	cmd.Println("")
+	cmd.Println("Members:")
+	for i, member := range session.Members {
+		status := "not signed"
+		if member.Signature != nil {
+			status = member.SignMode
+		}
+
+		if verify && member.Signature != nil {
+			sig, err := session.signature(clientCtx, i)
+			if err == nil {
+				err = session.verifySignature(cmd.Context(), clientCtx, stdTx, *sig)
+			}
+			if err != nil {
+				status += fmt.Sprintf("\t[ERROR: %v]", err)
+				success = false
+			} else {
+				status += "\t[OK]"
+			}
+		}
+
+		cmd.Printf("  %d: %s\t\t%s\n", i, member.Address, status)
+	}
+
+	if verify && success && session.signed() >= int(session.Threshold) {
+		if _, err := session.combine(cmd.Context(), clientCtx); err != nil {
+			cmd.PrintErrf("failed to verify the combined signature: %v\n", err)
+			success = false
+		}
+	}
+	cmd.Println("")
+
+	return success
+}
+
+// readMultisigSession reads a session file, returning errNotMultisigSession
+// if the file is not a session.
+func readMultisigSession(path string) (*multisigSession, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	session := new(multisigSession)
+	if err := json.Unmarshal(bz, session); err != nil {
+		return nil, fmt.Errorf("%w: %w", errNotMultisigSession, err)
+	}
+	if len(session.Tx) == 0 || len(session.PubKey) == 0 || len(session.Members) == 0 {
+		return nil, errNotMultisigSession
+	}
+
+	return session, nil
+}
+
+// write writes the session to path, replacing the previous file atomically.
+func (s *multisigSession) write(path string) error {
+	bz, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(append(bz, '\n')); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *multisigSession) pubKey(clientCtx client.Context) (*kmultisig.LegacyAminoPubKey, error) {
+	var pubKey cryptotypes.PubKey
+	if err := clientCtx.Codec.UnmarshalInterfaceJSON(s.PubKey, &pubKey); err != nil {
+		return nil, err
+	}
+
+	multisigPub, ok := pubKey.(*kmultisig.LegacyAminoPubKey)
+	if !ok {
+		return nil, fmt.Errorf("expected a multisig public key, got %T", pubKey)
+	}
+	if len(multisigPub.PubKeys) != len(s.Members) || multisigPub.Threshold != s.Threshold {
+		return nil, errors.New("the members and threshold of the session do not match its public key")
+	}
+
+	return multisigPub, nil
+}
+
+func (s *multisigSession) tx(clientCtx client.Context) (sdk.Tx, error) {
+	return clientCtx.TxConfig.TxJSONDecoder()(s.Tx)
+}
+
+// signature returns the signature of the i-th member.
+func (s *multisigSession) signature(clientCtx client.Context, i int) (*signingtypes.SignatureV2, error) {
+	sigs, err := clientCtx.TxConfig.UnmarshalSignatureJSON(s.Members[i].Signature)
+	if err != nil {
+		return nil, err
+	}
+	if len(sigs) != 1 {
+		return nil, fmt.Errorf("expected a single signature for member %s, got %d", s.Members[i].Address, len(sigs))
+	}
+
+	return &sigs[0], nil
+}
+
+func (s *multisigSession) setSignature(clientCtx client.Context, i int, sig signingtypes.SignatureV2) error {
+	data, ok := sig.Data.(*signingtypes.SingleSignatureData)
+	if !ok {
+		return fmt.Errorf("expected a single signature for member %s, got %T", s.Members[i].Address, sig.Data)
+	}
+
+	bz, err := clientCtx.TxConfig.MarshalSignatureJSON([]signingtypes.SignatureV2{sig})
+	if err != nil {
+		return err
+	}
+
+	s.Members[i].SignMode = data.SignMode.String()
+	s.Members[i].Signature = bz
+	return nil
+}
+
+// signed returns the number of members who signed.
+func (s *multisigSession) signed() int {
+	var signed int
+	for _, member := range s.Members {
+		if member.Signature != nil {
+			signed++
+		}
+	}
+
+	return signed
+}
+
+// signerData returns the signer data of the session, for the signer pubKey.
+func (s *multisigSession) signerData(pubKey cryptotypes.PubKey) (txsigning.SignerData, error) {
+	anyPk, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return txsigning.SignerData{}, err
+	}
+
+	return txsigning.SignerData{
+		ChainID:       s.ChainID,
+		AccountNumber: s.AccountNumber,
+		Sequence:      s.Sequence,
+		Address:       sdk.AccAddress(pubKey.Address()).String(),
+		PubKey: &anypb.Any{
+			TypeUrl: anyPk.TypeUrl,
+			Value:   anyPk.Value,
+		},
+	}, nil
+}
+
+// sign signs the transaction of the session with the --from key.
+func (s *multisigSession) sign(cmd *cobra.Command, clientCtx client.Context, stdTx sdk.Tx) (signingtypes.SignatureV2, error) {
+	// the signer data is the one of the session, even in offline mode
+	txF, err := tx.NewFactoryCLI(clientCtx.WithOffline(false), cmd.Flags())
+	if err != nil {
+		return signingtypes.SignatureV2{}, err
+	}
+	txF = txF.WithChainID(s.ChainID).WithAccountNumber(s.AccountNumber).WithSequence(s.Sequence)
+
+	switch txF.SignMode() {
+	case signingtypes.SignMode_SIGN_MODE_UNSPECIFIED:
+		txF = txF.WithSignMode(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	case signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
+	default:
+		return signingtypes.SignatureV2{}, unsupportedSignModeError(txF.SignMode())
+	}
+
+	txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(stdTx)
+	if err != nil {
+		return signingtypes.SignatureV2{}, err
+	}
+	if err := tx.Sign(cmd.Context(), txF, clientCtx.FromName, txBuilder, true); err != nil {
+		return signingtypes.SignatureV2{}, err
+	}
+
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	if err != nil {
+		return signingtypes.SignatureV2{}, err
+	}
+
+	return sigs[0], nil
+}
+
+// verifySignature verifies the signature of a member over the transaction of
+// the session.
+func (s *multisigSession) verifySignature(ctx context.Context, clientCtx client.Context, stdTx sdk.Tx, sig signingtypes.SignatureV2) error {
+	if err := checkSignMode(sig.Data); err != nil {
+		return err
+	}
+	if sig.Sequence != s.Sequence {
+		return fmt.Errorf("signature of sequence %d, expected %d", sig.Sequence, s.Sequence)
+	}
+
+	signerData, err := s.signerData(sig.PubKey)
+	if err != nil {
+		return err
+	}
+	adaptableTx, ok := stdTx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return fmt.Errorf("expected Tx to be signing.V2AdaptableTx, got %T", stdTx)
+	}
+
+	return authsigning.VerifySignature(ctx, sig.PubKey, signerData, sig.Data, clientCtx.TxConfig.SignModeHandler(), adaptableTx.GetSigningTxData())
+}
+
+// checkSignMode returns an error if a signature of data does not use the
+// LEGACY_AMINO_JSON sign mode.
+func checkSignMode(data signingtypes.SignatureData) error {
+	switch data := data.(type) {
+	case *signingtypes.SingleSignatureData:
+		if data.SignMode != signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+			return unsupportedSignModeError(data.SignMode)
+		}
+	case *signingtypes.MultiSignatureData:
+		for _, sig := range data.Signatures {
+			if err := checkSignMode(sig); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// unsupportedSignModeError returns the error of a member signing with another
+// sign mode than LEGACY_AMINO_JSON. The members sign with their own signer
+// data, which is only valid for the multisig if it is not part of the sign
+// bytes, as with LEGACY_AMINO_JSON. SIGN_MODE_DIRECT is not supported by
+// multisig accounts at all.
+func unsupportedSignModeError(signMode signingtypes.SignMode) error {
+	return fmt.Errorf("the %s sign mode is not supported by multisig sessions, use %s", signMode, signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+}
+
+// combine returns the transaction of the session signed with the combined
+// signatures of its members, verifying the multisig signature.
+func (s *multisigSession) combine(ctx context.Context, clientCtx client.Context) (client.TxBuilder, error) {
+	multisigPub, err := s.pubKey(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+	stdTx, err := s.tx(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+	txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(stdTx)
+	if err != nil {
+		return nil, err
+	}
+
+	multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
+	for i, member := range s.Members {
+		if member.Signature == nil {
+			continue
+		}
+
+		sig, err := s.signature(clientCtx, i)
+		if err != nil {
+			return nil, err
+		}
+		if err := multisig.AddSignatureV2(multisigSig, *sig, multisigPub.GetPubKeys()); err != nil {
+			return nil, err
+		}
+	}
+
+	sigV2 := signingtypes.SignatureV2{
+		PubKey:   multisigPub,
+		Data:     multisigSig,
+		Sequence: s.Sequence,
+	}
+	if err := txBuilder.SetSignatures(sigV2); err != nil {
+		return nil, err
+	}
+
+	signerData, err := s.signerData(multisigPub)
+	if err != nil {
+		return nil, err
+	}
+	adaptableTx, ok := txBuilder.GetTx().(authsigning.V2AdaptableTx)
+	if !ok {
+		return nil, fmt.Errorf("expected Tx to be signing.V2AdaptableTx, got %T", txBuilder.GetTx())
+	}
+	err = authsigning.VerifySignature(ctx, multisigPub, signerData, multisigSig, clientCtx.TxConfig.SignModeHandler(), adaptableTx.GetSigningTxData())
+	if err != nil {
+		return nil, fmt.Errorf("couldn't verify the multisig signature: %w", err)
+	}
+
+	return txBuilder, nil
+}
+
+// memberIndex returns the index of pubKey in the keys of a multisig public key,
+// -1 if not a member.
+func memberIndex(multisigPub *kmultisig.LegacyAminoPubKey, pubKey cryptotypes.PubKey) int {
+	for i, pk := range multisigPub.GetPubKeys() {
+		if pk.Equals(pubKey) {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,131 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+)
+
+func TestMultisigSession(t *testing.T) {
+	encodingConfig := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{})
+	testdata.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	txConfig := encodingConfig.TxConfig
+
+	kr := keyring.NewInMemory(encodingConfig.Codec)
+	var pubKeys []cryptotypes.PubKey
+	for _, name := range []string{"k1", "k2", "k3"} {
+		k, _, err := kr.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+		pk, err := k.GetPubKey()
+		require.NoError(t, err)
+		pubKeys = append(pubKeys, pk)
+	}
+	multisigPub := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+	_, err := kr.SaveMultisig("multi", multisigPub)
+	require.NoError(t, err)
+
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(testdata.NewTestMsg(sdk.AccAddress(multisigPub.Address()))))
+	builder.SetGasLimit(testdata.NewTestGasLimit())
+	builder.SetFeeAmount(testdata.NewTestFeeAmount())
+	txJSON, err := txConfig.TxJSONEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	txFile := testutil.WriteToNewTempFile(t, string(txJSON))
+
+	clientCtx := client.Context{}.
+		WithTxConfig(txConfig).
+		WithCodec(encodingConfig.Codec).
+		WithInterfaceRegistry(encodingConfig.InterfaceRegistry).
+		WithKeyring(kr)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	execute := func(cmd *cobra.Command, args ...string) (string, error) {
+		out := new(bytes.Buffer)
+		cmd.SetOut(out)
+		cmd.SetErr(out)
+		cmd.SetArgs(args)
+		err := cmd.ExecuteContext(ctx)
+		return out.String(), err
+	}
+	executeOffline := func(cmd *cobra.Command, args ...string) (string, error) {
+		return execute(cmd, append(args, fmt.Sprintf("--%s=testchain", flags.FlagChainID), fmt.Sprintf("--%s", flags.FlagOffline))...)
+	}
+
+	sessionFile := filepath.Join(t.TempDir(), "session.json")
+	_, err = executeOffline(cli.GetMultisigInitCommand(), txFile.Name(), "multi", sessionFile)
+	require.ErrorContains(t, err, "account-number")
+	_, err = executeOffline(cli.GetMultisigInitCommand(), txFile.Name(), "multi", sessionFile,
+		fmt.Sprintf("--%s=1", flags.FlagAccountNumber), fmt.Sprintf("--%s=2", flags.FlagSequence))
+	require.NoError(t, err)
+	_, err = executeOffline(cli.GetMultisigInitCommand(), txFile.Name(), "multi", sessionFile,
+		fmt.Sprintf("--%s=1", flags.FlagAccountNumber), fmt.Sprintf("--%s=2", flags.FlagSequence))
+	require.ErrorContains(t, err, "already exists")
+
+	// the threshold is not reached
+	_, err = executeOffline(cli.GetMultisigAddSigCommand(), sessionFile, fmt.Sprintf("--%s=k1", flags.FlagFrom))
+	require.NoError(t, err)
+	_, err = executeOffline(cli.GetMultisigAddSigCommand(), sessionFile, fmt.Sprintf("--%s=k1", flags.FlagFrom))
+	require.ErrorContains(t, err, "already signed")
+	_, err = executeOffline(cli.GetMultisigFinalizeCommand(), sessionFile, fmt.Sprintf("--%s", flags.FlagGenerateOnly))
+	require.ErrorContains(t, err, "the threshold is 2")
+
+	_, err = executeOffline(cli.GetMultisigAddSigCommand(), sessionFile,
+		fmt.Sprintf("--%s=k3", flags.FlagFrom), fmt.Sprintf("--%s=direct", flags.FlagSignMode))
+	require.ErrorContains(t, err, "SIGN_MODE_DIRECT")
+	_, err = executeOffline(cli.GetMultisigAddSigCommand(), sessionFile,
+		fmt.Sprintf("--%s=k3", flags.FlagFrom), fmt.Sprintf("--%s=textual", flags.FlagSignMode))
+	require.ErrorContains(t, err, "SIGN_MODE_TEXTUAL")
+	_, err = executeOffline(cli.GetMultisigAddSigCommand(), sessionFile, fmt.Sprintf("--%s=k3", flags.FlagFrom))
+	require.NoError(t, err)
+
+	out, err := execute(cli.GetMultisigStatusCommand(), sessionFile)
+	require.NoError(t, err)
+	require.Contains(t, out, "Threshold: 2 of 3 members, 2 signed")
+	require.Contains(t, out, "not signed")
+	require.Contains(t, out, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON.String())
+
+	out, err = execute(cli.GetValidateSignaturesCommand(), sessionFile)
+	require.NoError(t, err)
+	require.Contains(t, out, "[OK]")
+
+	out, err = executeOffline(cli.GetMultisigFinalizeCommand(), sessionFile, fmt.Sprintf("--%s", flags.FlagGenerateOnly))
+	require.NoError(t, err)
+	signedTx, err := txConfig.TxJSONDecoder()([]byte(out))
+	require.NoError(t, err)
+	sigs, err := signedTx.(interface {
+		GetSignaturesV2() ([]signing.SignatureV2, error)
+	}).GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.True(t, multisigPub.Equals(sigs[0].PubKey))
+	require.Equal(t, uint64(2), sigs[0].Sequence)
+
+	// tampered signatures fail the validation
+	bz, err := os.ReadFile(sessionFile)
+	require.NoError(t, err)
+	bz = bytes.Replace(bz, []byte(`"sequence": "2"`), []byte(`"sequence": "3"`), 1)
+	require.NoError(t, os.WriteFile(sessionFile, bz, 0o600))
+	out, err = execute(cli.GetValidateSignaturesCommand(), sessionFile)
+	require.Error(t, err)
+	require.Contains(t, out, "ERROR")
+}
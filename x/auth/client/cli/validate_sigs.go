@@ -2,6 +2,7 @@ package cli
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 
 	"github.com/spf13/cobra"
@@ -29,6 +30,9 @@ The command would check whether all required signers have signed the transaction
 the signatures were collected in the right order, and if the signature is valid over the
 given transaction. If the --offline flag is also set, signature validation over the
 transaction will be not be performed as that will require RPC communication with a full node.
+
+If [file] is a multisig signing session (see the multisig command), the signatures of its members
+are verified offline over its transaction, as well as their combination once the threshold is reached.
 `,
 		PreRun: preSignCmd,
 		RunE:   makeValidateSignaturesCmd(),
@@ -46,6 +50,20 @@ func makeValidateSignaturesCmd() func(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return err
 		}
+
+		if args[0] != "-" {
+			session, err := readMultisigSession(args[0])
+			switch {
+			case err == nil:
+				if !printMultisigSession(cmd, clientCtx, session, true) {
+					return fmt.Errorf("signatures validation failed")
+				}
+				return nil
+			case !errors.Is(err, errNotMultisigSession):
+				return err
+			}
+		}
+
 		clientCtx, txBldr, stdTx, err := readTxAndInitContexts(clientCtx, cmd, args[0])
 		if err != nil {
 			return err
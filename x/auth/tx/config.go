@@ -44,6 +44,9 @@ type ConfigOptions struct {
 	// TextualCoinMetadataQueryFn is the function that will be used to query coin metadata when constructing
 	// textual sign mode handler. This is required if SIGN_MODE_TEXTUAL is enabled.
 	TextualCoinMetadataQueryFn textual.CoinMetadataQueryFn
+	// TextualCustomMessageRenderers are the custom message renderers that will be defined on the textual sign mode
+	// handler, e.g. to render a x/bank MsgSend as "Send <amount> to <address>".
+	TextualCustomMessageRenderers []textual.CustomMessageRenderer
 	// CustomSignModes are the custom sign modes that will be added to the txsigning.HandlerMap.
 	CustomSignModes []txsigning.SignModeHandler
 	// ProtoDecoder is the decoder that will be used to decode protobuf transactions.
@@ -141,7 +144,7 @@ func NewSigningHandlerMap(configOpts ConfigOptions) (*txsigning.HandlerMap, erro
 				TypeResolver: signingOpts.TypeResolver,
 			})
 		case signingtypes.SignMode_SIGN_MODE_TEXTUAL:
-			handlers[i], err = textual.NewSignModeHandler(textual.SignModeOptions{
+			textualHandler, err := textual.NewSignModeHandler(textual.SignModeOptions{
 				CoinMetadataQuerier: configOpts.TextualCoinMetadataQueryFn,
 				FileResolver:        signingOpts.FileResolver,
 				TypeResolver:        signingOpts.TypeResolver,
@@ -152,6 +155,10 @@ func NewSigningHandlerMap(configOpts ConfigOptions) (*txsigning.HandlerMap, erro
 			if err != nil {
 				return nil, err
 			}
+			for _, r := range configOpts.TextualCustomMessageRenderers {
+				textualHandler.DefineMessageRenderer(r.MsgType, r.Fn(textualHandler))
+			}
+			handlers[i] = textualHandler
 		}
 	}
 	for i, m := range configOpts.CustomSignModes {
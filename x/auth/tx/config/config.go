@@ -53,6 +53,7 @@ type ModuleInputs struct {
 	FeeGrantKeeper         ante.FeegrantKeeper                `optional:"true"`
 	CustomSignModeHandlers func() []txsigning.SignModeHandler `optional:"true"`
 	CustomGetSigners       []txsigning.CustomGetSigner        `optional:"true"`
+	CustomMessageRenderers []textual.CustomMessageRenderer    `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -81,7 +82,8 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 			ValidatorAddressCodec: in.ValidatorAddressCodec,
 			CustomGetSigners:      make(map[protoreflect.FullName]txsigning.GetSignersFunc),
 		},
-		CustomSignModes: customSignModeHandlers,
+		TextualCustomMessageRenderers: in.CustomMessageRenderers,
+		CustomSignModes:               customSignModeHandlers,
 	}
 
 	for _, mode := range in.CustomGetSigners {
@@ -1,10 +1,20 @@
 package tx_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	txsigning "cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/textual"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/testutil"
@@ -12,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/std"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
 	txtestutil "github.com/cosmos/cosmos-sdk/x/auth/tx/testutil"
 )
@@ -34,3 +45,38 @@ func TestConfigOptions(t *testing.T) {
 	handler := txConfig.SignModeHandler()
 	require.NotNil(t, handler)
 }
+
+func TestConfigOptions_TextualCustomMessageRenderers(t *testing.T) {
+	msg, err := anypb.New(&bankv1beta1.MsgSend{
+		FromAddress: "cosmos1ulav3hsenupswqfkw2y3sup5kgtqwnvqa8eyhs",
+		ToAddress:   "cosmos1ejrf4cur2wy6kfurg9f2jppp2h3afe5h6pkh5t",
+		Amount:      []*basev1beta1.Coin{{Denom: "stake", Amount: "1"}},
+	})
+	require.NoError(t, err)
+	body := &txv1beta1.TxBody{Messages: []*anypb.Any{msg}}
+	authInfo := &txv1beta1.AuthInfo{Fee: &txv1beta1.Fee{GasLimit: 100}}
+	bodyBytes, err := proto.Marshal(body)
+	require.NoError(t, err)
+	authInfoBytes, err := proto.Marshal(authInfo)
+	require.NoError(t, err)
+	txData := txsigning.TxData{Body: body, AuthInfo: authInfo, BodyBytes: bodyBytes, AuthInfoBytes: authInfoBytes}
+
+	signBytes := func(renderers ...textual.CustomMessageRenderer) string {
+		handler, err := tx.NewSigningHandlerMap(tx.ConfigOptions{
+			EnabledSignModes:              []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL},
+			TextualCoinMetadataQueryFn:    func(context.Context, string) (*bankv1beta1.Metadata, error) { return nil, nil },
+			TextualCustomMessageRenderers: renderers,
+		})
+		require.NoError(t, err)
+
+		bz, err := handler.GetSignBytes(context.Background(), signingv1beta1.SignMode_SIGN_MODE_TEXTUAL, txsigning.SignerData{ChainID: "test"}, txData)
+		require.NoError(t, err)
+		return string(bz)
+	}
+
+	require.NotContains(t, signBytes(), "Send 1 stake to cosmos1ejrf4cur2wy6kfurg9f2jppp2h3afe5h6pkh5t")
+	require.Contains(t, signBytes(textual.CustomMessageRenderer{
+		MsgType: (&bankv1beta1.MsgSend{}).ProtoReflect().Descriptor().FullName(),
+		Fn:      textual.NewMsgSendValueRenderer,
+	}), "Send 1 stake to cosmos1ejrf4cur2wy6kfurg9f2jppp2h3afe5h6pkh5t")
+}
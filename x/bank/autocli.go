@@ -11,7 +11,8 @@ import (
 func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 	return &autocliv1.ModuleOptions{
 		Query: &autocliv1.ServiceCommandDescriptor{
-			Service: bankv1beta1.Query_ServiceDesc.ServiceName,
+			Service:              bankv1beta1.Query_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // the hooks query is a custom command
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
 				{
 					RpcMethod:      "Balance",
@@ -108,6 +109,10 @@ Note: multiple coins can be send by space separated.`,
 					RpcMethod: "SetSendEnabled",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "SetHookEnabled",
+					Skip:      true, // skipped because authority gated
+				},
 				{
 					RpcMethod: "Burn",
 					Skip:      true, // skipped because available from v0.51
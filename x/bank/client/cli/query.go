@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// NewQueryCmd returns a root CLI command handler for the x/bank queries that
+// AutoCLI cannot generate. The other queries are added to it by AutoCLI.
+func NewQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the bank module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		NewHooksCmd(),
+	)
+
+	return queryCmd
+}
+
+// NewHooksCmd returns a CLI command handler for querying the registered bank
+// hooks. The Hooks query is not part of the cosmossdk.io/api release the
+// AutoCLI descriptors are resolved from yet.
+func NewHooksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hooks",
+		Short: "Query the registered bank hooks in execution order",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Hooks(cmd.Context(), &types.QueryHooksRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
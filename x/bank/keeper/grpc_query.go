@@ -301,6 +301,28 @@ func (k BaseKeeper) SendEnabled(goCtx context.Context, req *types.QuerySendEnabl
 	return resp, nil
 }
 
+// Hooks implements the Query/Hooks gRPC method
+func (k BaseKeeper) Hooks(ctx context.Context, req *types.QueryHooksRequest) (*types.QueryHooksResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	registered := k.GetHooks()
+	hooks := make([]types.HookInfo, 0, len(registered))
+	for _, h := range registered {
+		hooks = append(hooks, types.HookInfo{
+			Name:          h.Name,
+			Order:         h.Order,
+			GasLimit:      h.GasLimit,
+			Enabled:       k.IsHookEnabled(ctx, h.Name),
+			Denoms:        h.Denoms,
+			DenomPrefixes: h.DenomPrefixes,
+		})
+	}
+
+	return &types.QueryHooksResponse{Hooks: hooks}, nil
+}
+
 // DenomOwnersByQuery is identical to DenomOwner query, but receives denom values via query string.
 func (k BaseKeeper) DenomOwnersByQuery(ctx context.Context, req *types.QueryDenomOwnersByQueryRequest) (*types.QueryDenomOwnersByQueryResponse, error) {
 	if req == nil {
@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/golang/mock/gomock"
+
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
@@ -913,3 +916,24 @@ func (suite *KeeperTestSuite) TestGRPCDenomOwnersByQuery() {
 
 	suite.Require().True(true)
 }
+
+func (suite *KeeperTestSuite) TestQueryHooks() {
+	ctrl := gomock.NewController(suite.T())
+	keeper.UnsafeSetHooks(&suite.bankKeeper, types.NewNamedMultiBankHooks(
+		types.NamedBankHooks{Name: "second", Order: 2, DenomPrefixes: []string{"factory/"}, Hooks: testutil.NewMockBankHooks(ctrl)},
+		types.NamedBankHooks{Name: "first", Order: 1, GasLimit: 50_000, Hooks: testutil.NewMockBankHooks(ctrl)},
+	))
+	// the hook is reported disabled once the block disabling it is committed
+	finalizeCtx := sdk.UnwrapSDKContext(suite.ctx).WithExecMode(sdk.ExecModeFinalize)
+	suite.Require().NoError(suite.bankKeeper.SetHookEnabled(finalizeCtx, "second", false))
+
+	res, err := suite.bankKeeper.Hooks(suite.ctx, &types.QueryHooksRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.HookInfo{
+		{Name: "first", Order: 1, GasLimit: 50_000, Enabled: true},
+		{Name: "second", Order: 2, Enabled: false, DenomPrefixes: []string{"factory/"}},
+	}, res.Hooks)
+
+	_, err = suite.bankKeeper.Hooks(suite.ctx, nil)
+	suite.Require().Error(err)
+}
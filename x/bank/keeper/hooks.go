@@ -8,19 +8,53 @@ import (
 )
 
 // Implements StakingHooks interface
-var _ types.BankHooks = BaseSendKeeper{}
+var (
+	_ types.BankHooks = BaseSendKeeper{}
+	_ types.MintHooks = BaseSendKeeper{}
+	_ types.BurnHooks = BaseSendKeeper{}
+)
 
 // TrackBeforeSend executes the TrackBeforeSend hook if registered.
 func (k BaseSendKeeper) TrackBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) {
-	if k.hooks != nil {
+	if k.hooks.Len() != 0 {
 		k.hooks.TrackBeforeSend(ctx, from, to, amount)
 	}
 }
 
 // BlockBeforeSend executes the BlockBeforeSend hook if registered.
 func (k BaseSendKeeper) BlockBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
-	if k.hooks != nil {
+	if k.hooks.Len() != 0 {
 		return k.hooks.BlockBeforeSend(ctx, from, to, amount)
 	}
 	return nil
 }
+
+// TrackBeforeMint executes the TrackBeforeMint hook if registered.
+func (k BaseSendKeeper) TrackBeforeMint(ctx context.Context, minter sdk.AccAddress, amount sdk.Coins) {
+	if k.hooks.Len() != 0 {
+		k.hooks.TrackBeforeMint(ctx, minter, amount)
+	}
+}
+
+// BlockBeforeMint executes the BlockBeforeMint hook if registered.
+func (k BaseSendKeeper) BlockBeforeMint(ctx context.Context, minter sdk.AccAddress, amount sdk.Coins) error {
+	if k.hooks.Len() != 0 {
+		return k.hooks.BlockBeforeMint(ctx, minter, amount)
+	}
+	return nil
+}
+
+// TrackBeforeBurn executes the TrackBeforeBurn hook if registered.
+func (k BaseSendKeeper) TrackBeforeBurn(ctx context.Context, burner sdk.AccAddress, amount sdk.Coins) {
+	if k.hooks.Len() != 0 {
+		k.hooks.TrackBeforeBurn(ctx, burner, amount)
+	}
+}
+
+// BlockBeforeBurn executes the BlockBeforeBurn hook if registered.
+func (k BaseSendKeeper) BlockBeforeBurn(ctx context.Context, burner sdk.AccAddress, amount sdk.Coins) error {
+	if k.hooks.Len() != 0 {
+		return k.hooks.BlockBeforeBurn(ctx, burner, amount)
+	}
+	return nil
+}
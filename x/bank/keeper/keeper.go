@@ -116,6 +116,16 @@ func (k BaseKeeper) WithMintCoinsRestriction(check types.MintingRestrictionFn) B
 	return k
 }
 
+// WithStrictHooks makes a panicking TrackBeforeSend, TrackBeforeMint or
+// TrackBeforeBurn hook abort the operation it is called for. By default, such
+// a hook has its state changes discarded and a hook_error event is emitted,
+// without failing the operation.
+func (k BaseKeeper) WithStrictHooks() BaseKeeper {
+	k.strictHooks = true
+	k.hooks = k.hooks.WithStrictTracking(true)
+	return k
+}
+
 // DelegateCoins performs delegation by deducting amt coins from an account with
 // address addr. For vesting accounts, delegations amounts are tracked for both
 // vesting and vested coins. The coins are then transferred from the delegator
@@ -372,6 +382,13 @@ func (k BaseKeeper) MintCoins(ctx context.Context, moduleName string, amounts sd
 		panic(errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to mint tokens", moduleName))
 	}
 
+	// call the BlockBeforeMint hooks and the TrackBeforeMint hooks
+	err = k.BlockBeforeMint(ctx, acc.GetAddress(), amounts)
+	if err != nil {
+		return err
+	}
+	k.TrackBeforeMint(ctx, acc.GetAddress(), amounts)
+
 	err = k.addCoins(ctx, acc.GetAddress(), amounts)
 	if err != nil {
 		return err
@@ -405,7 +422,14 @@ func (k BaseKeeper) BurnCoins(ctx context.Context, moduleName string, amounts sd
 		panic(errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to burn tokens", moduleName))
 	}
 
-	err := k.subUnlockedCoins(ctx, acc.GetAddress(), amounts)
+	// call the BlockBeforeBurn hooks and the TrackBeforeBurn hooks
+	err := k.BlockBeforeBurn(ctx, acc.GetAddress(), amounts)
+	if err != nil {
+		return err
+	}
+	k.TrackBeforeBurn(ctx, acc.GetAddress(), amounts)
+
+	err = k.subUnlockedCoins(ctx, acc.GetAddress(), amounts)
 	if err != nil {
 		return err
 	}
@@ -1033,6 +1033,46 @@ func (suite *KeeperTestSuite) TestSendCoins() {
 	require.Equal(newBarCoin(25), coins[0], "expected only bar coins in the account balance, got: %v", coins)
 }
 
+func (suite *KeeperTestSuite) TestSendCoinsPanickingTrackHook() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100))
+	sendAmt := sdk.NewCoins(newFooCoin(10))
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], balances))
+
+	hooks := banktestutil.NewMockBankHooks(gomock.NewController(suite.T()))
+	hooks.EXPECT().BlockBeforeSend(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	hooks.EXPECT().TrackBeforeSend(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Do(
+		func(context.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) { panic("boom") },
+	).AnyTimes()
+	keeper.UnsafeSetHooks(&suite.bankKeeper, banktypes.NewNamedMultiBankHooks(
+		banktypes.NamedBankHooks{Name: "panicking", Hooks: hooks},
+	))
+
+	// the failing hook doesn't abort the transfer
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sendAmt))
+	require.Equal(sendAmt, suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+
+	var hookErrors int
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == banktypes.EventTypeHookError {
+			hookErrors++
+		}
+	}
+	require.Equal(1, hookErrors)
+
+	// in strict mode the failing hook aborts the transfer
+	strictKeeper := suite.bankKeeper.WithStrictHooks()
+	require.PanicsWithValue("boom", func() {
+		_ = strictKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sendAmt)
+	})
+}
+
 func (suite *KeeperTestSuite) TestSendCoinsWithRestrictions() {
 	type restrictionArgs struct {
 		ctx      context.Context
@@ -2013,6 +2053,55 @@ func (suite *KeeperTestSuite) TestMintCoinRestrictions() {
 	}
 }
 
+// supplyHooks combines mocks of the BankHooks, MintHooks and BurnHooks interfaces.
+type supplyHooks struct {
+	*banktestutil.MockBankHooks
+	*banktestutil.MockMintHooks
+	*banktestutil.MockBurnHooks
+}
+
+func (suite *KeeperTestSuite) TestMintBurnHooks() {
+	require := suite.Require()
+	ctrl := gomock.NewController(suite.T())
+	hooks := supplyHooks{
+		MockBankHooks: banktestutil.NewMockBankHooks(ctrl),
+		MockMintHooks: banktestutil.NewMockMintHooks(ctrl),
+		MockBurnHooks: banktestutil.NewMockBurnHooks(ctrl),
+	}
+	keeper.UnsafeSetHooks(&suite.bankKeeper, hooks)
+
+	fooCoins := sdk.NewCoins(newFooCoin(100))
+	barCoins := sdk.NewCoins(newBarCoin(100))
+
+	// minting is blocked by BlockBeforeMint
+	suite.mockMintCoins(multiPermAcc)
+	hooks.MockMintHooks.EXPECT().BlockBeforeMint(gomock.Any(), multiPermAcc.GetAddress(), barCoins).Return(errors.New("bar is not mintable"))
+	require.EqualError(suite.bankKeeper.MintCoins(suite.ctx, multiPerm, barCoins), "bar is not mintable")
+	require.True(suite.bankKeeper.GetSupply(suite.ctx, barDenom).IsZero())
+
+	suite.mockMintCoins(multiPermAcc)
+	gomock.InOrder(
+		hooks.MockMintHooks.EXPECT().BlockBeforeMint(gomock.Any(), multiPermAcc.GetAddress(), fooCoins).Return(nil),
+		hooks.MockMintHooks.EXPECT().TrackBeforeMint(gomock.Any(), multiPermAcc.GetAddress(), fooCoins),
+	)
+	require.NoError(suite.bankKeeper.MintCoins(suite.ctx, multiPerm, fooCoins))
+	require.Equal(fooCoins, suite.bankKeeper.GetAllBalances(suite.ctx, multiPermAcc.GetAddress()))
+
+	// burning is blocked by BlockBeforeBurn
+	suite.authKeeper.EXPECT().GetModuleAccount(suite.ctx, multiPermAcc.Name).Return(multiPermAcc)
+	hooks.MockBurnHooks.EXPECT().BlockBeforeBurn(gomock.Any(), multiPermAcc.GetAddress(), fooCoins).Return(errors.New("foo is not burnable"))
+	require.EqualError(suite.bankKeeper.BurnCoins(suite.ctx, multiPerm, fooCoins), "foo is not burnable")
+	require.Equal(fooCoins, suite.bankKeeper.GetAllBalances(suite.ctx, multiPermAcc.GetAddress()))
+
+	suite.mockBurnCoins(multiPermAcc)
+	gomock.InOrder(
+		hooks.MockBurnHooks.EXPECT().BlockBeforeBurn(gomock.Any(), multiPermAcc.GetAddress(), fooCoins).Return(nil),
+		hooks.MockBurnHooks.EXPECT().TrackBeforeBurn(gomock.Any(), multiPermAcc.GetAddress(), fooCoins),
+	)
+	require.NoError(suite.bankKeeper.BurnCoins(suite.ctx, multiPerm, fooCoins))
+	require.True(suite.bankKeeper.GetAllBalances(suite.ctx, multiPermAcc.GetAddress()).IsZero())
+}
+
 func (suite *KeeperTestSuite) TestIsSendEnabledDenom() {
 	ctx, bankKeeper := suite.ctx, suite.bankKeeper
 	require := suite.Require()
@@ -182,3 +182,20 @@ func (k msgServer) SetSendEnabled(goCtx context.Context, msg *types.MsgSetSendEn
 
 	return &types.MsgSetSendEnabledResponse{}, nil
 }
+
+func (k msgServer) SetHookEnabled(goCtx context.Context, msg *types.MsgSetHookEnabled) (*types.MsgSetHookEnabledResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if msg.Name == "" {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("hook name cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.SetHookEnabled(ctx, msg.Name, msg.Enabled); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetHookEnabledResponse{}, nil
+}
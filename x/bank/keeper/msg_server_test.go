@@ -1,8 +1,14 @@
 package keeper_test
 
 import (
+	"github.com/golang/mock/gomock"
+
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -360,3 +366,98 @@ func (suite *KeeperTestSuite) TestMsgSetSendEnabled() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgSetHookEnabled() {
+	ctrl := gomock.NewController(suite.T())
+	hooks := banktestutil.NewMockBankHooks(ctrl)
+	keeper.UnsafeSetHooks(&suite.bankKeeper, banktypes.NewNamedMultiBankHooks(
+		banktypes.NamedBankHooks{Name: "tokenfactory", Hooks: hooks},
+	))
+	msgServer := keeper.NewMsgServerImpl(suite.bankKeeper)
+
+	testCases := []struct {
+		name     string
+		req      *banktypes.MsgSetHookEnabled
+		isExpErr bool
+		errMsg   string
+	}{
+		{
+			name:     "invalid authority",
+			req:      banktypes.NewMsgSetHookEnabled("invalid", "tokenfactory", false),
+			isExpErr: true,
+			errMsg:   "invalid authority",
+		},
+		{
+			name:     "empty name",
+			req:      banktypes.NewMsgSetHookEnabled(govAcc.GetAddress().String(), "", false),
+			isExpErr: true,
+			errMsg:   "hook name cannot be empty",
+		},
+		{
+			name:     "unknown hook",
+			req:      banktypes.NewMsgSetHookEnabled(govAcc.GetAddress().String(), "unknown", false),
+			isExpErr: true,
+			errMsg:   `no bank hook registered under "unknown": unknown bank hook`,
+		},
+		{
+			name: "disable hook",
+			req:  banktypes.NewMsgSetHookEnabled(govAcc.GetAddress().String(), "tokenfactory", false),
+		},
+		{
+			name: "enable hook",
+			req:  banktypes.NewMsgSetHookEnabled(govAcc.GetAddress().String(), "tokenfactory", true),
+		},
+	}
+
+	// a change takes effect from the next block
+	nextBlockCtx := sdk.UnwrapSDKContext(suite.ctx)
+	nextBlockCtx = nextBlockCtx.WithBlockHeight(nextBlockCtx.BlockHeight() + 1)
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := msgServer.SetHookEnabled(suite.ctx, tc.req)
+			if tc.isExpErr {
+				suite.Require().ErrorContains(err, tc.errMsg)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.req.Enabled, suite.bankKeeper.IsHookEnabled(nextBlockCtx, tc.req.Name))
+		})
+	}
+
+	// whatever the execution mode, a disabled hook is still called in the
+	// current block and skipped from the next one, without consuming gas
+	for i, mode := range []sdk.ExecMode{sdk.ExecModeCheck, sdk.ExecModeSimulate, sdk.ExecModeFinalize} {
+		ctx := sdk.UnwrapSDKContext(suite.ctx).WithExecMode(mode).WithBlockHeight(int64(10 * (i + 1)))
+		suite.Require().NoError(suite.bankKeeper.SetHookEnabled(ctx, "tokenfactory", false))
+		suite.Require().True(suite.bankKeeper.IsHookEnabled(ctx, "tokenfactory"))
+
+		ctx = ctx.WithGasMeter(storetypes.NewGasMeter(1_000_000))
+		hooks.EXPECT().BlockBeforeSend(gomock.Any(), accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(1))).Return(nil)
+		suite.Require().NoError(suite.bankKeeper.BlockBeforeSend(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(1))))
+		suite.Require().Zero(ctx.GasMeter().GasConsumed())
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		suite.Require().False(suite.bankKeeper.IsHookEnabled(ctx, "tokenfactory"))
+		suite.Require().NoError(suite.bankKeeper.BlockBeforeSend(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(1))))
+		suite.bankKeeper.TrackBeforeSend(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(1)))
+		suite.Require().Zero(ctx.GasMeter().GasConsumed())
+
+		// enabling the hook again takes effect from the next block too
+		suite.Require().NoError(suite.bankKeeper.SetHookEnabled(ctx, "tokenfactory", true))
+		suite.Require().False(suite.bankKeeper.IsHookEnabled(ctx, "tokenfactory"))
+		suite.Require().True(suite.bankKeeper.IsHookEnabled(ctx.WithBlockHeight(ctx.BlockHeight()+1), "tokenfactory"))
+	}
+
+	// a change made in the block being finalized applies to the transactions
+	// checked once it is committed, which are for the next block too
+	ctx := sdk.UnwrapSDKContext(suite.ctx).WithExecMode(sdk.ExecModeFinalize).WithBlockHeight(40)
+	suite.Require().NoError(suite.bankKeeper.SetHookEnabled(ctx, "tokenfactory", false))
+	suite.Require().True(suite.bankKeeper.IsHookEnabled(ctx, "tokenfactory"))
+	suite.Require().False(suite.bankKeeper.IsHookEnabled(ctx.WithExecMode(sdk.ExecModeCheck), "tokenfactory"))
+	suite.Require().False(suite.bankKeeper.IsHookEnabled(ctx.WithBlockHeight(41), "tokenfactory"))
+
+	// enabling the hook again in the same block cancels the pending change
+	suite.Require().NoError(suite.bankKeeper.SetHookEnabled(ctx, "tokenfactory", true))
+	suite.Require().True(suite.bankKeeper.IsHookEnabled(ctx, "tokenfactory"))
+	suite.Require().True(suite.bankKeeper.IsHookEnabled(ctx.WithBlockHeight(41), "tokenfactory"))
+}
@@ -9,6 +9,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -46,6 +47,10 @@ type SendKeeper interface {
 	BlockedAddr(addr sdk.AccAddress) bool
 	GetBlockedAddresses() map[string]bool
 
+	GetHooks() []types.NamedBankHooks
+	IsHookEnabled(ctx context.Context, name string) bool
+	SetHookEnabled(ctx context.Context, name string, enabled bool) error
+
 	GetAuthority() string
 }
 
@@ -60,7 +65,8 @@ type BaseSendKeeper struct {
 	ak           types.AccountKeeper
 	storeService store.KVStoreService
 	logger       log.Logger
-	hooks        types.BankHooks
+	hooks        types.NamedMultiBankHooks
+	strictHooks  bool
 
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
@@ -96,17 +102,101 @@ func NewBaseSendKeeper(
 	}
 }
 
-// SetHooks Set the bank hooks
+// SetHooks Set the bank hooks. Hooks other than NamedMultiBankHooks are
+// registered under the name "hooks_<i>", <i> being their position in a
+// MultiBankHooks, or 0.
 func (k BaseSendKeeper) SetHooks(bh types.BankHooks) BaseSendKeeper {
-	if k.hooks != nil {
+	if k.hooks.Len() != 0 {
 		panic("cannot set bank hooks twice")
 	}
 
-	k.hooks = bh
+	k.hooks = k.newMultiBankHooks(bh)
 
 	return k
 }
 
+// newMultiBankHooks wraps bh into a NamedMultiBankHooks skipping the hooks
+// disabled through MsgSetHookEnabled.
+func (k BaseSendKeeper) newMultiBankHooks(bh types.BankHooks) types.NamedMultiBankHooks {
+	var mh types.NamedMultiBankHooks
+	switch hooks := bh.(type) {
+	case types.NamedMultiBankHooks:
+		mh = hooks
+	case types.MultiBankHooks:
+		mh = hooks.Named()
+	default:
+		mh = types.NewMultiBankHooks(bh).Named()
+	}
+
+	return mh.WithStrictTracking(k.strictHooks).WithHookFilter(k.isHookDisabled)
+}
+
+// GetHooks returns the registered bank hooks in execution order.
+func (k BaseSendKeeper) GetHooks() []types.NamedBankHooks {
+	return k.hooks.Hooks()
+}
+
+// IsHookEnabled returns false if the hook registered under name is disabled in
+// the block of ctx, see hookHeight.
+func (k BaseSendKeeper) IsHookEnabled(ctx context.Context, name string) bool {
+	return !k.isHookDisabled(ctx, name)
+}
+
+// SetHookEnabled enables or disables the hook registered under name. An error
+// is returned if no hook is registered under that name.
+//
+// The change takes effect from the block following the block of ctx, in every
+// execution mode, see hookHeight.
+func (k BaseSendKeeper) SetHookEnabled(ctx context.Context, name string, enabled bool) error {
+	if !k.hooks.IsRegistered(name) {
+		return errorsmod.Wrapf(types.ErrUnknownHook, "no bank hook registered under %q", name)
+	}
+
+	height := hookHeight(sdk.UnwrapSDKContext(ctx))
+	if k.IsHookEnabled(ctx, name) != enabled {
+		return k.HookToggles.Set(ctx, name, collections.Join(height+1, enabled))
+	}
+
+	// the hook is already in the requested state in the current block, which
+	// cancels any change pending for the next block
+	if enabled {
+		return k.HookToggles.Remove(ctx, name)
+	}
+
+	return k.HookToggles.Set(ctx, name, collections.Join(height, enabled))
+}
+
+// isHookDisabled is the filter of the bank hooks. It consumes no gas, so that
+// registering hooks does not change the gas cost of the transfers.
+func (k BaseSendKeeper) isHookDisabled(ctx context.Context, name string) bool {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	toggle, err := k.HookToggles.Get(sdkCtx.WithGasMeter(storetypes.NewInfiniteGasMeter()), name)
+	if err != nil {
+		return false
+	}
+
+	// before the height of the toggle, the hook is in the opposite state
+	enabled := toggle.K2()
+	if hookHeight(sdkCtx) < toggle.K1() {
+		enabled = !enabled
+	}
+
+	return !enabled
+}
+
+// hookHeight returns the height of the block the hooks of ctx run in. It is the
+// block being executed when finalizing or proposing a block, and the next block
+// otherwise, e.g. for CheckTx, simulations and queries whose context has the
+// height of the last committed block.
+func hookHeight(ctx sdk.Context) int64 {
+	switch ctx.ExecMode() {
+	case sdk.ExecModeCheck, sdk.ExecModeReCheck, sdk.ExecModeSimulate:
+		return ctx.BlockHeight() + 1
+	default:
+		return ctx.BlockHeight()
+	}
+}
+
 // AppendSendRestriction adds the provided SendRestrictionFn to run after previously provided restrictions.
 func (k BaseSendKeeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
 	k.sendRestriction.append(restriction)
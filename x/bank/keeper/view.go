@@ -67,6 +67,9 @@ type BaseViewKeeper struct {
 	SendEnabled   collections.Map[string, bool]
 	Balances      *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
 	Params        collections.Item[types.Params]
+	// HookToggles maps the name of a hook toggled through MsgSetHookEnabled to
+	// the height from which the toggle applies and whether it enables the hook.
+	HookToggles collections.Map[string, collections.Pair[int64, bool]]
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
@@ -82,6 +85,7 @@ func NewBaseViewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService,
 		SendEnabled:   collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
 		Balances:      collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
 		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		HookToggles:   collections.NewMap(sb, types.HookTogglesPrefix, "hook_toggles", collections.StringKey, types.HookToggleValueCodec),
 	}
 
 	schema, err := sb.Build()
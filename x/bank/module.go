@@ -11,11 +11,13 @@ import (
 	"golang.org/x/exp/maps"
 
 	modulev1 "cosmossdk.io/api/cosmos/bank/module/v1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	"cosmossdk.io/x/tx/signing/textual"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -83,6 +85,11 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *g
 	}
 }
 
+// GetQueryCmd returns the root query command for the bank module.
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.NewQueryCmd()
+}
+
 // GetTxCmd returns the root tx command for the bank module.
 func (ab AppModuleBasic) GetTxCmd() *cobra.Command {
 	return cli.NewTxCmd(ab.ac)
@@ -259,6 +266,19 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	return ModuleOutputs{BankKeeper: bankKeeper, Module: m}
 }
 
+// ProvideTextualMessageRenderer returns the SIGN_MODE_TEXTUAL renderer of
+// MsgSend, which renders it as "Send <amount> to <address>".
+//
+// It changes the sign bytes of MsgSend, so it is not provided by the module:
+// apps opt in by passing it in tx.ConfigOptions.TextualCustomMessageRenderers,
+// or to depinject with depinject.Provide, on both the node and the client.
+func ProvideTextualMessageRenderer() textual.CustomMessageRenderer {
+	return textual.CustomMessageRenderer{
+		MsgType: (&bankv1beta1.MsgSend{}).ProtoReflect().Descriptor().FullName(),
+		Fn:      textual.NewMsgSendValueRenderer,
+	}
+}
+
 func InvokeSetSendRestrictions(
 	config *modulev1.Module,
 	keeper keeper.BaseKeeper,
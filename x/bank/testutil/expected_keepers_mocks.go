@@ -291,3 +291,101 @@ func (mr *MockBankHooksMockRecorder) TrackBeforeSend(ctx, from, to, amount inter
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackBeforeSend", reflect.TypeOf((*MockBankHooks)(nil).TrackBeforeSend), ctx, from, to, amount)
 }
+
+// MockMintHooks is a mock of MintHooks interface.
+type MockMintHooks struct {
+	ctrl     *gomock.Controller
+	recorder *MockMintHooksMockRecorder
+}
+
+// MockMintHooksMockRecorder is the mock recorder for MockMintHooks.
+type MockMintHooksMockRecorder struct {
+	mock *MockMintHooks
+}
+
+// NewMockMintHooks creates a new mock instance.
+func NewMockMintHooks(ctrl *gomock.Controller) *MockMintHooks {
+	mock := &MockMintHooks{ctrl: ctrl}
+	mock.recorder = &MockMintHooksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMintHooks) EXPECT() *MockMintHooksMockRecorder {
+	return m.recorder
+}
+
+// BlockBeforeMint mocks base method.
+func (m *MockMintHooks) BlockBeforeMint(ctx context.Context, minter types.AccAddress, amount types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockBeforeMint", ctx, minter, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockBeforeMint indicates an expected call of BlockBeforeMint.
+func (mr *MockMintHooksMockRecorder) BlockBeforeMint(ctx, minter, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockBeforeMint", reflect.TypeOf((*MockMintHooks)(nil).BlockBeforeMint), ctx, minter, amount)
+}
+
+// TrackBeforeMint mocks base method.
+func (m *MockMintHooks) TrackBeforeMint(ctx context.Context, minter types.AccAddress, amount types.Coins) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackBeforeMint", ctx, minter, amount)
+}
+
+// TrackBeforeMint indicates an expected call of TrackBeforeMint.
+func (mr *MockMintHooksMockRecorder) TrackBeforeMint(ctx, minter, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackBeforeMint", reflect.TypeOf((*MockMintHooks)(nil).TrackBeforeMint), ctx, minter, amount)
+}
+
+// MockBurnHooks is a mock of BurnHooks interface.
+type MockBurnHooks struct {
+	ctrl     *gomock.Controller
+	recorder *MockBurnHooksMockRecorder
+}
+
+// MockBurnHooksMockRecorder is the mock recorder for MockBurnHooks.
+type MockBurnHooksMockRecorder struct {
+	mock *MockBurnHooks
+}
+
+// NewMockBurnHooks creates a new mock instance.
+func NewMockBurnHooks(ctrl *gomock.Controller) *MockBurnHooks {
+	mock := &MockBurnHooks{ctrl: ctrl}
+	mock.recorder = &MockBurnHooksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBurnHooks) EXPECT() *MockBurnHooksMockRecorder {
+	return m.recorder
+}
+
+// BlockBeforeBurn mocks base method.
+func (m *MockBurnHooks) BlockBeforeBurn(ctx context.Context, burner types.AccAddress, amount types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockBeforeBurn", ctx, burner, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockBeforeBurn indicates an expected call of BlockBeforeBurn.
+func (mr *MockBurnHooksMockRecorder) BlockBeforeBurn(ctx, burner, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockBeforeBurn", reflect.TypeOf((*MockBurnHooks)(nil).BlockBeforeBurn), ctx, burner, amount)
+}
+
+// TrackBeforeBurn mocks base method.
+func (m *MockBurnHooks) TrackBeforeBurn(ctx context.Context, burner types.AccAddress, amount types.Coins) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackBeforeBurn", ctx, burner, amount)
+}
+
+// TrackBeforeBurn indicates an expected call of TrackBeforeBurn.
+func (mr *MockBurnHooksMockRecorder) TrackBeforeBurn(ctx, burner, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackBeforeBurn", reflect.TypeOf((*MockBurnHooks)(nil).TrackBeforeBurn), ctx, burner, amount)
+}
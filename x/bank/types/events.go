@@ -17,10 +17,16 @@ const (
 	EventTypeCoinMint     = "coinbase" // NOTE(fdymylja): using mint clashes with mint module event
 	EventTypeCoinBurn     = "burn"
 
+	// EventTypeHookError is emitted when a TrackBeforeSend hook fails without
+	// aborting the transfer.
+	EventTypeHookError = "hook_error"
+
 	AttributeKeySpender  = "spender"
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
 	AttributeKeyBurner   = "burner"
+	AttributeKeyHook     = "hook"
+	AttributeKeyError    = "error"
 )
 
 // NewCoinSpentEvent constructs a new coin spent sdk.Event
@@ -58,3 +64,12 @@ func NewCoinBurnEvent(burner sdk.AccAddress, amount sdk.Coins) sdk.Event {
 		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
 	)
 }
+
+// NewHookErrorEvent constructs a new hook error sdk.Event
+func NewHookErrorEvent(hook string, err error) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeHookError,
+		sdk.NewAttribute(AttributeKeyHook, hook),
+		sdk.NewAttribute(AttributeKeyError, err.Error()),
+	)
+}
@@ -45,3 +45,17 @@ type BankHooks interface {
 	TrackBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins)       // Must be before any send is executed
 	BlockBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) error // Must be before any send is executed
 }
+
+// MintHooks is an optional extension of BankHooks. BankHooks implementing it
+// are called by MintCoins, with the address of the minting module account.
+type MintHooks interface {
+	TrackBeforeMint(ctx context.Context, minter sdk.AccAddress, amount sdk.Coins)       // Must be before any mint is executed
+	BlockBeforeMint(ctx context.Context, minter sdk.AccAddress, amount sdk.Coins) error // Must be before any mint is executed
+}
+
+// BurnHooks is an optional extension of BankHooks. BankHooks implementing it
+// are called by BurnCoins, with the address of the burning module account.
+type BurnHooks interface {
+	TrackBeforeBurn(ctx context.Context, burner sdk.AccAddress, amount sdk.Coins)       // Must be before any burn is executed
+	BlockBeforeBurn(ctx context.Context, burner sdk.AccAddress, amount sdk.Coins) error // Must be before any burn is executed
+}
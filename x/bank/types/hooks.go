@@ -2,10 +2,102 @@ package types
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/hashicorp/go-metrics"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// NamedBankHooks is a BankHooks implementation registered in a NamedMultiBankHooks
+// under a unique name.
+type NamedBankHooks struct {
+	// Name uniquely identifies the hooks. It is used by the Hooks query and by
+	// MsgSetHookEnabled.
+	Name string
+	// Order defines the execution order of the hooks, lower orders run first.
+	// Hooks sharing the same order run in registration order.
+	Order int64
+	// GasLimit is the maximum amount of gas a single invocation of the hooks may
+	// consume. Zero means the hooks are only bounded by the transaction gas limit.
+	GasLimit uint64
+	// Denoms and DenomPrefixes scope the hooks to the transfers of matching
+	// denoms, for instance "factory/". The hooks then only receive the matching
+	// subset of the transferred coins. When both are empty, the hooks are called
+	// for every denom.
+	Denoms        []string
+	DenomPrefixes []string
+	Hooks         BankHooks
+}
+
+// IsScoped returns true if the hooks are only called for a subset of denoms.
+func (nh NamedBankHooks) IsScoped() bool {
+	return len(nh.Denoms) != 0 || len(nh.DenomPrefixes) != 0
+}
+
+// denomIndex maps denoms and denom prefixes to the positions of the scoped
+// hooks subscribed to them.
+type denomIndex struct {
+	denoms   map[string][]int
+	prefixes []denomPrefixSubscription
+}
+
+type denomPrefixSubscription struct {
+	prefix string
+	hook   int
+}
+
+func newDenomIndex(hooks []NamedBankHooks) denomIndex {
+	idx := denomIndex{denoms: make(map[string][]int)}
+	for i, h := range hooks {
+		for _, denom := range h.Denoms {
+			if subscribed := idx.denoms[denom]; len(subscribed) != 0 && subscribed[len(subscribed)-1] == i {
+				continue
+			}
+			idx.denoms[denom] = append(idx.denoms[denom], i)
+		}
+		for _, prefix := range h.DenomPrefixes {
+			idx.prefixes = append(idx.prefixes, denomPrefixSubscription{prefix: prefix, hook: i})
+		}
+	}
+	return idx
+}
+
+// split returns, for each scoped hook position, the subset of amount it is
+// subscribed to. Unscoped hook positions are left nil.
+func (idx denomIndex) split(numHooks int, amount sdk.Coins) []sdk.Coins {
+	subsets := make([]sdk.Coins, numHooks)
+	for _, coin := range amount {
+		for _, i := range idx.denoms[coin.Denom] {
+			subsets[i] = append(subsets[i], coin)
+		}
+		for _, sub := range idx.prefixes {
+			if strings.HasPrefix(coin.Denom, sub.prefix) && !containsDenom(subsets[sub.hook], coin.Denom) {
+				subsets[sub.hook] = append(subsets[sub.hook], coin)
+			}
+		}
+	}
+	return subsets
+}
+
+func containsDenom(coins sdk.Coins, denom string) bool {
+	for _, coin := range coins {
+		if coin.Denom == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// HookFilterFn reports whether the hooks registered under name must be skipped.
+type HookFilterFn func(ctx context.Context, name string) bool
+
 // MultiBankHooks combine multiple bank hooks, all hook functions are run in array sequence
 type MultiBankHooks []BankHooks
 
@@ -14,6 +106,17 @@ func NewMultiBankHooks(hooks ...BankHooks) MultiBankHooks {
 	return hooks
 }
 
+// Named returns a NamedMultiBankHooks running the hooks in array sequence.
+// Hooks are named after their position in the list, starting at "hooks_0",
+// and run without any gas limit.
+func (h MultiBankHooks) Named() NamedMultiBankHooks {
+	named := make([]NamedBankHooks, len(h))
+	for i, hooks := range h {
+		named[i] = NamedBankHooks{Name: fmt.Sprintf("hooks_%d", i), Order: int64(i), Hooks: hooks}
+	}
+	return NewNamedMultiBankHooks(named...)
+}
+
 // TrackBeforeSend runs the TrackBeforeSend hooks in order for each BankHook in a MultiBankHooks struct
 func (h MultiBankHooks) TrackBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) {
 	for i := range h {
@@ -31,3 +134,349 @@ func (h MultiBankHooks) BlockBeforeSend(ctx context.Context, from, to sdk.AccAdd
 	}
 	return nil
 }
+
+// TrackBeforeMint runs the TrackBeforeMint hooks in order for each BankHook
+// implementing MintHooks in a MultiBankHooks struct
+func (h MultiBankHooks) TrackBeforeMint(ctx context.Context, minter sdk.AccAddress, amount sdk.Coins) {
+	for i := range h {
+		if mh, ok := h[i].(MintHooks); ok {
+			mh.TrackBeforeMint(ctx, minter, amount)
+		}
+	}
+}
+
+// BlockBeforeMint runs the BlockBeforeMint hooks in order for each BankHook
+// implementing MintHooks in a MultiBankHooks struct
+func (h MultiBankHooks) BlockBeforeMint(ctx context.Context, minter sdk.AccAddress, amount sdk.Coins) error {
+	for i := range h {
+		if mh, ok := h[i].(MintHooks); ok {
+			if err := mh.BlockBeforeMint(ctx, minter, amount); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// TrackBeforeBurn runs the TrackBeforeBurn hooks in order for each BankHook
+// implementing BurnHooks in a MultiBankHooks struct
+func (h MultiBankHooks) TrackBeforeBurn(ctx context.Context, burner sdk.AccAddress, amount sdk.Coins) {
+	for i := range h {
+		if bh, ok := h[i].(BurnHooks); ok {
+			bh.TrackBeforeBurn(ctx, burner, amount)
+		}
+	}
+}
+
+// BlockBeforeBurn runs the BlockBeforeBurn hooks in order for each BankHook
+// implementing BurnHooks in a MultiBankHooks struct
+func (h MultiBankHooks) BlockBeforeBurn(ctx context.Context, burner sdk.AccAddress, amount sdk.Coins) error {
+	for i := range h {
+		if bh, ok := h[i].(BurnHooks); ok {
+			if err := bh.BlockBeforeBurn(ctx, burner, amount); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// NamedMultiBankHooks combine multiple named bank hooks, all hook functions
+// are run in ascending order of their registration order.
+//
+// Unless strict mode is enabled, each Track hook runs in a cached
+// sub-context: a hook that panics or runs out of its gas limit has its writes
+// discarded and a hook_error event emitted, but does not fail the transfer.
+type NamedMultiBankHooks struct {
+	hooks    []NamedBankHooks
+	index    denomIndex
+	scoped   bool
+	disabled HookFilterFn
+	strict   bool
+}
+
+// NewNamedMultiBankHooks takes a list of NamedBankHooks and returns a
+// NamedMultiBankHooks running them in ascending order. It panics if a name is empty
+// or registered twice, or if a scoped denom is invalid.
+func NewNamedMultiBankHooks(hooks ...NamedBankHooks) NamedMultiBankHooks {
+	seen := make(map[string]bool, len(hooks))
+	for _, h := range hooks {
+		if h.Name == "" {
+			panic("bank hooks name cannot be empty")
+		}
+		if seen[h.Name] {
+			panic(fmt.Sprintf("bank hooks %q registered twice", h.Name))
+		}
+		if h.Hooks == nil {
+			panic(fmt.Sprintf("bank hooks %q cannot be nil", h.Name))
+		}
+		for _, denom := range h.Denoms {
+			if err := sdk.ValidateDenom(denom); err != nil {
+				panic(fmt.Sprintf("bank hooks %q: %s", h.Name, err))
+			}
+		}
+		for _, prefix := range h.DenomPrefixes {
+			if prefix == "" {
+				panic(fmt.Sprintf("bank hooks %q: denom prefix cannot be empty", h.Name))
+			}
+		}
+		seen[h.Name] = true
+	}
+
+	sorted := make([]NamedBankHooks, len(hooks))
+	copy(sorted, hooks)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Order < sorted[j].Order
+	})
+
+	mh := NamedMultiBankHooks{hooks: sorted, index: newDenomIndex(sorted)}
+	for _, h := range sorted {
+		mh.scoped = mh.scoped || h.IsScoped()
+	}
+	return mh
+}
+
+// WithHookFilter returns a copy of the NamedMultiBankHooks which skips every hook for
+// which the provided filter returns true.
+func (h NamedMultiBankHooks) WithHookFilter(disabled HookFilterFn) NamedMultiBankHooks {
+	h.disabled = disabled
+	return h
+}
+
+// WithStrictTracking returns a copy of the NamedMultiBankHooks which lets a failing
+// Track hook abort the operation by panicking, instead of isolating it.
+func (h NamedMultiBankHooks) WithStrictTracking(strict bool) NamedMultiBankHooks {
+	h.strict = strict
+	return h
+}
+
+// Hooks returns the registered hooks in execution order.
+func (h NamedMultiBankHooks) Hooks() []NamedBankHooks {
+	return h.hooks
+}
+
+// Len returns the number of registered hooks.
+func (h NamedMultiBankHooks) Len() int {
+	return len(h.hooks)
+}
+
+// IsRegistered returns true if hooks are registered under the given name.
+func (h NamedMultiBankHooks) IsRegistered(name string) bool {
+	for _, nh := range h.hooks {
+		if nh.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// TrackBeforeSend runs the TrackBeforeSend hooks in order for each BankHook in a NamedMultiBankHooks struct
+func (h NamedMultiBankHooks) TrackBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) {
+	h.track(ctx, "track_before_send", amount, func(hooks BankHooks, amount sdk.Coins) func(context.Context) {
+		return func(ctx context.Context) { hooks.TrackBeforeSend(ctx, from, to, amount) }
+	})
+}
+
+// BlockBeforeSend runs the BlockBeforeSend hooks in order for each BankHook in a NamedMultiBankHooks struct
+func (h NamedMultiBankHooks) BlockBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	return h.block(ctx, "block_before_send", amount, func(hooks BankHooks, amount sdk.Coins) func(context.Context) error {
+		return func(ctx context.Context) error { return hooks.BlockBeforeSend(ctx, from, to, amount) }
+	})
+}
+
+// TrackBeforeMint runs the TrackBeforeMint hooks in order for each BankHook
+// implementing MintHooks in a NamedMultiBankHooks struct
+func (h NamedMultiBankHooks) TrackBeforeMint(ctx context.Context, minter sdk.AccAddress, amount sdk.Coins) {
+	h.track(ctx, "track_before_mint", amount, func(hooks BankHooks, amount sdk.Coins) func(context.Context) {
+		mh, ok := hooks.(MintHooks)
+		if !ok {
+			return nil
+		}
+		return func(ctx context.Context) { mh.TrackBeforeMint(ctx, minter, amount) }
+	})
+}
+
+// BlockBeforeMint runs the BlockBeforeMint hooks in order for each BankHook
+// implementing MintHooks in a NamedMultiBankHooks struct
+func (h NamedMultiBankHooks) BlockBeforeMint(ctx context.Context, minter sdk.AccAddress, amount sdk.Coins) error {
+	return h.block(ctx, "block_before_mint", amount, func(hooks BankHooks, amount sdk.Coins) func(context.Context) error {
+		mh, ok := hooks.(MintHooks)
+		if !ok {
+			return nil
+		}
+		return func(ctx context.Context) error { return mh.BlockBeforeMint(ctx, minter, amount) }
+	})
+}
+
+// TrackBeforeBurn runs the TrackBeforeBurn hooks in order for each BankHook
+// implementing BurnHooks in a NamedMultiBankHooks struct
+func (h NamedMultiBankHooks) TrackBeforeBurn(ctx context.Context, burner sdk.AccAddress, amount sdk.Coins) {
+	h.track(ctx, "track_before_burn", amount, func(hooks BankHooks, amount sdk.Coins) func(context.Context) {
+		bh, ok := hooks.(BurnHooks)
+		if !ok {
+			return nil
+		}
+		return func(ctx context.Context) { bh.TrackBeforeBurn(ctx, burner, amount) }
+	})
+}
+
+// BlockBeforeBurn runs the BlockBeforeBurn hooks in order for each BankHook
+// implementing BurnHooks in a NamedMultiBankHooks struct
+func (h NamedMultiBankHooks) BlockBeforeBurn(ctx context.Context, burner sdk.AccAddress, amount sdk.Coins) error {
+	return h.block(ctx, "block_before_burn", amount, func(hooks BankHooks, amount sdk.Coins) func(context.Context) error {
+		bh, ok := hooks.(BurnHooks)
+		if !ok {
+			return nil
+		}
+		return func(ctx context.Context) error { return bh.BlockBeforeBurn(ctx, burner, amount) }
+	})
+}
+
+// track runs, in order, the track function returned by hookFn for each enabled
+// hook subscribed to the denoms of amount. hookFn receives the subset of amount
+// the hook is subscribed to, and returns nil for hooks not supporting the
+// tracked operation.
+func (h NamedMultiBankHooks) track(ctx context.Context, method string, amount sdk.Coins, hookFn func(BankHooks, sdk.Coins) func(context.Context)) {
+	subsets := h.split(amount)
+	for i, nh := range h.hooks {
+		subset, ok := nh.subset(subsets, i, amount)
+		if !ok {
+			continue
+		}
+		fn := hookFn(nh.Hooks, subset)
+		if fn == nil || h.isDisabled(ctx, nh.Name) {
+			continue
+		}
+		nh.incrCallCounter(method)
+		track := func(ctx context.Context) error {
+			fn(ctx)
+			return nil
+		}
+		if h.strict {
+			if err := nh.runWithGasLimit(ctx, track); err != nil {
+				panic(err)
+			}
+			continue
+		}
+		nh.runIsolated(ctx, track)
+	}
+}
+
+// block runs, in order, the block function returned by hookFn for each enabled
+// hook subscribed to the denoms of amount, stopping at the first error. hookFn
+// receives the subset of amount the hook is subscribed to, and returns nil for
+// hooks not supporting the blocked operation.
+func (h NamedMultiBankHooks) block(ctx context.Context, method string, amount sdk.Coins, hookFn func(BankHooks, sdk.Coins) func(context.Context) error) error {
+	subsets := h.split(amount)
+	for i, nh := range h.hooks {
+		subset, ok := nh.subset(subsets, i, amount)
+		if !ok {
+			continue
+		}
+		fn := hookFn(nh.Hooks, subset)
+		if fn == nil || h.isDisabled(ctx, nh.Name) {
+			continue
+		}
+		nh.incrCallCounter(method)
+		if err := nh.runWithGasLimit(ctx, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// split returns the subsets of amount each scoped hook is subscribed to, or nil
+// if no hook is scoped.
+func (h NamedMultiBankHooks) split(amount sdk.Coins) []sdk.Coins {
+	if !h.scoped {
+		return nil
+	}
+	return h.index.split(len(h.hooks), amount)
+}
+
+func (h NamedMultiBankHooks) isDisabled(ctx context.Context, name string) bool {
+	return h.disabled != nil && h.disabled(ctx, name)
+}
+
+// subset returns the coins the hooks at position i must be called with, and
+// false if they must not be called at all.
+func (nh NamedBankHooks) subset(subsets []sdk.Coins, i int, amount sdk.Coins) (sdk.Coins, bool) {
+	if !nh.IsScoped() {
+		return amount, true
+	}
+	return subsets[i], len(subsets[i]) != 0
+}
+
+// incrCallCounter increments the telemetry counter of the calls to method.
+func (nh NamedBankHooks) incrCallCounter(method string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{ModuleName, "hooks", "calls"},
+		1,
+		[]metrics.Label{telemetry.NewLabel("hook", nh.Name), telemetry.NewLabel("method", method)},
+	)
+}
+
+// runIsolated runs fn in a cached sub-context, its writes and events are only
+// committed if fn succeeds. A failure is reported through a hook_error event
+// instead of being propagated, with the exception of the transaction running
+// out of gas.
+func (nh NamedBankHooks) runIsolated(ctx context.Context, fn func(ctx context.Context) error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, write := sdkCtx.CacheContext()
+
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				if _, ok := r.(storetypes.ErrorOutOfGas); ok && sdkCtx.GasMeter().IsOutOfGas() {
+					panic(r)
+				}
+				err = fmt.Errorf("panic: %v", r)
+			}
+		}()
+		return nh.runWithGasLimit(cacheCtx, fn)
+	}()
+	if err != nil {
+		sdkCtx.Logger().Error("bank hook failed", "hook", nh.Name, "err", err)
+		sdkCtx.EventManager().EmitEvent(NewHookErrorEvent(nh.Name, err))
+		return
+	}
+
+	write()
+}
+
+// runWithGasLimit runs fn with a child gas meter capped at the hook gas limit.
+// The gas consumed by fn is charged to the parent gas meter afterwards. If fn
+// exceeds the hook gas limit, ErrHookOutOfGas is returned. Running out of the
+// parent gas is not recovered.
+func (nh NamedBankHooks) runWithGasLimit(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	if nh.GasLimit == 0 {
+		return fn(ctx)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	parentMeter := sdkCtx.GasMeter()
+
+	limit := nh.GasLimit
+	cappedByParent := false
+	if remaining := parentMeter.GasRemaining(); remaining <= limit {
+		limit = remaining
+		cappedByParent = true
+	}
+
+	childMeter := storetypes.NewGasMeter(limit)
+	defer func() {
+		r := recover()
+		// charging the parent meter panics if the transaction itself is out of gas
+		parentMeter.ConsumeGas(childMeter.GasConsumedToLimit(), fmt.Sprintf("bank hooks %s", nh.Name))
+		if r == nil {
+			return
+		}
+		if oog, ok := r.(storetypes.ErrorOutOfGas); ok && !cappedByParent {
+			err = errorsmod.Wrapf(ErrHookOutOfGas, "hooks %s exceeded gas limit %d in %s", nh.Name, nh.GasLimit, oog.Descriptor)
+			return
+		}
+		panic(r)
+	}()
+
+	return fn(sdkCtx.WithGasMeter(childMeter))
+}
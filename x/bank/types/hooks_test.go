@@ -0,0 +1,264 @@
+package types_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// recordingHooks is a BankHooks implementation recording its calls and
+// consuming a fixed amount of gas on each of them.
+type recordingHooks struct {
+	name     string
+	calls    *[]string
+	gas      uint64
+	blockErr error
+}
+
+func (h recordingHooks) TrackBeforeSend(ctx context.Context, _, _ sdk.AccAddress, _ sdk.Coins) {
+	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(h.gas, "track")
+	*h.calls = append(*h.calls, "track:"+h.name)
+}
+
+func (h recordingHooks) BlockBeforeSend(ctx context.Context, _, _ sdk.AccAddress, _ sdk.Coins) error {
+	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(h.gas, "block")
+	*h.calls = append(*h.calls, "block:"+h.name)
+	return h.blockErr
+}
+
+func newHooksTestContext(t *testing.T) sdk.Context {
+	t.Helper()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	return testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+}
+
+func TestMultiBankHooksOrder(t *testing.T) {
+	ctx := newHooksTestContext(t)
+	var calls []string
+
+	hooks := types.NewNamedMultiBankHooks(
+		types.NamedBankHooks{Name: "c", Order: 10, Hooks: recordingHooks{name: "c", calls: &calls}},
+		types.NamedBankHooks{Name: "a", Order: -1, Hooks: recordingHooks{name: "a", calls: &calls}},
+		types.NamedBankHooks{Name: "b", Order: 10, Hooks: recordingHooks{name: "b", calls: &calls}},
+	)
+
+	require.NoError(t, hooks.BlockBeforeSend(ctx, nil, nil, nil))
+	hooks.TrackBeforeSend(ctx, nil, nil, nil)
+	require.Equal(t, []string{"block:a", "block:c", "block:b", "track:a", "track:c", "track:b"}, calls)
+
+	names := make([]string, 0, hooks.Len())
+	for _, h := range hooks.Hooks() {
+		names = append(names, h.Name)
+	}
+	require.Equal(t, []string{"a", "c", "b"}, names)
+	require.True(t, hooks.IsRegistered("b"))
+	require.False(t, hooks.IsRegistered("d"))
+}
+
+func TestMultiBankHooksInvalidRegistration(t *testing.T) {
+	var calls []string
+	h := recordingHooks{calls: &calls}
+
+	require.PanicsWithValue(t, `bank hooks "a" registered twice`, func() {
+		types.NewNamedMultiBankHooks(types.NamedBankHooks{Name: "a", Hooks: h}, types.NamedBankHooks{Name: "a", Hooks: h})
+	})
+	require.PanicsWithValue(t, "bank hooks name cannot be empty", func() {
+		types.NewNamedMultiBankHooks(types.NamedBankHooks{Hooks: h})
+	})
+	require.PanicsWithValue(t, `bank hooks "a" cannot be nil`, func() {
+		types.NewNamedMultiBankHooks(types.NamedBankHooks{Name: "a"})
+	})
+}
+
+func TestMultiBankHooksFilter(t *testing.T) {
+	ctx := newHooksTestContext(t)
+	var calls []string
+
+	hooks := types.NewMultiBankHooks(
+		recordingHooks{name: "first", calls: &calls},
+		recordingHooks{name: "second", calls: &calls, blockErr: errors.New("blocked")},
+	)
+	require.Error(t, hooks.BlockBeforeSend(ctx, nil, nil, nil))
+
+	calls = nil
+	named := hooks.Named().WithHookFilter(func(_ context.Context, name string) bool { return name == "hooks_1" })
+	require.NoError(t, named.BlockBeforeSend(ctx, nil, nil, nil))
+	named.TrackBeforeSend(ctx, nil, nil, nil)
+	require.Equal(t, []string{"block:first", "track:first"}, calls)
+}
+
+func TestMultiBankHooksGasLimit(t *testing.T) {
+	var calls []string
+
+	hooks := types.NewNamedMultiBankHooks(
+		types.NamedBankHooks{Name: "cheap", GasLimit: 100, Hooks: recordingHooks{name: "cheap", calls: &calls, gas: 60}},
+		types.NamedBankHooks{Name: "greedy", Order: 1, GasLimit: 100, Hooks: recordingHooks{name: "greedy", calls: &calls, gas: 150}},
+	)
+
+	// the hook exceeding its own limit fails the send, the gas it consumed up
+	// to its limit is charged to the transaction
+	ctx := newHooksTestContext(t).WithGasMeter(storetypes.NewGasMeter(1000))
+	err := hooks.BlockBeforeSend(ctx, nil, nil, nil)
+	require.ErrorIs(t, err, types.ErrHookOutOfGas)
+	require.Equal(t, uint64(160), ctx.GasMeter().GasConsumed())
+	require.Equal(t, []string{"block:cheap"}, calls)
+
+	require.PanicsWithError(t, "hooks greedy exceeded gas limit 100 in track: bank hook out of gas", func() {
+		hooks.WithStrictTracking(true).TrackBeforeSend(ctx, nil, nil, nil)
+	})
+
+	// running out of transaction gas is not turned into a hook error
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(80))
+	require.PanicsWithValue(t, storetypes.ErrorOutOfGas{Descriptor: "block"}, func() {
+		_ = hooks.BlockBeforeSend(ctx, nil, nil, nil)
+	})
+}
+
+// panickingHooks is a BankHooks implementation writing to the store before
+// panicking in TrackBeforeSend.
+type panickingHooks struct {
+	key *storetypes.KVStoreKey
+}
+
+func (h panickingHooks) TrackBeforeSend(ctx context.Context, _, _ sdk.AccAddress, _ sdk.Coins) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.KVStore(h.key).Set([]byte("panicking"), []byte{1})
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent("panicking"))
+	panic("boom")
+}
+
+func (h panickingHooks) BlockBeforeSend(context.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) error {
+	return nil
+}
+
+// writingHooks is a BankHooks implementation writing to the store in TrackBeforeSend.
+type writingHooks struct {
+	key *storetypes.KVStoreKey
+}
+
+func (h writingHooks) TrackBeforeSend(ctx context.Context, _, _ sdk.AccAddress, _ sdk.Coins) {
+	sdk.UnwrapSDKContext(ctx).KVStore(h.key).Set([]byte("writing"), []byte{1})
+}
+
+func (h writingHooks) BlockBeforeSend(context.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) error {
+	return nil
+}
+
+func TestMultiBankHooksTrackIsolation(t *testing.T) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	hooks := types.NewNamedMultiBankHooks(
+		types.NamedBankHooks{Name: "panicking", Hooks: panickingHooks{key: key}},
+		types.NamedBankHooks{Name: "writing", Order: 1, Hooks: writingHooks{key: key}},
+	)
+
+	// the panicking hook writes and events are discarded, the following hooks still run
+	require.NotPanics(t, func() { hooks.TrackBeforeSend(ctx, nil, nil, nil) })
+	require.False(t, ctx.KVStore(key).Has([]byte("panicking")))
+	require.True(t, ctx.KVStore(key).Has([]byte("writing")))
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeHookError, events[0].Type)
+	hook, ok := events[0].GetAttribute(types.AttributeKeyHook)
+	require.True(t, ok)
+	require.Equal(t, "panicking", hook.Value)
+	errAttr, ok := events[0].GetAttribute(types.AttributeKeyError)
+	require.True(t, ok)
+	require.Equal(t, "panic: boom", errAttr.Value)
+
+	// strict mode lets the panic abort the transfer
+	require.PanicsWithValue(t, "boom", func() { hooks.WithStrictTracking(true).TrackBeforeSend(ctx, nil, nil, nil) })
+}
+
+// supplyHooks is a BankHooks implementation also implementing MintHooks and BurnHooks.
+type supplyHooks struct {
+	recordingHooks
+}
+
+func (h supplyHooks) TrackBeforeMint(_ context.Context, _ sdk.AccAddress, _ sdk.Coins) {
+	*h.calls = append(*h.calls, "track_mint:"+h.name)
+}
+
+func (h supplyHooks) BlockBeforeMint(_ context.Context, _ sdk.AccAddress, _ sdk.Coins) error {
+	*h.calls = append(*h.calls, "block_mint:"+h.name)
+	return h.blockErr
+}
+
+func (h supplyHooks) TrackBeforeBurn(_ context.Context, _ sdk.AccAddress, _ sdk.Coins) {
+	*h.calls = append(*h.calls, "track_burn:"+h.name)
+}
+
+func (h supplyHooks) BlockBeforeBurn(_ context.Context, _ sdk.AccAddress, _ sdk.Coins) error {
+	*h.calls = append(*h.calls, "block_burn:"+h.name)
+	return h.blockErr
+}
+
+func TestMultiBankHooksMintBurn(t *testing.T) {
+	ctx := newHooksTestContext(t)
+	var calls []string
+
+	hooks := types.NewMultiBankHooks(
+		recordingHooks{name: "send_only", calls: &calls},
+		supplyHooks{recordingHooks{name: "supply", calls: &calls}},
+	)
+
+	require.NoError(t, hooks.BlockBeforeMint(ctx, nil, nil))
+	hooks.TrackBeforeMint(ctx, nil, nil)
+	require.NoError(t, hooks.BlockBeforeBurn(ctx, nil, nil))
+	hooks.TrackBeforeBurn(ctx, nil, nil)
+	require.Equal(t, []string{"block_mint:supply", "track_mint:supply", "block_burn:supply", "track_burn:supply"}, calls)
+
+	hooks = types.NewMultiBankHooks(supplyHooks{recordingHooks{name: "supply", calls: &calls, blockErr: errors.New("blocked")}})
+	require.EqualError(t, hooks.BlockBeforeMint(ctx, nil, nil), "blocked")
+	require.EqualError(t, hooks.BlockBeforeBurn(ctx, nil, nil), "blocked")
+}
+
+// amountHooks is a BankHooks implementation recording the amounts it is called with.
+type amountHooks struct {
+	amounts *[]sdk.Coins
+}
+
+func (h amountHooks) TrackBeforeSend(_ context.Context, _, _ sdk.AccAddress, amount sdk.Coins) {
+	*h.amounts = append(*h.amounts, amount)
+}
+
+func (h amountHooks) BlockBeforeSend(_ context.Context, _, _ sdk.AccAddress, amount sdk.Coins) error {
+	*h.amounts = append(*h.amounts, amount)
+	return nil
+}
+
+func TestMultiBankHooksDenomScope(t *testing.T) {
+	ctx := newHooksTestContext(t)
+	var all, factory, atom []sdk.Coins
+
+	hooks := types.NewNamedMultiBankHooks(
+		types.NamedBankHooks{Name: "all", Hooks: amountHooks{amounts: &all}},
+		types.NamedBankHooks{Name: "factory", DenomPrefixes: []string{"factory/"}, Denoms: []string{"factory/a"}, Hooks: amountHooks{amounts: &factory}},
+		types.NamedBankHooks{Name: "atom", Denoms: []string{"atom", "atom"}, Hooks: amountHooks{amounts: &atom}},
+	)
+
+	mixed := sdk.NewCoins(sdk.NewInt64Coin("atom", 1), sdk.NewInt64Coin("factory/a", 2), sdk.NewInt64Coin("factory/b", 3), sdk.NewInt64Coin("stake", 4))
+	require.NoError(t, hooks.BlockBeforeSend(ctx, nil, nil, mixed))
+	hooks.TrackBeforeSend(ctx, nil, nil, sdk.NewCoins(sdk.NewInt64Coin("stake", 5)))
+
+	require.Equal(t, []sdk.Coins{mixed, sdk.NewCoins(sdk.NewInt64Coin("stake", 5))}, all)
+	require.Equal(t, []sdk.Coins{sdk.NewCoins(sdk.NewInt64Coin("factory/a", 2), sdk.NewInt64Coin("factory/b", 3))}, factory)
+	require.Equal(t, []sdk.Coins{sdk.NewCoins(sdk.NewInt64Coin("atom", 1))}, atom)
+
+	require.Panics(t, func() {
+		types.NewNamedMultiBankHooks(types.NamedBankHooks{Name: "invalid", Denoms: []string{"!"}, Hooks: amountHooks{amounts: &all}})
+	})
+	require.Panics(t, func() {
+		types.NewNamedMultiBankHooks(types.NamedBankHooks{Name: "invalid", DenomPrefixes: []string{""}, Hooks: amountHooks{amounts: &all}})
+	})
+}
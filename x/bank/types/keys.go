@@ -32,6 +32,10 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = collections.NewPrefix(5)
+
+	// HookTogglesPrefix is the prefix for the bank hooks enabled or disabled
+	// through MsgSetHookEnabled.
+	HookTogglesPrefix = collections.NewPrefix(6)
 )
 
 // BalanceValueCodec is a codec for encoding bank balances in a backwards compatible way.
@@ -44,3 +48,7 @@ var BalanceValueCodec = collcodec.NewAltValueCodec(sdk.IntValue, func(bytes []by
 	}
 	return c.Amount, nil
 })
+
+// HookToggleValueCodec is the codec of the bank hook toggles, pairing the height
+// from which a toggle applies with whether it enables the hook.
+var HookToggleValueCodec = collcodec.KeyToValueCodec(collections.PairKeyCodec(collections.Int64Key, collections.BoolKey))
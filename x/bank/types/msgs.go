@@ -8,6 +8,7 @@ var (
 	_ sdk.Msg = &MsgSend{}
 	_ sdk.Msg = &MsgMultiSend{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetHookEnabled{}
 )
 
 // NewMsgSend - construct a msg to send coins from one account to another.
@@ -28,3 +29,12 @@ func NewMsgSetSendEnabled(authority string, sendEnabled []*SendEnabled, useDefau
 		UseDefaultFor: useDefaultFor,
 	}
 }
+
+// NewMsgSetHookEnabled Construct a message to enable or disable a registered bank hook.
+func NewMsgSetHookEnabled(authority, name string, enabled bool) *MsgSetHookEnabled {
+	return &MsgSetHookEnabled{
+		Authority: authority,
+		Name:      name,
+		Enabled:   enabled,
+	}
+}
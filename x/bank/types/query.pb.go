@@ -1387,6 +1387,182 @@ func (m *QuerySendEnabledResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryHooksRequest defines the RPC request for listing the registered bank hooks.
+type QueryHooksRequest struct {
+}
+
+func (m *QueryHooksRequest) Reset()         { *m = QueryHooksRequest{} }
+func (m *QueryHooksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHooksRequest) ProtoMessage()    {}
+func (*QueryHooksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{27}
+}
+func (m *QueryHooksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHooksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHooksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHooksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHooksRequest.Merge(m, src)
+}
+func (m *QueryHooksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHooksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHooksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHooksRequest proto.InternalMessageInfo
+
+// QueryHooksResponse defines the RPC response of a Hooks query.
+type QueryHooksResponse struct {
+	// hooks is the list of registered bank hooks, in execution order.
+	Hooks []HookInfo `protobuf:"bytes,1,rep,name=hooks,proto3" json:"hooks"`
+}
+
+func (m *QueryHooksResponse) Reset()         { *m = QueryHooksResponse{} }
+func (m *QueryHooksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHooksResponse) ProtoMessage()    {}
+func (*QueryHooksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{28}
+}
+func (m *QueryHooksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHooksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHooksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHooksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHooksResponse.Merge(m, src)
+}
+func (m *QueryHooksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHooksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHooksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHooksResponse proto.InternalMessageInfo
+
+func (m *QueryHooksResponse) GetHooks() []HookInfo {
+	if m != nil {
+		return m.Hooks
+	}
+	return nil
+}
+
+// HookInfo describes a bank hook registered with the keeper.
+type HookInfo struct {
+	// name is the unique name the hook was registered under.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// order defines the execution order of the hook, lower orders run first.
+	Order int64 `protobuf:"varint,2,opt,name=order,proto3" json:"order,omitempty"`
+	// gas_limit is the maximum amount of gas a single invocation of the hook may
+	// consume. Zero means the hook is only bounded by the transaction gas limit.
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// enabled is false when the hook has been disabled through MsgSetHookEnabled.
+	Enabled bool `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// denoms is the list of denoms the hook is scoped to.
+	Denoms []string `protobuf:"bytes,5,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	// denom_prefixes is the list of denom prefixes the hook is scoped to. When both
+	// denoms and denom_prefixes are empty, the hook is called for every denom.
+	DenomPrefixes []string `protobuf:"bytes,6,rep,name=denom_prefixes,json=denomPrefixes,proto3" json:"denom_prefixes,omitempty"`
+}
+
+func (m *HookInfo) Reset()         { *m = HookInfo{} }
+func (m *HookInfo) String() string { return proto.CompactTextString(m) }
+func (*HookInfo) ProtoMessage()    {}
+func (*HookInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{29}
+}
+func (m *HookInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HookInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HookInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HookInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HookInfo.Merge(m, src)
+}
+func (m *HookInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *HookInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_HookInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HookInfo proto.InternalMessageInfo
+
+func (m *HookInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *HookInfo) GetOrder() int64 {
+	if m != nil {
+		return m.Order
+	}
+	return 0
+}
+
+func (m *HookInfo) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *HookInfo) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *HookInfo) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func (m *HookInfo) GetDenomPrefixes() []string {
+	if m != nil {
+		return m.DenomPrefixes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.bank.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.bank.v1beta1.QueryBalanceResponse")
@@ -1415,96 +1591,108 @@ func init() {
 	proto.RegisterType((*QueryDenomOwnersByQueryResponse)(nil), "cosmos.bank.v1beta1.QueryDenomOwnersByQueryResponse")
 	proto.RegisterType((*QuerySendEnabledRequest)(nil), "cosmos.bank.v1beta1.QuerySendEnabledRequest")
 	proto.RegisterType((*QuerySendEnabledResponse)(nil), "cosmos.bank.v1beta1.QuerySendEnabledResponse")
+	proto.RegisterType((*QueryHooksRequest)(nil), "cosmos.bank.v1beta1.QueryHooksRequest")
+	proto.RegisterType((*QueryHooksResponse)(nil), "cosmos.bank.v1beta1.QueryHooksResponse")
+	proto.RegisterType((*HookInfo)(nil), "cosmos.bank.v1beta1.HookInfo")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/query.proto", fileDescriptor_9c6fc1939682df13) }
 
 var fileDescriptor_9c6fc1939682df13 = []byte{
-	// 1336 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xa4, 0xaa, 0x93, 0x3c, 0xa7, 0x48, 0x99, 0x06, 0x9a, 0x6c, 0x88, 0x1d, 0x36, 0x55,
-	0xe2, 0x84, 0x64, 0xb7, 0x71, 0xa2, 0x42, 0x4b, 0x88, 0x14, 0xa7, 0xa4, 0x07, 0x84, 0x5a, 0x1c,
-	0x7a, 0x81, 0x83, 0xb5, 0xf6, 0x0e, 0xc6, 0x8a, 0xbd, 0xeb, 0x7a, 0x36, 0x2d, 0xab, 0x2a, 0x08,
-	0x21, 0x21, 0xf5, 0x88, 0x44, 0x4f, 0x95, 0x90, 0x22, 0x24, 0xa0, 0x02, 0xa9, 0xea, 0x81, 0x03,
-	0x07, 0x8e, 0x1c, 0x2a, 0x4e, 0x15, 0x1c, 0x40, 0x1c, 0x0a, 0x4a, 0x90, 0xda, 0x9f, 0x81, 0x3c,
-	0x33, 0xeb, 0xdd, 0xb5, 0xd7, 0xeb, 0x8d, 0x6b, 0xaa, 0xaa, 0x97, 0xc4, 0x9e, 0x79, 0x6f, 0xde,
-	0xf7, 0xbe, 0x79, 0xf3, 0xe6, 0x1b, 0x43, 0xaa, 0x68, 0xd2, 0xaa, 0x49, 0xd5, 0x82, 0x66, 0xec,
-	0xa8, 0xd7, 0x96, 0x0b, 0xc4, 0xd2, 0x96, 0xd5, 0xab, 0xbb, 0xa4, 0x6e, 0x2b, 0xb5, 0xba, 0x69,
-	0x99, 0xf8, 0x24, 0x37, 0x50, 0x1a, 0x06, 0x8a, 0x30, 0x90, 0x16, 0x9a, 0x5e, 0x94, 0x70, 0xeb,
-	0xa6, 0x6f, 0x4d, 0x2b, 0x95, 0x0d, 0xcd, 0x2a, 0x9b, 0x06, 0x5f, 0x40, 0x1a, 0x2b, 0x99, 0x25,
-	0x93, 0x7d, 0x54, 0x1b, 0x9f, 0xc4, 0xe8, 0xcb, 0x25, 0xd3, 0x2c, 0x55, 0x88, 0xaa, 0xd5, 0xca,
-	0xaa, 0x66, 0x18, 0xa6, 0xc5, 0x5c, 0xa8, 0x98, 0x4d, 0x7a, 0xd7, 0x77, 0x56, 0x2e, 0x9a, 0x65,
-	0xa3, 0x6d, 0xde, 0x83, 0x9a, 0x21, 0xe4, 0xf3, 0x13, 0x7c, 0x3e, 0xcf, 0xc3, 0x8a, 0x0c, 0xf8,
-	0xd4, 0xa4, 0x70, 0x75, 0x50, 0x7b, 0x93, 0x95, 0x46, 0xb5, 0x6a, 0xd9, 0x30, 0x55, 0xf6, 0x97,
-	0x0f, 0xc9, 0x65, 0x38, 0xf9, 0x6e, 0xc3, 0x22, 0xab, 0x55, 0x34, 0xa3, 0x48, 0x72, 0xe4, 0xea,
-	0x2e, 0xa1, 0x16, 0xce, 0xc0, 0xa0, 0xa6, 0xeb, 0x75, 0x42, 0xe9, 0x38, 0x9a, 0x46, 0xe9, 0xe1,
-	0xec, 0xf8, 0x6f, 0x3f, 0x2e, 0x8d, 0x89, 0x48, 0x1b, 0x7c, 0x66, 0xdb, 0xaa, 0x97, 0x8d, 0x52,
-	0xce, 0x31, 0xc4, 0x63, 0x70, 0x5c, 0x27, 0x86, 0x59, 0x1d, 0x1f, 0x68, 0x78, 0xe4, 0xf8, 0x97,
-	0xf3, 0x43, 0x37, 0xf7, 0x53, 0xb1, 0xc7, 0xfb, 0xa9, 0x98, 0xfc, 0x36, 0x8c, 0xf9, 0x43, 0xd1,
-	0x9a, 0x69, 0x50, 0x82, 0x57, 0x60, 0xb0, 0xc0, 0x87, 0x58, 0xac, 0x44, 0x66, 0x42, 0x69, 0x6e,
-	0x0a, 0x25, 0xce, 0xa6, 0x28, 0x9b, 0x66, 0xd9, 0xc8, 0x39, 0x96, 0xf2, 0x2f, 0x08, 0x4e, 0xb1,
-	0xd5, 0x36, 0x2a, 0x15, 0xb1, 0x20, 0x7d, 0x12, 0xf0, 0x5b, 0x00, 0xee, 0xd6, 0xb2, 0x0c, 0x12,
-	0x99, 0x59, 0x1f, 0x0e, 0x4e, 0xa4, 0x83, 0xe6, 0xb2, 0x56, 0x72, 0xc8, 0xca, 0x79, 0x3c, 0xf1,
-	0x0c, 0x9c, 0xa8, 0x13, 0x6a, 0x56, 0xae, 0x91, 0x3c, 0x27, 0xe3, 0xd8, 0x34, 0x4a, 0x0f, 0xe5,
-	0x46, 0xc4, 0xe0, 0x85, 0x16, 0x4e, 0x0e, 0x10, 0x8c, 0xb7, 0xa7, 0x21, 0x88, 0xd9, 0x83, 0x21,
-	0x91, 0x6e, 0x23, 0x91, 0x63, 0xa1, 0xcc, 0x64, 0xb7, 0xee, 0x3f, 0x4c, 0xc5, 0xbe, 0xff, 0x3b,
-	0x95, 0x2e, 0x95, 0xad, 0x8f, 0x76, 0x0b, 0x4a, 0xd1, 0xac, 0x8a, 0xca, 0x10, 0xff, 0x96, 0xa8,
-	0xbe, 0xa3, 0x5a, 0x76, 0x8d, 0x50, 0xe6, 0x40, 0x6f, 0x3f, 0xba, 0xb7, 0x30, 0x52, 0x21, 0x25,
-	0xad, 0x68, 0xe7, 0x1b, 0xb5, 0x47, 0xef, 0x3c, 0xba, 0xb7, 0x80, 0x72, 0xcd, 0x90, 0xf8, 0x62,
-	0x00, 0x25, 0x73, 0x5d, 0x29, 0xe1, 0xd8, 0xbd, 0x9c, 0xc8, 0xdf, 0x20, 0x98, 0x62, 0x49, 0x6e,
-	0xd7, 0x88, 0xa1, 0x6b, 0x85, 0x0a, 0x79, 0x86, 0x76, 0xcc, 0xb3, 0x19, 0x8f, 0x11, 0x24, 0x3b,
-	0xe1, 0x7c, 0xce, 0xb6, 0xc4, 0x86, 0x99, 0xc0, 0x4c, 0xb3, 0x36, 0xab, 0xd0, 0xff, 0xb3, 0x0d,
-	0x7c, 0x00, 0xa7, 0xc3, 0x43, 0x3f, 0x49, 0x5b, 0xd8, 0x11, 0x5d, 0xe1, 0x3d, 0xd3, 0xd2, 0x2a,
-	0xdb, 0xbb, 0xb5, 0x5a, 0xc5, 0x76, 0x72, 0xf1, 0xd7, 0x0b, 0xea, 0x43, 0xbd, 0x3c, 0x74, 0x0e,
-	0xaf, 0x2f, 0x9a, 0x80, 0x6f, 0x43, 0x9c, 0xb2, 0x91, 0xa7, 0x57, 0x27, 0x22, 0x60, 0xff, 0xaa,
-	0x64, 0x51, 0x74, 0x6c, 0x9e, 0xda, 0xa5, 0x0f, 0x1d, 0x2a, 0x9b, 0x5b, 0x8c, 0x3c, 0x5b, 0x2c,
-	0x5f, 0x81, 0x17, 0x5b, 0xac, 0x05, 0x15, 0x6b, 0x10, 0xd7, 0xaa, 0xe6, 0xae, 0x61, 0x75, 0xdd,
-	0xc8, 0xec, 0x70, 0x83, 0x0a, 0x91, 0x0d, 0xf7, 0x91, 0xc7, 0x00, 0xb3, 0x65, 0x2f, 0x6b, 0x75,
-	0xad, 0xea, 0x74, 0x0c, 0xf9, 0x8a, 0xb8, 0xb7, 0x9c, 0x51, 0x11, 0x6a, 0x1d, 0xe2, 0x35, 0x36,
-	0x22, 0x42, 0x4d, 0x2a, 0x01, 0xf7, 0xbb, 0xc2, 0x9d, 0x7c, 0xc1, 0xb8, 0x97, 0xac, 0x83, 0xc4,
-	0x96, 0x65, 0xa5, 0x48, 0xdf, 0x21, 0x96, 0xa6, 0x6b, 0x96, 0xd6, 0xe7, 0x12, 0x92, 0xef, 0x22,
-	0x98, 0x0c, 0x0c, 0x23, 0xb2, 0xd8, 0x82, 0xe1, 0xaa, 0x18, 0x73, 0xda, 0xcc, 0x54, 0x60, 0x22,
-	0x8e, 0xa7, 0x37, 0x15, 0xd7, 0xb5, 0x7f, 0x85, 0xb0, 0x0c, 0x13, 0x2e, 0xde, 0x56, 0x56, 0x82,
-	0xab, 0xa1, 0xe0, 0x65, 0xb2, 0x2d, 0xc3, 0x0b, 0x30, 0xe4, 0xc0, 0x14, 0x3c, 0x46, 0x4f, 0xb0,
-	0xe9, 0x29, 0xaf, 0xc3, 0x6c, 0x7b, 0x8c, 0xac, 0xcd, 0xab, 0x90, 0xb7, 0xa5, 0x50, 0x8c, 0x26,
-	0xcc, 0x75, 0xf5, 0xef, 0x2b, 0xe0, 0xeb, 0xa2, 0x3d, 0xb1, 0x80, 0x97, 0xae, 0x1b, 0xa4, 0x4e,
-	0x43, 0x11, 0xf6, 0xeb, 0x92, 0x93, 0x3f, 0x45, 0x00, 0x6e, 0xd0, 0x9e, 0xfa, 0xfa, 0xba, 0xdb,
-	0x8f, 0x07, 0x8e, 0x70, 0x8c, 0x9b, 0xad, 0xf9, 0x3b, 0xa7, 0x5b, 0xfa, 0x92, 0x17, 0xf4, 0x66,
-	0x61, 0x84, 0x25, 0x9c, 0x37, 0xd9, 0xb8, 0x28, 0xfa, 0x54, 0x20, 0xc5, 0xae, 0x7f, 0x2e, 0xa1,
-	0xbb, 0x6b, 0xf5, 0xaf, 0xda, 0x3f, 0x11, 0x32, 0xc0, 0x03, 0x54, 0x14, 0xc5, 0xd3, 0xd9, 0xac,
-	0xbb, 0x08, 0x52, 0x1d, 0x01, 0x3c, 0x8b, 0x84, 0xd9, 0xa2, 0xac, 0xb7, 0x89, 0xa1, 0xbf, 0x65,
-	0x34, 0xee, 0x74, 0xdd, 0x61, 0xea, 0x25, 0x88, 0xb3, 0x90, 0x1c, 0xe1, 0x70, 0x4e, 0x7c, 0x6b,
-	0xe1, 0xaa, 0xd8, 0x33, 0x57, 0x77, 0x9c, 0xaa, 0xf2, 0xc5, 0x16, 0x24, 0x6d, 0xc2, 0x08, 0x25,
-	0x86, 0x9e, 0x27, 0x7c, 0x5c, 0x90, 0x34, 0x1d, 0x48, 0x92, 0xd7, 0x3f, 0x41, 0xdd, 0x2f, 0x2d,
-	0x2c, 0x15, 0x7b, 0x66, 0x29, 0xf3, 0xeb, 0x28, 0x1c, 0x67, 0x50, 0xf1, 0x57, 0x08, 0x06, 0x85,
-	0xea, 0xc1, 0xe9, 0x40, 0x34, 0x01, 0x6f, 0x32, 0x69, 0x3e, 0x82, 0x25, 0x0f, 0x2b, 0xbf, 0x79,
-	0xb3, 0x71, 0xf6, 0x3e, 0xfb, 0xfd, 0xdf, 0x2f, 0x07, 0x32, 0xf8, 0x8c, 0x1a, 0xfc, 0x9c, 0xe4,
-	0x9a, 0x52, 0xbd, 0x21, 0x0e, 0xf8, 0x9e, 0x5a, 0xb0, 0xf9, 0x9b, 0x05, 0xef, 0x23, 0x48, 0x78,
-	0x1e, 0x24, 0x78, 0xb1, 0x73, 0xe4, 0xf6, 0xe7, 0x97, 0xb4, 0x14, 0xd1, 0x5a, 0x60, 0x5d, 0x75,
-	0xb1, 0xce, 0xe3, 0xb9, 0x88, 0x58, 0xf1, 0xcf, 0x08, 0x46, 0xdb, 0x64, 0x3a, 0xce, 0x74, 0x0e,
-	0xdd, 0xe9, 0xed, 0x21, 0xad, 0x1c, 0xc9, 0x47, 0x80, 0x5e, 0x77, 0x41, 0xaf, 0xe0, 0xe5, 0x40,
-	0xd0, 0xd4, 0x71, 0xce, 0x07, 0xc0, 0xff, 0x03, 0xc1, 0xa9, 0x0e, 0x02, 0x18, 0xbf, 0x1e, 0x1d,
-	0x90, 0x5f, 0xae, 0x4b, 0xe7, 0x7a, 0xf0, 0x14, 0x09, 0x5d, 0x74, 0x13, 0x5a, 0xc3, 0xe7, 0x8f,
-	0x9c, 0x90, 0x5b, 0x3b, 0xb7, 0x10, 0x24, 0x3c, 0x7a, 0x38, 0xac, 0x76, 0xda, 0x45, 0x7a, 0x58,
-	0xed, 0x04, 0x88, 0x6c, 0x39, 0xed, 0xa2, 0x9e, 0xc2, 0x93, 0xc1, 0xa8, 0x39, 0x8c, 0x5b, 0x08,
-	0x86, 0x1c, 0x61, 0x8a, 0x43, 0x4e, 0x52, 0x8b, 0xd4, 0x95, 0x16, 0xa2, 0x98, 0x0a, 0x34, 0xcb,
-	0x2e, 0x9a, 0x59, 0x7c, 0x3a, 0x04, 0x8d, 0xcb, 0xd6, 0xe7, 0x08, 0xe2, 0x5c, 0x8d, 0xe2, 0xb9,
-	0xce, 0x91, 0x7c, 0xd2, 0x57, 0x4a, 0x77, 0x37, 0x8c, 0x4e, 0x0f, 0xd7, 0xbd, 0xf8, 0x07, 0x04,
-	0x27, 0x7c, 0x2a, 0x08, 0x2b, 0x9d, 0xa3, 0x04, 0xa9, 0x40, 0x49, 0x8d, 0x6c, 0x2f, 0xc0, 0x9d,
-	0x73, 0xc1, 0x29, 0x78, 0x31, 0x10, 0x1c, 0xbf, 0x2b, 0xf2, 0x8e, 0x7c, 0x52, 0x6f, 0xb0, 0x81,
-	0x3d, 0xfc, 0x17, 0x02, 0xa9, 0xb3, 0x66, 0xc3, 0x6f, 0x44, 0x84, 0x12, 0xa4, 0x14, 0xa5, 0xb5,
-	0xde, 0x9c, 0x45, 0x52, 0x1b, 0x6e, 0x52, 0x67, 0xf1, 0x6a, 0x94, 0xa4, 0xf2, 0x05, 0x3b, 0xcf,
-	0x2e, 0x90, 0x3c, 0xe5, 0xe8, 0xbf, 0x45, 0xf0, 0x82, 0xff, 0x5d, 0x80, 0xbb, 0x71, 0xdb, 0xfa,
-	0x50, 0x91, 0xce, 0x44, 0x77, 0x88, 0x5e, 0xbb, 0x2d, 0xc0, 0xf1, 0xd7, 0x08, 0x12, 0x1e, 0x85,
-	0x12, 0x76, 0xd2, 0xdb, 0xf5, 0x6e, 0xd8, 0x49, 0x0f, 0x10, 0x88, 0xf2, 0x59, 0x17, 0xdf, 0xab,
-	0x78, 0xbe, 0x33, 0x3e, 0xa1, 0x87, 0x9a, 0xa5, 0xf2, 0x13, 0x02, 0xdc, 0x2e, 0xa3, 0xf0, 0x4a,
-	0xa4, 0xe8, 0x7e, 0xd5, 0x27, 0xad, 0x1e, 0xcd, 0x49, 0x20, 0x7f, 0xcd, 0x45, 0xbe, 0x88, 0x17,
-	0xba, 0x22, 0x6f, 0xd6, 0x03, 0xbe, 0x8d, 0x20, 0xe1, 0x51, 0x25, 0x61, 0xfc, 0xb6, 0x0b, 0xaf,
-	0x30, 0x7e, 0x03, 0xa4, 0x92, 0xac, 0xb8, 0x28, 0x67, 0xf0, 0x2b, 0xc1, 0xbd, 0xcb, 0x23, 0xa5,
-	0xb2, 0x9b, 0xf7, 0x0f, 0x92, 0xe8, 0xc1, 0x41, 0x12, 0xfd, 0x73, 0x90, 0x44, 0x5f, 0x1c, 0x26,
-	0x63, 0x0f, 0x0e, 0x93, 0xb1, 0x3f, 0x0f, 0x93, 0xb1, 0xf7, 0xe7, 0x43, 0x7f, 0xc5, 0xf8, 0x98,
-	0xaf, 0xc9, 0x7e, 0xcc, 0x28, 0xc4, 0xd9, 0x6f, 0xd0, 0x2b, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff,
-	0x46, 0x5f, 0xc6, 0x98, 0xa6, 0x17, 0x00, 0x00,
+	// 1482 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0xcf, 0x00, 0x71, 0x9c, 0xe7, 0x80, 0xc4, 0x24, 0xdf, 0x2f, 0x61, 0x03, 0x36, 0x5d, 0x68,
+	0x7e, 0x35, 0xf1, 0x92, 0x04, 0xd1, 0x42, 0x69, 0x24, 0x0c, 0x85, 0x56, 0x6d, 0x05, 0x75, 0xe0,
+	0xd2, 0x1e, 0xac, 0xb5, 0x3d, 0x18, 0x2b, 0xf6, 0x8e, 0xf1, 0x6c, 0x00, 0x0b, 0x51, 0xb5, 0x95,
+	0x2a, 0x71, 0xac, 0x54, 0x4e, 0x48, 0x95, 0x50, 0xa5, 0xb6, 0x88, 0x4a, 0x88, 0x43, 0x0f, 0x3d,
+	0xf4, 0xd8, 0x03, 0xa7, 0x0a, 0xb5, 0x87, 0x56, 0x3d, 0xd0, 0x2a, 0x54, 0x82, 0x3f, 0xa3, 0xda,
+	0x99, 0xb7, 0xde, 0x5d, 0x7b, 0x6d, 0x6f, 0x82, 0x8b, 0x50, 0x2f, 0xb0, 0xfb, 0xe6, 0xbd, 0x79,
+	0x9f, 0xf7, 0x99, 0xb7, 0x6f, 0xde, 0x73, 0x20, 0x55, 0xe0, 0xa2, 0xca, 0x85, 0x91, 0x37, 0xad,
+	0x55, 0xe3, 0xf2, 0x42, 0x9e, 0xd9, 0xe6, 0x82, 0x71, 0x69, 0x8d, 0xd5, 0x1b, 0xe9, 0x5a, 0x9d,
+	0xdb, 0x9c, 0x8e, 0x2a, 0x85, 0xb4, 0xa3, 0x90, 0x46, 0x05, 0x6d, 0xb6, 0x69, 0x25, 0x98, 0xd2,
+	0x6e, 0xda, 0xd6, 0xcc, 0x52, 0xd9, 0x32, 0xed, 0x32, 0xb7, 0xd4, 0x06, 0xda, 0x58, 0x89, 0x97,
+	0xb8, 0x7c, 0x34, 0x9c, 0x27, 0x94, 0xee, 0x29, 0x71, 0x5e, 0xaa, 0x30, 0xc3, 0xac, 0x95, 0x0d,
+	0xd3, 0xb2, 0xb8, 0x2d, 0x4d, 0x04, 0xae, 0x26, 0xfd, 0xfb, 0xbb, 0x3b, 0x17, 0x78, 0xd9, 0x6a,
+	0x5b, 0xf7, 0xa1, 0x96, 0x08, 0xd5, 0xfa, 0x6e, 0xb5, 0x9e, 0x53, 0x6e, 0x31, 0x02, 0xb5, 0x34,
+	0x81, 0xa6, 0x2e, 0x6a, 0x7f, 0xb0, 0xda, 0x4e, 0xb3, 0x5a, 0xb6, 0xb8, 0x21, 0xff, 0x55, 0x22,
+	0xbd, 0x0c, 0xa3, 0xef, 0x3b, 0x1a, 0x19, 0xb3, 0x62, 0x5a, 0x05, 0x96, 0x65, 0x97, 0xd6, 0x98,
+	0xb0, 0xe9, 0x22, 0x0c, 0x99, 0xc5, 0x62, 0x9d, 0x09, 0x31, 0x4e, 0xf6, 0x91, 0xe9, 0xe1, 0xcc,
+	0xf8, 0x2f, 0xdf, 0xcf, 0x8f, 0xa1, 0xa7, 0xe3, 0x6a, 0x65, 0xc5, 0xae, 0x97, 0xad, 0x52, 0xd6,
+	0x55, 0xa4, 0x63, 0x30, 0x58, 0x64, 0x16, 0xaf, 0x8e, 0x6f, 0x71, 0x2c, 0xb2, 0xea, 0xe5, 0x68,
+	0xfc, 0xc6, 0xed, 0xd4, 0xc0, 0xd3, 0xdb, 0xa9, 0x01, 0xfd, 0x1d, 0x18, 0x0b, 0xba, 0x12, 0x35,
+	0x6e, 0x09, 0x46, 0x97, 0x60, 0x28, 0xaf, 0x44, 0xd2, 0x57, 0x62, 0x71, 0x77, 0xba, 0x79, 0x28,
+	0x82, 0xb9, 0x87, 0x92, 0x3e, 0xc1, 0xcb, 0x56, 0xd6, 0xd5, 0xd4, 0x7f, 0x22, 0xb0, 0x4b, 0xee,
+	0x76, 0xbc, 0x52, 0xc1, 0x0d, 0xc5, 0xb3, 0x80, 0x3f, 0x05, 0xe0, 0x1d, 0xad, 0x8c, 0x20, 0xb1,
+	0x38, 0x19, 0xc0, 0xa1, 0x88, 0x74, 0xd1, 0x9c, 0x35, 0x4b, 0x2e, 0x59, 0x59, 0x9f, 0x25, 0xdd,
+	0x0f, 0xdb, 0xeb, 0x4c, 0xf0, 0xca, 0x65, 0x96, 0x53, 0x64, 0x6c, 0xdd, 0x47, 0xa6, 0xe3, 0xd9,
+	0x11, 0x14, 0x9e, 0x6c, 0xe1, 0x64, 0x9d, 0xc0, 0x78, 0x7b, 0x18, 0x48, 0xcc, 0x75, 0x88, 0x63,
+	0xb8, 0x4e, 0x20, 0x5b, 0xbb, 0x32, 0x93, 0x39, 0xf5, 0xe0, 0x51, 0x6a, 0xe0, 0xee, 0x9f, 0xa9,
+	0xe9, 0x52, 0xd9, 0xbe, 0xb8, 0x96, 0x4f, 0x17, 0x78, 0x15, 0x33, 0x03, 0xff, 0x9b, 0x17, 0xc5,
+	0x55, 0xc3, 0x6e, 0xd4, 0x98, 0x90, 0x06, 0xe2, 0xd6, 0x93, 0xfb, 0xb3, 0x23, 0x15, 0x56, 0x32,
+	0x0b, 0x8d, 0x9c, 0x93, 0x7b, 0xe2, 0xce, 0x93, 0xfb, 0xb3, 0x24, 0xdb, 0x74, 0x49, 0x4f, 0x87,
+	0x50, 0x32, 0xd5, 0x93, 0x12, 0x85, 0xdd, 0xcf, 0x89, 0xfe, 0x35, 0x81, 0xbd, 0x32, 0xc8, 0x95,
+	0x1a, 0xb3, 0x8a, 0x66, 0xbe, 0xc2, 0x5e, 0xa0, 0x13, 0xf3, 0x1d, 0xc6, 0x53, 0x02, 0xc9, 0x4e,
+	0x38, 0xff, 0x63, 0x47, 0xd2, 0x80, 0xfd, 0xa1, 0x91, 0x66, 0x1a, 0x32, 0x43, 0xff, 0xcd, 0x32,
+	0xf0, 0x21, 0x1c, 0xe8, 0xee, 0xfa, 0x59, 0xca, 0xc2, 0x2a, 0x56, 0x85, 0x73, 0xdc, 0x36, 0x2b,
+	0x2b, 0x6b, 0xb5, 0x5a, 0xa5, 0xe1, 0xc6, 0x12, 0xcc, 0x17, 0xd2, 0x87, 0x7c, 0x79, 0xe4, 0x7e,
+	0xbc, 0x01, 0x6f, 0x08, 0xbf, 0x01, 0x31, 0x21, 0x25, 0xcf, 0x2f, 0x4f, 0xd0, 0x61, 0xff, 0xb2,
+	0x64, 0x0e, 0x2b, 0xb6, 0x0a, 0xed, 0xcc, 0x05, 0x97, 0xca, 0xe6, 0x11, 0x13, 0xdf, 0x11, 0xeb,
+	0xe7, 0xe1, 0x7f, 0x2d, 0xda, 0x48, 0xc5, 0x31, 0x88, 0x99, 0x55, 0xbe, 0x66, 0xd9, 0x3d, 0x0f,
+	0x32, 0x33, 0xec, 0x50, 0x81, 0xd1, 0x28, 0x1b, 0x7d, 0x0c, 0xa8, 0xdc, 0xf6, 0xac, 0x59, 0x37,
+	0xab, 0x6e, 0xc5, 0xd0, 0xcf, 0xc3, 0x68, 0x40, 0x8a, 0xae, 0x96, 0x21, 0x56, 0x93, 0x12, 0x74,
+	0x35, 0x91, 0x0e, 0xb9, 0xdf, 0xd3, 0xca, 0x28, 0xe0, 0x4c, 0x59, 0xe9, 0x45, 0xd0, 0xe4, 0xb6,
+	0x32, 0x15, 0xc5, 0x7b, 0xcc, 0x36, 0x8b, 0xa6, 0x6d, 0xf6, 0x39, 0x85, 0xf4, 0x7b, 0x04, 0x26,
+	0x42, 0xdd, 0x60, 0x14, 0xa7, 0x60, 0xb8, 0x8a, 0x32, 0xb7, 0xcc, 0xec, 0x0d, 0x0d, 0xc4, 0xb5,
+	0xf4, 0x87, 0xe2, 0x99, 0xf6, 0x2f, 0x11, 0x16, 0x60, 0xb7, 0x87, 0xb7, 0x95, 0x95, 0xf0, 0x6c,
+	0xc8, 0x83, 0x16, 0x66, 0x82, 0x11, 0x9e, 0x84, 0xb8, 0x0b, 0x13, 0x79, 0x8c, 0x1e, 0x60, 0xd3,
+	0x52, 0x5f, 0x86, 0xc9, 0x76, 0x1f, 0x99, 0x86, 0xca, 0x42, 0x55, 0x96, 0xba, 0x62, 0xe4, 0x30,
+	0xd5, 0xd3, 0xbe, 0xaf, 0x80, 0xaf, 0xc0, 0x2e, 0xcf, 0xe1, 0x99, 0x2b, 0x16, 0xab, 0x8b, 0xae,
+	0x08, 0xfb, 0x75, 0xc9, 0xe9, 0x1f, 0x13, 0x00, 0xcf, 0xe9, 0xa6, 0xea, 0xfa, 0xb2, 0x57, 0x8f,
+	0xb7, 0x6c, 0xe0, 0x33, 0x6e, 0x96, 0xe6, 0x6f, 0xdd, 0x6a, 0x19, 0x08, 0x1e, 0xe9, 0xcd, 0xc0,
+	0x88, 0x0c, 0x38, 0xc7, 0xa5, 0x1c, 0x93, 0x3e, 0x15, 0x4a, 0xb1, 0x67, 0x9f, 0x4d, 0x14, 0xbd,
+	0xbd, 0xfa, 0x97, 0xed, 0x1f, 0x61, 0x1b, 0xe0, 0x03, 0x8a, 0x49, 0xf1, 0x7c, 0x0e, 0xeb, 0x1e,
+	0x81, 0x54, 0x47, 0x00, 0x2f, 0x22, 0x61, 0x0d, 0x4c, 0xeb, 0x15, 0x66, 0x15, 0xdf, 0xb4, 0x9c,
+	0x3b, 0xbd, 0xe8, 0x32, 0xf5, 0x7f, 0x88, 0x49, 0x97, 0x0a, 0xe1, 0x70, 0x16, 0xdf, 0x5a, 0xb8,
+	0x2a, 0x6c, 0x9a, 0xab, 0x3b, 0x6e, 0x56, 0x05, 0x7c, 0x23, 0x49, 0x27, 0x60, 0x44, 0x30, 0xab,
+	0x98, 0x63, 0x4a, 0x8e, 0x24, 0xed, 0x0b, 0x25, 0xc9, 0x6f, 0x9f, 0x10, 0xde, 0x0b, 0x3d, 0x1d,
+	0x82, 0x74, 0x53, 0x2c, 0x8d, 0xc2, 0x4e, 0x89, 0xf4, 0x2d, 0xce, 0x57, 0x9b, 0xf7, 0xd8, 0x39,
+	0xa0, 0x7e, 0x61, 0xf3, 0x1a, 0x1b, 0xbc, 0xe8, 0x08, 0xba, 0x16, 0x7f, 0xc7, 0xe4, 0x6d, 0xeb,
+	0x02, 0xf7, 0x7f, 0x6d, 0xca, 0x4c, 0xbf, 0x4b, 0x20, 0xee, 0x2e, 0x53, 0x0a, 0xdb, 0x2c, 0xb3,
+	0xca, 0x30, 0x57, 0xe5, 0xb3, 0x93, 0xc0, 0xbc, 0x5e, 0x64, 0x75, 0x79, 0xea, 0x5b, 0xb3, 0xea,
+	0x85, 0x4e, 0xc0, 0x70, 0xc9, 0x14, 0xb9, 0x4a, 0xb9, 0x5a, 0xb6, 0xe5, 0xe0, 0xb2, 0x2d, 0x1b,
+	0x2f, 0x99, 0xe2, 0x5d, 0xe7, 0x9d, 0x8e, 0xc3, 0x90, 0xcb, 0xe3, 0x36, 0x39, 0xd3, 0xb8, 0xaf,
+	0xbe, 0x33, 0x1e, 0x0c, 0x9c, 0xf1, 0xcb, 0xb0, 0x43, 0xe5, 0x68, 0xad, 0xce, 0x2e, 0x94, 0xaf,
+	0x32, 0x31, 0x1e, 0x93, 0xeb, 0xdb, 0xa5, 0xf4, 0x2c, 0x0a, 0x17, 0x7f, 0xa6, 0x30, 0x28, 0x39,
+	0xa0, 0x5f, 0x12, 0x18, 0xc2, 0x6e, 0x90, 0x4e, 0x87, 0xc6, 0x1c, 0x32, 0xab, 0x6a, 0x33, 0x11,
+	0x34, 0x15, 0xaf, 0xfa, 0x1b, 0x37, 0x1c, 0x96, 0x3e, 0xfd, 0xf5, 0xef, 0x2f, 0xb6, 0x2c, 0xd2,
+	0x83, 0x46, 0xf8, 0x98, 0x2d, 0x4d, 0x84, 0x71, 0x0d, 0x0b, 0xdf, 0x75, 0x23, 0xdf, 0x50, 0xb3,
+	0x1c, 0xbd, 0x4d, 0x20, 0xe1, 0x1b, 0xd4, 0xe8, 0x5c, 0x67, 0xcf, 0xed, 0x63, 0xa9, 0x36, 0x1f,
+	0x51, 0x1b, 0xb1, 0x1e, 0xf2, 0xb0, 0xce, 0xd0, 0xa9, 0x88, 0x58, 0xe9, 0x8f, 0x04, 0x76, 0xb6,
+	0x8d, 0x2f, 0x74, 0xb1, 0xb3, 0xeb, 0x4e, 0x33, 0x99, 0xb6, 0xb4, 0x21, 0x1b, 0x04, 0xbd, 0xec,
+	0x81, 0x5e, 0xa2, 0x0b, 0xa1, 0xa0, 0x85, 0x6b, 0x9c, 0x0b, 0x81, 0xff, 0x1b, 0x81, 0x5d, 0x1d,
+	0x06, 0x03, 0xfa, 0x5a, 0x74, 0x40, 0xc1, 0x31, 0x46, 0x3b, 0xb2, 0x09, 0x4b, 0x0c, 0xe8, 0xb4,
+	0x17, 0xd0, 0x31, 0x7a, 0x74, 0xc3, 0x01, 0x79, 0xb9, 0x73, 0x93, 0x40, 0xc2, 0x37, 0x27, 0x74,
+	0xcb, 0x9d, 0xf6, 0xe1, 0x45, 0x9b, 0x8f, 0xa8, 0x8d, 0xa8, 0xa7, 0x3d, 0xd4, 0x7b, 0xe9, 0x44,
+	0x38, 0x6a, 0x05, 0xe3, 0x26, 0x81, 0xb8, 0xdb, 0xb0, 0xd3, 0x2e, 0x5f, 0x52, 0xcb, 0x08, 0xa0,
+	0xcd, 0x46, 0x51, 0x45, 0x34, 0x0b, 0x1e, 0x9a, 0x49, 0x7a, 0xa0, 0x0b, 0x1a, 0x8f, 0xad, 0xcf,
+	0x08, 0xc4, 0x54, 0x97, 0x4e, 0xa7, 0x3a, 0x7b, 0x0a, 0x8c, 0x04, 0xda, 0x74, 0x6f, 0xc5, 0xe8,
+	0xf4, 0xa8, 0x79, 0x80, 0x7e, 0x47, 0x60, 0x7b, 0xa0, 0x3b, 0xa4, 0xe9, 0xce, 0x5e, 0xc2, 0xba,
+	0x63, 0xcd, 0x88, 0xac, 0x8f, 0xe0, 0x8e, 0x78, 0xe0, 0xd2, 0x74, 0x2e, 0x14, 0x9c, 0xaa, 0xaf,
+	0x39, 0xb7, 0xad, 0x34, 0xae, 0x49, 0xc1, 0x75, 0xfa, 0x07, 0x01, 0xad, 0x73, 0x2f, 0x4b, 0x5f,
+	0x8f, 0x08, 0x25, 0xac, 0x83, 0xd6, 0x8e, 0x6d, 0xce, 0x18, 0x83, 0x3a, 0xee, 0x05, 0x75, 0x98,
+	0x1e, 0x8a, 0x12, 0x54, 0x2e, 0xdf, 0xc8, 0xc9, 0x8b, 0x35, 0x27, 0x14, 0xfa, 0x6f, 0x08, 0xec,
+	0x08, 0xce, 0x4b, 0xb4, 0x17, 0xb7, 0xad, 0x03, 0x9c, 0x76, 0x30, 0xba, 0x41, 0xf4, 0xdc, 0x6d,
+	0x01, 0x4e, 0xbf, 0x22, 0x90, 0xf0, 0x75, 0x6e, 0xdd, 0xbe, 0xf4, 0xf6, 0x39, 0x40, 0x9b, 0x8f,
+	0xa8, 0x8d, 0xf8, 0x0e, 0x7b, 0xf8, 0x5e, 0xa1, 0x33, 0x9d, 0xf1, 0x61, 0x9f, 0xd8, 0x4c, 0x95,
+	0x1f, 0x08, 0xd0, 0xf6, 0xf6, 0x92, 0x2e, 0x45, 0xf2, 0x1e, 0xec, 0x86, 0xb5, 0x43, 0x1b, 0x33,
+	0x42, 0xe4, 0xaf, 0x7a, 0xc8, 0xe7, 0xe8, 0x6c, 0x4f, 0xe4, 0xcd, 0x7c, 0xa0, 0xb7, 0x08, 0x24,
+	0x7c, 0xdd, 0x5a, 0x37, 0x7e, 0xdb, 0x1b, 0x52, 0x6d, 0x3e, 0xa2, 0x36, 0xa2, 0x4c, 0x7b, 0x28,
+	0xf7, 0xd3, 0x97, 0xc2, 0x6b, 0x97, 0xaf, 0xc5, 0xa4, 0x9f, 0x10, 0x18, 0x94, 0xbd, 0x1c, 0x9d,
+	0xec, 0xec, 0xc8, 0xdf, 0x01, 0x6a, 0x53, 0x3d, 0xf5, 0x10, 0xca, 0x94, 0x07, 0x65, 0x0f, 0xd5,
+	0x42, 0xa1, 0xc8, 0xee, 0x2f, 0x73, 0xe2, 0xc1, 0x7a, 0x92, 0x3c, 0x5c, 0x4f, 0x92, 0xbf, 0xd6,
+	0x93, 0xe4, 0xf3, 0xc7, 0xc9, 0x81, 0x87, 0x8f, 0x93, 0x03, 0xbf, 0x3f, 0x4e, 0x0e, 0x7c, 0x30,
+	0xd3, 0xf5, 0x17, 0xa6, 0xab, 0x6a, 0x33, 0xf9, 0x43, 0x53, 0x3e, 0x26, 0xff, 0x3e, 0xb0, 0xf4,
+	0xcf, 0x00, 0x05, 0x52, 0x93, 0x77, 0x42, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1582,6 +1770,8 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// Hooks queries the bank hooks registered with the keeper, in execution order.
+	Hooks(ctx context.Context, in *QueryHooksRequest, opts ...grpc.CallOption) (*QueryHooksResponse, error)
 }
 
 type queryClient struct {
@@ -1709,6 +1899,15 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) Hooks(ctx context.Context, in *QueryHooksRequest, opts ...grpc.CallOption) (*QueryHooksResponse, error) {
+	out := new(QueryHooksResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/Hooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the balance of a single coin for a single account.
@@ -1774,6 +1973,8 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// Hooks queries the bank hooks registered with the keeper, in execution order.
+	Hooks(context.Context, *QueryHooksRequest) (*QueryHooksResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1819,6 +2020,9 @@ func (*UnimplementedQueryServer) DenomOwnersByQuery(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) SendEnabled(ctx context.Context, req *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (*UnimplementedQueryServer) Hooks(ctx context.Context, req *QueryHooksRequest) (*QueryHooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hooks not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2058,6 +2262,24 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Hooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Hooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/Hooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Hooks(ctx, req.(*QueryHooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2114,6 +2336,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "Hooks",
+			Handler:    _Query_Hooks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
@@ -3187,6 +3413,134 @@ func (m *QuerySendEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryHooksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHooksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHooksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHooksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHooksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHooksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hooks) > 0 {
+		for iNdEx := len(m.Hooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HookInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HookInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HookInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomPrefixes) > 0 {
+		for iNdEx := len(m.DenomPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DenomPrefixes[iNdEx])
+			copy(dAtA[i:], m.DenomPrefixes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomPrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Order != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Order))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3614,38 +3968,96 @@ func (m *QuerySendEnabledResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryHooksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
+
+func (m *QueryHooksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hooks) > 0 {
+		for _, e := range m.Hooks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
 		}
-		if fieldNum <= 0 {
+	}
+	return n
+}
+
+func (m *HookInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Order != 0 {
+		n += 1 + sovQuery(uint64(m.Order))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DenomPrefixes) > 0 {
+		for _, s := range m.DenomPrefixes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
 			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
@@ -6385,6 +6797,344 @@ func (m *QuerySendEnabledResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryHooksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHooksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHooksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHooksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHooksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHooksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hooks = append(m.Hooks, HookInfo{})
+			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HookInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HookInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HookInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			m.Order = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Order |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPrefixes = append(m.DenomPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
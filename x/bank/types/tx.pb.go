@@ -413,6 +413,107 @@ func (m *MsgSetSendEnabledResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetSendEnabledResponse proto.InternalMessageInfo
 
+// MsgSetHookEnabled is the Msg/SetHookEnabled request type.
+type MsgSetHookEnabled struct {
+	// authority is the address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// name is the name the bank hook was registered under.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// enabled defines whether the hook is run on transfers.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetHookEnabled) Reset()         { *m = MsgSetHookEnabled{} }
+func (m *MsgSetHookEnabled) String() string { return proto.CompactTextString(m) }
+func (*MsgSetHookEnabled) ProtoMessage()    {}
+func (*MsgSetHookEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{8}
+}
+func (m *MsgSetHookEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetHookEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetHookEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetHookEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetHookEnabled.Merge(m, src)
+}
+func (m *MsgSetHookEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetHookEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetHookEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetHookEnabled proto.InternalMessageInfo
+
+func (m *MsgSetHookEnabled) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetHookEnabled) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgSetHookEnabled) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// MsgSetHookEnabledResponse defines the Msg/SetHookEnabled response type.
+type MsgSetHookEnabledResponse struct {
+}
+
+func (m *MsgSetHookEnabledResponse) Reset()         { *m = MsgSetHookEnabledResponse{} }
+func (m *MsgSetHookEnabledResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetHookEnabledResponse) ProtoMessage()    {}
+func (*MsgSetHookEnabledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{9}
+}
+func (m *MsgSetHookEnabledResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetHookEnabledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetHookEnabledResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetHookEnabledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetHookEnabledResponse.Merge(m, src)
+}
+func (m *MsgSetHookEnabledResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetHookEnabledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetHookEnabledResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetHookEnabledResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSend)(nil), "cosmos.bank.v1beta1.MsgSend")
 	proto.RegisterType((*MsgSendResponse)(nil), "cosmos.bank.v1beta1.MsgSendResponse")
@@ -422,56 +523,62 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.bank.v1beta1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgSetSendEnabled)(nil), "cosmos.bank.v1beta1.MsgSetSendEnabled")
 	proto.RegisterType((*MsgSetSendEnabledResponse)(nil), "cosmos.bank.v1beta1.MsgSetSendEnabledResponse")
+	proto.RegisterType((*MsgSetHookEnabled)(nil), "cosmos.bank.v1beta1.MsgSetHookEnabled")
+	proto.RegisterType((*MsgSetHookEnabledResponse)(nil), "cosmos.bank.v1beta1.MsgSetHookEnabledResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/tx.proto", fileDescriptor_1d8cb1613481f5b7) }
 
 var fileDescriptor_1d8cb1613481f5b7 = []byte{
-	// 700 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x4f, 0xd3, 0x50,
-	0x1c, 0x5f, 0x99, 0x8e, 0xec, 0x31, 0x25, 0x54, 0x22, 0xac, 0x90, 0x0e, 0x16, 0x43, 0x00, 0xa5,
-	0x15, 0x34, 0x9a, 0xcc, 0x68, 0x74, 0x28, 0x89, 0x26, 0x8b, 0x66, 0xc4, 0x83, 0x5e, 0x96, 0xd7,
-	0xf5, 0x51, 0x1a, 0xd6, 0xbe, 0xa6, 0xef, 0x95, 0xb0, 0x9b, 0x7a, 0x32, 0x9e, 0x3c, 0x7b, 0xe2,
-	0x68, 0x8c, 0x07, 0x0e, 0x1e, 0x4d, 0xbc, 0x72, 0x24, 0x9e, 0x3c, 0xa9, 0x81, 0x03, 0xfa, 0x5f,
-	0x98, 0xf7, 0xa3, 0xa5, 0x8c, 0x8d, 0x11, 0x2f, 0x6b, 0xf7, 0x3e, 0x3f, 0xbe, 0xef, 0xf3, 0xed,
-	0xf7, 0x3d, 0x30, 0xd9, 0xc4, 0xc4, 0xc3, 0xc4, 0xb4, 0xa0, 0xbf, 0x61, 0x6e, 0x2e, 0x5a, 0x88,
-	0xc2, 0x45, 0x93, 0x6e, 0x19, 0x41, 0x88, 0x29, 0x56, 0x2f, 0x09, 0xd4, 0x60, 0xa8, 0x21, 0x51,
-	0x6d, 0xd4, 0xc1, 0x0e, 0xe6, 0xb8, 0xc9, 0xde, 0x04, 0x55, 0xd3, 0x13, 0x23, 0x82, 0x12, 0xa3,
-	0x26, 0x76, 0xfd, 0x13, 0x78, 0xaa, 0x10, 0xf7, 0x15, 0x78, 0x51, 0xe0, 0x0d, 0x61, 0x2c, 0xeb,
-	0x0a, 0x68, 0x4c, 0x4a, 0x3d, 0xe2, 0x98, 0x9b, 0x8b, 0xec, 0x21, 0x81, 0x11, 0xe8, 0xb9, 0x3e,
-	0x36, 0xf9, 0xaf, 0x58, 0x2a, 0x7f, 0x1e, 0x00, 0x83, 0x35, 0xe2, 0xac, 0x22, 0xdf, 0x56, 0xef,
-	0x80, 0xc2, 0x5a, 0x88, 0xbd, 0x06, 0xb4, 0xed, 0x10, 0x11, 0x32, 0xae, 0x4c, 0x29, 0xb3, 0xf9,
-	0xea, 0xf8, 0xf7, 0x2f, 0x0b, 0xa3, 0xd2, 0xff, 0x81, 0x40, 0x56, 0x69, 0xe8, 0xfa, 0x4e, 0x7d,
-	0x88, 0xb1, 0xe5, 0x92, 0x7a, 0x1b, 0x00, 0x8a, 0x13, 0xe9, 0x40, 0x1f, 0x69, 0x9e, 0xe2, 0x58,
-	0xd8, 0x06, 0x39, 0xe8, 0xe1, 0xc8, 0xa7, 0xe3, 0xd9, 0xa9, 0xec, 0xec, 0xd0, 0x52, 0xd1, 0x48,
-	0x9a, 0x48, 0x50, 0xdc, 0x44, 0x63, 0x19, 0xbb, 0x7e, 0x75, 0x65, 0xf7, 0x67, 0x29, 0xf3, 0xe9,
-	0x57, 0x69, 0xd6, 0x71, 0xe9, 0x7a, 0x64, 0x19, 0x4d, 0xec, 0xc9, 0xe4, 0xf2, 0xb1, 0x40, 0xec,
-	0x0d, 0x93, 0xb6, 0x03, 0x44, 0xb8, 0x80, 0x7c, 0x38, 0xdc, 0x99, 0x2f, 0xb4, 0x90, 0x03, 0x9b,
-	0xed, 0x06, 0xeb, 0x2d, 0xf9, 0x78, 0xb8, 0x33, 0xaf, 0xd4, 0x65, 0xc1, 0xca, 0xf5, 0xb7, 0xdb,
-	0xa5, 0xcc, 0x9f, 0xed, 0x52, 0xe6, 0x0d, 0xe3, 0xa5, 0xb3, 0xbf, 0x3b, 0xdc, 0x99, 0x57, 0x53,
-	0x9e, 0xb2, 0x45, 0xe5, 0x11, 0x30, 0x2c, 0x5f, 0xeb, 0x88, 0x04, 0xd8, 0x27, 0xa8, 0xfc, 0x55,
-	0x01, 0x85, 0x1a, 0x71, 0x6a, 0x51, 0x8b, 0xba, 0xbc, 0x8d, 0x77, 0x41, 0xce, 0xf5, 0x83, 0x88,
-	0xb2, 0x06, 0xb2, 0x40, 0x9a, 0xd1, 0x65, 0x2a, 0x8c, 0xc7, 0x8c, 0x52, 0xcd, 0xb3, 0x44, 0x72,
-	0x53, 0x42, 0xa4, 0xde, 0x07, 0x83, 0x38, 0xa2, 0x5c, 0x3f, 0xc0, 0xf5, 0x13, 0x5d, 0xf5, 0x4f,
-	0x39, 0x27, 0x6d, 0x10, 0xcb, 0x2a, 0x57, 0xe3, 0x48, 0xd2, 0x92, 0x85, 0x19, 0x3b, 0x1e, 0x26,
-	0xd9, 0x6d, 0xf9, 0x32, 0x18, 0x4d, 0xff, 0x4f, 0x62, 0x7d, 0x53, 0x78, 0xd4, 0xe7, 0x81, 0x0d,
-	0x29, 0x7a, 0x06, 0x43, 0xe8, 0x11, 0xf5, 0x16, 0xc8, 0xc3, 0x88, 0xae, 0xe3, 0xd0, 0xa5, 0xed,
-	0xbe, 0xd3, 0x71, 0x44, 0x55, 0xef, 0x81, 0x5c, 0xc0, 0x1d, 0xf8, 0x5c, 0xf4, 0x4a, 0x24, 0x8a,
-	0x1c, 0x6b, 0x89, 0x50, 0x55, 0x6e, 0xb2, 0x30, 0x47, 0x7e, 0x2c, 0xcf, 0x74, 0x2a, 0xcf, 0x96,
-	0x38, 0x24, 0x1d, 0xbb, 0x2d, 0x17, 0xc1, 0x58, 0xc7, 0x52, 0x12, 0xee, 0xaf, 0x02, 0x46, 0xf8,
-	0x77, 0xa4, 0x2c, 0xf3, 0x23, 0x1f, 0x5a, 0x2d, 0x64, 0xff, 0x77, 0xbc, 0x65, 0x50, 0x20, 0xc8,
-	0xb7, 0x1b, 0x48, 0xf8, 0xc8, 0xcf, 0x36, 0xd5, 0x35, 0x64, 0xaa, 0x5e, 0x7d, 0x88, 0xa4, 0x8a,
-	0xcf, 0x80, 0xe1, 0x88, 0xa0, 0x86, 0x8d, 0xd6, 0x60, 0xd4, 0xa2, 0x8d, 0x35, 0x1c, 0xf2, 0xf3,
-	0x90, 0xaf, 0x5f, 0x88, 0x08, 0x7a, 0x28, 0x56, 0x57, 0x70, 0x58, 0x31, 0x4f, 0xf6, 0x62, 0xb2,
-	0x73, 0x50, 0xd3, 0xa9, 0xca, 0x13, 0xa0, 0x78, 0x62, 0x31, 0x6e, 0xc4, 0xd2, 0xeb, 0x2c, 0xc8,
-	0xd6, 0x88, 0xa3, 0x3e, 0x01, 0xe7, 0xf8, 0xec, 0x4e, 0x76, 0xdd, 0xb4, 0x1c, 0x79, 0xed, 0xca,
-	0x69, 0x68, 0xec, 0xa9, 0xbe, 0x00, 0xf9, 0xa3, 0xc3, 0x30, 0xdd, 0x4b, 0x92, 0x50, 0xb4, 0xb9,
-	0xbe, 0x94, 0xc4, 0xda, 0x02, 0x85, 0x63, 0x03, 0xd9, 0x73, 0x43, 0x69, 0x96, 0x76, 0xed, 0x2c,
-	0xac, 0xa4, 0xc6, 0x3a, 0xb8, 0xd8, 0x31, 0x17, 0x33, 0xbd, 0x63, 0xa7, 0x79, 0x9a, 0x71, 0x36,
-	0x5e, 0x5c, 0x49, 0x3b, 0xff, 0x8a, 0x4d, 0x79, 0x75, 0x79, 0x77, 0x5f, 0x57, 0xf6, 0xf6, 0x75,
-	0xe5, 0xf7, 0xbe, 0xae, 0xbc, 0x3f, 0xd0, 0x33, 0x7b, 0x07, 0x7a, 0xe6, 0xc7, 0x81, 0x9e, 0x79,
-	0x39, 0x77, 0xea, 0x3d, 0x27, 0xc7, 0x9e, 0x5f, 0x77, 0x56, 0x8e, 0x5f, 0xe7, 0x37, 0xfe, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x5b, 0x5b, 0x43, 0xa9, 0xa0, 0x06, 0x00, 0x00,
+	// 758 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x4f, 0x13, 0x4f,
+	0x1c, 0xed, 0x52, 0xbe, 0xe5, 0xdb, 0xa1, 0x4a, 0x58, 0x89, 0xb4, 0x0b, 0x69, 0x4b, 0x63, 0x48,
+	0x41, 0xd9, 0x15, 0x34, 0x9a, 0xd4, 0x68, 0xb4, 0x28, 0x51, 0x93, 0x46, 0x53, 0xe2, 0x41, 0x2f,
+	0xcd, 0xb6, 0x3b, 0x2c, 0x1b, 0xba, 0x3b, 0xcd, 0xce, 0x2c, 0xa1, 0x37, 0xe3, 0xc9, 0x78, 0xf2,
+	0xec, 0x89, 0xa3, 0x21, 0x1e, 0x38, 0x78, 0x34, 0xf1, 0xca, 0x91, 0x78, 0xf2, 0xa4, 0x06, 0x0e,
+	0x68, 0xfc, 0x27, 0xcc, 0xfc, 0xd8, 0xed, 0x50, 0x5a, 0x68, 0xf4, 0xd2, 0xee, 0xce, 0xe7, 0xbd,
+	0xf7, 0x99, 0xf7, 0x76, 0x3e, 0xbb, 0x60, 0xba, 0x81, 0xb0, 0x8b, 0xb0, 0x51, 0x37, 0xbd, 0x0d,
+	0x63, 0x73, 0xb1, 0x0e, 0x89, 0xb9, 0x68, 0x90, 0x2d, 0xbd, 0xe5, 0x23, 0x82, 0xd4, 0x0b, 0xbc,
+	0xaa, 0xd3, 0xaa, 0x2e, 0xaa, 0xda, 0x84, 0x8d, 0x6c, 0xc4, 0xea, 0x06, 0xbd, 0xe2, 0x50, 0x2d,
+	0x1b, 0x09, 0x61, 0x18, 0x09, 0x35, 0x90, 0xe3, 0x9d, 0xa8, 0x4b, 0x8d, 0x98, 0x2e, 0xaf, 0x67,
+	0x78, 0xbd, 0xc6, 0x85, 0x45, 0x5f, 0x5e, 0x9a, 0x14, 0x54, 0x17, 0xdb, 0xc6, 0xe6, 0x22, 0xfd,
+	0x13, 0x85, 0x71, 0xd3, 0x75, 0x3c, 0x64, 0xb0, 0x5f, 0xbe, 0x54, 0xf8, 0x30, 0x04, 0x46, 0x2a,
+	0xd8, 0x5e, 0x85, 0x9e, 0xa5, 0xde, 0x02, 0xa9, 0x35, 0x1f, 0xb9, 0x35, 0xd3, 0xb2, 0x7c, 0x88,
+	0x71, 0x5a, 0xc9, 0x2b, 0xc5, 0x64, 0x39, 0xfd, 0xe5, 0xe3, 0xc2, 0x84, 0xd0, 0xbf, 0xc7, 0x2b,
+	0xab, 0xc4, 0x77, 0x3c, 0xbb, 0x3a, 0x4a, 0xd1, 0x62, 0x49, 0xbd, 0x09, 0x00, 0x41, 0x11, 0x75,
+	0xe8, 0x0c, 0x6a, 0x92, 0xa0, 0x90, 0xd8, 0x06, 0x09, 0xd3, 0x45, 0x81, 0x47, 0xd2, 0xf1, 0x7c,
+	0xbc, 0x38, 0xba, 0x94, 0xd1, 0xa3, 0x10, 0x31, 0x0c, 0x43, 0xd4, 0x97, 0x91, 0xe3, 0x95, 0x57,
+	0xf6, 0xbe, 0xe5, 0x62, 0x3b, 0xdf, 0x73, 0x45, 0xdb, 0x21, 0xeb, 0x41, 0x5d, 0x6f, 0x20, 0x57,
+	0x38, 0x17, 0x7f, 0x0b, 0xd8, 0xda, 0x30, 0x48, 0xbb, 0x05, 0x31, 0x23, 0xe0, 0x77, 0x47, 0xbb,
+	0xf3, 0xa9, 0x26, 0xb4, 0xcd, 0x46, 0xbb, 0x46, 0xb3, 0xc5, 0xef, 0x8f, 0x76, 0xe7, 0x95, 0xaa,
+	0x68, 0x58, 0xba, 0xfa, 0x7a, 0x3b, 0x17, 0xfb, 0xb9, 0x9d, 0x8b, 0xbd, 0xa2, 0x38, 0xd9, 0xfb,
+	0x9b, 0xa3, 0xdd, 0x79, 0x55, 0xd2, 0x14, 0x11, 0x15, 0xc6, 0xc1, 0x98, 0xb8, 0xac, 0x42, 0xdc,
+	0x42, 0x1e, 0x86, 0x85, 0x4f, 0x0a, 0x48, 0x55, 0xb0, 0x5d, 0x09, 0x9a, 0xc4, 0x61, 0x31, 0xde,
+	0x06, 0x09, 0xc7, 0x6b, 0x05, 0x84, 0x06, 0x48, 0x0d, 0x69, 0x7a, 0x8f, 0x53, 0xa1, 0x3f, 0xa2,
+	0x90, 0x72, 0x92, 0x3a, 0x12, 0x9b, 0xe2, 0x24, 0xf5, 0x2e, 0x18, 0x41, 0x01, 0x61, 0xfc, 0x21,
+	0xc6, 0x9f, 0xea, 0xc9, 0x7f, 0x12, 0x90, 0x2e, 0x81, 0x90, 0x56, 0xba, 0x1c, 0x5a, 0x12, 0x92,
+	0xd4, 0xcc, 0xe4, 0x71, 0x33, 0xd1, 0x6e, 0x0b, 0x17, 0xc1, 0x84, 0x7c, 0x1f, 0xd9, 0xfa, 0xac,
+	0x30, 0xab, 0xcf, 0x5a, 0x96, 0x49, 0xe0, 0x53, 0xd3, 0x37, 0x5d, 0xac, 0xde, 0x00, 0x49, 0x33,
+	0x20, 0xeb, 0xc8, 0x77, 0x48, 0xfb, 0xcc, 0xd3, 0xd1, 0x81, 0xaa, 0x77, 0x40, 0xa2, 0xc5, 0x14,
+	0xd8, 0xb9, 0xe8, 0xe7, 0x88, 0x37, 0x39, 0x16, 0x09, 0x67, 0x95, 0xae, 0x53, 0x33, 0x1d, 0x3d,
+	0xea, 0x67, 0x46, 0xf2, 0xb3, 0xc5, 0x87, 0xa4, 0x6b, 0xb7, 0x85, 0x0c, 0x98, 0xec, 0x5a, 0x8a,
+	0xcc, 0xfd, 0x52, 0xc0, 0x38, 0x7b, 0x8e, 0x84, 0x7a, 0x7e, 0xe0, 0x99, 0xf5, 0x26, 0xb4, 0xfe,
+	0xda, 0xde, 0x32, 0x48, 0x61, 0xe8, 0x59, 0x35, 0xc8, 0x75, 0xc4, 0x63, 0xcb, 0xf7, 0x34, 0x29,
+	0xf5, 0xab, 0x8e, 0x62, 0xa9, 0xf9, 0x2c, 0x18, 0x0b, 0x30, 0xac, 0x59, 0x70, 0xcd, 0x0c, 0x9a,
+	0xa4, 0xb6, 0x86, 0x7c, 0x36, 0x0f, 0xc9, 0xea, 0xb9, 0x00, 0xc3, 0xfb, 0x7c, 0x75, 0x05, 0xf9,
+	0x25, 0xe3, 0x64, 0x16, 0xd3, 0xdd, 0x07, 0x55, 0x76, 0x55, 0x98, 0x02, 0x99, 0x13, 0x8b, 0x51,
+	0x10, 0x3b, 0x51, 0x10, 0x0f, 0x11, 0xda, 0xf8, 0xd7, 0x20, 0x54, 0x30, 0xec, 0x99, 0x2e, 0xe4,
+	0xd3, 0x5f, 0x65, 0xd7, 0x6a, 0x1a, 0x8c, 0x84, 0xb9, 0xc4, 0xf3, 0x4a, 0xf1, 0xff, 0x6a, 0x78,
+	0x3b, 0x98, 0x13, 0x69, 0x5b, 0x1d, 0x27, 0xd2, 0x62, 0xe8, 0x64, 0xe9, 0x77, 0x1c, 0xc4, 0x2b,
+	0xd8, 0x56, 0x1f, 0x83, 0x61, 0x36, 0x85, 0xd3, 0x3d, 0xe3, 0x17, 0xc3, 0xab, 0x5d, 0x3a, 0xad,
+	0x1a, 0x6a, 0xaa, 0xcf, 0x41, 0xb2, 0x33, 0xd6, 0x33, 0xfd, 0x28, 0x11, 0x44, 0x9b, 0x3b, 0x13,
+	0x12, 0x49, 0xd7, 0x41, 0xea, 0xd8, 0x68, 0xf5, 0xdd, 0x90, 0x8c, 0xd2, 0xae, 0x0c, 0x82, 0x8a,
+	0x7a, 0xac, 0x83, 0xf3, 0x5d, 0x27, 0x7c, 0xb6, 0xbf, 0x6d, 0x19, 0xa7, 0xe9, 0x83, 0xe1, 0xba,
+	0x3a, 0xc9, 0x47, 0xe8, 0xb4, 0x4e, 0x12, 0x4e, 0xd3, 0x07, 0xc3, 0x85, 0x9d, 0xb4, 0xff, 0x5e,
+	0xd2, 0x37, 0x43, 0x79, 0x79, 0xef, 0x20, 0xab, 0xec, 0x1f, 0x64, 0x95, 0x1f, 0x07, 0x59, 0xe5,
+	0xed, 0x61, 0x36, 0xb6, 0x7f, 0x98, 0x8d, 0x7d, 0x3d, 0xcc, 0xc6, 0x5e, 0xcc, 0x9d, 0xfa, 0x6d,
+	0x10, 0xaf, 0x0a, 0xf6, 0x89, 0xa8, 0x27, 0xd8, 0x27, 0xf0, 0xda, 0x9f, 0x01, 0x00, 0xb4, 0x99,
+	0x64, 0x57, 0xd4, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -502,6 +609,9 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// SetHookEnabled is a governance operation for enabling or disabling a
+	// registered bank hook without a chain upgrade.
+	SetHookEnabled(ctx context.Context, in *MsgSetHookEnabled, opts ...grpc.CallOption) (*MsgSetHookEnabledResponse, error)
 }
 
 type msgClient struct {
@@ -548,6 +658,15 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
+func (c *msgClient) SetHookEnabled(ctx context.Context, in *MsgSetHookEnabled, opts ...grpc.CallOption) (*MsgSetHookEnabledResponse, error) {
+	out := new(MsgSetHookEnabledResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/SetHookEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Send defines a method for sending coins from one account to another account.
@@ -566,6 +685,9 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// SetHookEnabled is a governance operation for enabling or disabling a
+	// registered bank hook without a chain upgrade.
+	SetHookEnabled(context.Context, *MsgSetHookEnabled) (*MsgSetHookEnabledResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -584,6 +706,9 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) SetSendEnabled(ctx context.Context, req *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (*UnimplementedMsgServer) SetHookEnabled(ctx context.Context, req *MsgSetHookEnabled) (*MsgSetHookEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHookEnabled not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -661,6 +786,24 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetHookEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetHookEnabled)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetHookEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/SetHookEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetHookEnabled(ctx, req.(*MsgSetHookEnabled))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -681,6 +824,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "SetHookEnabled",
+			Handler:    _Msg_SetHookEnabled_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
@@ -973,6 +1120,76 @@ func (m *MsgSetSendEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetHookEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetHookEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetHookEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetHookEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetHookEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetHookEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1104,6 +1321,35 @@ func (m *MsgSetSendEnabledResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetHookEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetHookEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1839,6 +2085,190 @@ func (m *MsgSetSendEnabledResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetHookEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetHookEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetHookEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetHookEnabledResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetHookEnabledResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetHookEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -473,6 +473,20 @@ func (mr *MockBankKeeperMockRecorder) GetDenomMetaData(ctx, denom interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).GetDenomMetaData), ctx, denom)
 }
 
+// GetHooks mocks base method.
+func (m *MockBankKeeper) GetHooks() []types0.NamedBankHooks {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHooks")
+	ret0, _ := ret[0].([]types0.NamedBankHooks)
+	return ret0
+}
+
+// GetHooks indicates an expected call of GetHooks.
+func (mr *MockBankKeeperMockRecorder) GetHooks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHooks", reflect.TypeOf((*MockBankKeeper)(nil).GetHooks))
+}
+
 // GetPaginatedTotalSupply mocks base method.
 func (m *MockBankKeeper) GetPaginatedTotalSupply(ctx context.Context, pagination *query.PageRequest) (types.Coins, *query.PageResponse, error) {
 	m.ctrl.T.Helper()
@@ -574,6 +588,21 @@ func (mr *MockBankKeeperMockRecorder) HasSupply(ctx, denom interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSupply", reflect.TypeOf((*MockBankKeeper)(nil).HasSupply), ctx, denom)
 }
 
+// Hooks mocks base method.
+func (m *MockBankKeeper) Hooks(arg0 context.Context, arg1 *types0.QueryHooksRequest) (*types0.QueryHooksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hooks", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryHooksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Hooks indicates an expected call of Hooks.
+func (mr *MockBankKeeperMockRecorder) Hooks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hooks", reflect.TypeOf((*MockBankKeeper)(nil).Hooks), arg0, arg1)
+}
+
 // InitGenesis mocks base method.
 func (m *MockBankKeeper) InitGenesis(arg0 context.Context, arg1 *types0.GenesisState) {
 	m.ctrl.T.Helper()
@@ -600,6 +629,20 @@ func (mr *MockBankKeeperMockRecorder) InputOutputCoins(ctx, input, outputs inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InputOutputCoins", reflect.TypeOf((*MockBankKeeper)(nil).InputOutputCoins), ctx, input, outputs)
 }
 
+// IsHookEnabled mocks base method.
+func (m *MockBankKeeper) IsHookEnabled(ctx context.Context, name string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsHookEnabled", ctx, name)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsHookEnabled indicates an expected call of IsHookEnabled.
+func (mr *MockBankKeeperMockRecorder) IsHookEnabled(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsHookEnabled", reflect.TypeOf((*MockBankKeeper)(nil).IsHookEnabled), ctx, name)
+}
+
 // IsSendEnabledCoin mocks base method.
 func (m *MockBankKeeper) IsSendEnabledCoin(ctx context.Context, coin types.Coin) bool {
 	m.ctrl.T.Helper()
@@ -857,6 +900,20 @@ func (mr *MockBankKeeperMockRecorder) SetDenomMetaData(ctx, denomMetaData interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomMetaData), ctx, denomMetaData)
 }
 
+// SetHookEnabled mocks base method.
+func (m *MockBankKeeper) SetHookEnabled(ctx context.Context, name string, enabled bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHookEnabled", ctx, name, enabled)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHookEnabled indicates an expected call of SetHookEnabled.
+func (mr *MockBankKeeperMockRecorder) SetHookEnabled(ctx, name, enabled interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHookEnabled", reflect.TypeOf((*MockBankKeeper)(nil).SetHookEnabled), ctx, name, enabled)
+}
+
 // SetParams mocks base method.
 func (m *MockBankKeeper) SetParams(ctx context.Context, params types0.Params) error {
 	m.ctrl.T.Helper()
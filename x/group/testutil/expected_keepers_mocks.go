@@ -198,6 +198,21 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderMo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
 
+// SetHookEnabled mocks base method.
+func (m *MockBankKeeper) SetHookEnabled(arg0 context.Context, arg1 *types0.MsgSetHookEnabled) (*types0.MsgSetHookEnabledResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHookEnabled", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgSetHookEnabledResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetHookEnabled indicates an expected call of SetHookEnabled.
+func (mr *MockBankKeeperMockRecorder) SetHookEnabled(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHookEnabled", reflect.TypeOf((*MockBankKeeper)(nil).SetHookEnabled), arg0, arg1)
+}
+
 // SetSendEnabled mocks base method.
 func (m *MockBankKeeper) SetSendEnabled(arg0 context.Context, arg1 *types0.MsgSetSendEnabled) (*types0.MsgSetSendEnabledResponse, error) {
 	m.ctrl.T.Helper()
@@ -12,9 +12,11 @@ import (
 	"golang.org/x/exp/maps"
 
 	modulev1 "cosmossdk.io/api/cosmos/staking/module/v1"
+	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
+	"cosmossdk.io/x/tx/signing/textual"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -238,6 +240,20 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	return ModuleOutputs{StakingKeeper: k, Module: m}
 }
 
+// ProvideTextualMessageRenderer returns the SIGN_MODE_TEXTUAL renderer of
+// MsgDelegate, which renders it as "Delegate <amount> to <validator>".
+//
+// It changes the sign bytes of MsgDelegate, so it is not provided by the
+// module: apps opt in by passing it in
+// tx.ConfigOptions.TextualCustomMessageRenderers, or to depinject with
+// depinject.Provide, on both the node and the client.
+func ProvideTextualMessageRenderer() textual.CustomMessageRenderer {
+	return textual.CustomMessageRenderer{
+		MsgType: (&stakingv1beta1.MsgDelegate{}).ProtoReflect().Descriptor().FullName(),
+		Fn:      textual.NewMsgDelegateValueRenderer,
+	}
+}
+
 func InvokeSetStakingHooks(
 	config *modulev1.Module,
 	keeper *keeper.Keeper,
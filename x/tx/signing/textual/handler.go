@@ -32,6 +32,22 @@ type CoinMetadataQueryFn func(ctx context.Context, denom string) (*bankv1beta1.M
 // ValueRendererCreator is a function returning a textual.
 type ValueRendererCreator func(protoreflect.FieldDescriptor) ValueRenderer
 
+// MessageValueRendererCreator is a function returning the custom value
+// renderer of a message type. It is given the SignModeHandler, so that the
+// renderer can use it to render the fields of the message.
+type MessageValueRendererCreator func(*SignModeHandler) ValueRenderer
+
+// CustomMessageRenderer is a custom MessageValueRendererCreator that is
+// defined for a specific message type, e.g. a module's Msg. Modules provide
+// them with depinject, to have them defined with DefineMessageRenderer on the
+// SIGN_MODE_TEXTUAL handler of the app.
+type CustomMessageRenderer struct {
+	MsgType protoreflect.FullName
+	Fn      MessageValueRendererCreator
+}
+
+func (c CustomMessageRenderer) IsManyPerContainerType() {}
+
 // SignModeOptions are options to be passed to Textual's sign mode handler.
 type SignModeOptions struct {
 	// coinMetadataQuerier defines a function to query the coin metadata from
@@ -0,0 +1,160 @@
+package textual
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
+)
+
+// NewMsgSendValueRenderer returns a ValueRenderer for bank's MsgSend, which
+// renders it as "Send <amount> to <to_address>" followed by its sender. It is
+// meant to be registered as a custom message renderer by the bank module.
+func NewMsgSendValueRenderer(t *SignModeHandler) ValueRenderer {
+	return actionValueRenderer{
+		tr:          t,
+		msgType:     (&bankv1beta1.MsgSend{}).ProtoReflect().Type(),
+		verb:        "Send",
+		amountField: "amount",
+		toField:     "to_address",
+		fromField:   "from_address",
+		fromTitle:   "From",
+	}
+}
+
+// NewMsgDelegateValueRenderer returns a ValueRenderer for staking's
+// MsgDelegate, which renders it as "Delegate <amount> to
+// <validator_address>" followed by its delegator. It is meant to be
+// registered as a custom message renderer by the staking module.
+func NewMsgDelegateValueRenderer(t *SignModeHandler) ValueRenderer {
+	return actionValueRenderer{
+		tr:          t,
+		msgType:     (&stakingv1beta1.MsgDelegate{}).ProtoReflect().Type(),
+		verb:        "Delegate",
+		amountField: "amount",
+		toField:     "validator_address",
+		fromField:   "delegator_address",
+		fromTitle:   "Delegator",
+	}
+}
+
+// actionValueRenderer renders a message moving an amount of coins from an
+// address to another as a sentence, e.g. "Send 5 ATOM to cosmos1...",
+// followed by an indented screen with the address the coins are taken from.
+type actionValueRenderer struct {
+	tr      *SignModeHandler
+	msgType protoreflect.MessageType
+
+	verb        string
+	amountField protoreflect.Name
+	toField     protoreflect.Name
+	fromField   protoreflect.Name
+	fromTitle   string
+}
+
+var _ ValueRenderer = actionValueRenderer{}
+
+// Format implements the ValueRenderer interface.
+func (vr actionValueRenderer) Format(ctx context.Context, v protoreflect.Value) ([]Screen, error) {
+	msg := v.Message()
+	fullName, wantFullName := msg.Descriptor().FullName(), vr.msgType.Descriptor().FullName()
+	if fullName != wantFullName {
+		return nil, fmt.Errorf(`bad message type: want "%s", got "%s"`, wantFullName, fullName)
+	}
+
+	fields := msg.Descriptor().Fields()
+	amountFd := fields.ByName(vr.amountField)
+	amountVr, err := vr.tr.GetFieldValueRenderer(amountFd)
+	if err != nil {
+		return nil, err
+	}
+
+	var amount []Screen
+	switch {
+	case amountFd.IsList():
+		r, ok := amountVr.(RepeatedValueRenderer)
+		if !ok {
+			return nil, fmt.Errorf("expected a repeated value renderer for field %s", amountFd.Name())
+		}
+		amount, err = r.FormatRepeated(ctx, msg.Get(amountFd))
+	case msg.Has(amountFd):
+		amount, err = amountVr.Format(ctx, msg.Get(amountFd))
+	default:
+		amount = []Screen{{Content: emptyCoins}}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(amount) != 1 {
+		return nil, fmt.Errorf("expected single screen for field %s, got %d", amountFd.Name(), len(amount))
+	}
+
+	screens := []Screen{{
+		Content: fmt.Sprintf("%s %s to %s", vr.verb, amount[0].Content, msg.Get(fields.ByName(vr.toField)).String()),
+	}}
+	if from := msg.Get(fields.ByName(vr.fromField)).String(); from != "" {
+		screens = append(screens, Screen{Title: vr.fromTitle, Content: from, Indent: 1})
+	}
+
+	return screens, nil
+}
+
+// Parse implements the ValueRenderer interface.
+func (vr actionValueRenderer) Parse(ctx context.Context, screens []Screen) (protoreflect.Value, error) {
+	if len(screens) == 0 || len(screens) > 2 {
+		return nilValue, fmt.Errorf("expected 1 or 2 screens, got %d", len(screens))
+	}
+	if screens[0].Indent != 0 {
+		return nilValue, fmt.Errorf("bad indentation: want 0, got %d", screens[0].Indent)
+	}
+
+	// The amount cannot contain " to " followed by a space-free string, so
+	// the last " to " always separates it from the address.
+	content, ok := strings.CutPrefix(screens[0].Content, vr.verb+" ")
+	i := strings.LastIndex(content, " to ")
+	if !ok || i < 0 {
+		return nilValue, fmt.Errorf(`expected "%s <amount> to <address>", got "%s"`, vr.verb, screens[0].Content)
+	}
+	amount, to := content[:i], content[i+len(" to "):]
+
+	msg := vr.msgType.New()
+	fields := msg.Descriptor().Fields()
+	amountFd := fields.ByName(vr.amountField)
+	amountVr, err := vr.tr.GetFieldValueRenderer(amountFd)
+	if err != nil {
+		return nilValue, err
+	}
+
+	amountScreens := []Screen{{Content: amount}}
+	switch {
+	case amountFd.IsList():
+		r, ok := amountVr.(RepeatedValueRenderer)
+		if !ok {
+			return nilValue, fmt.Errorf("expected a repeated value renderer for field %s", amountFd.Name())
+		}
+		if err := r.ParseRepeated(ctx, amountScreens, msg.Mutable(amountFd).List()); err != nil {
+			return nilValue, err
+		}
+	case amount != emptyCoins:
+		v, err := amountVr.Parse(ctx, amountScreens)
+		if err != nil {
+			return nilValue, err
+		}
+		msg.Set(amountFd, v)
+	}
+
+	msg.Set(fields.ByName(vr.toField), protoreflect.ValueOfString(to))
+
+	if len(screens) == 2 {
+		if screens[1].Title != vr.fromTitle || screens[1].Indent != 1 {
+			return nilValue, fmt.Errorf(`expected "%s" screen with indentation 1, got "%s" with indentation %d`, vr.fromTitle, screens[1].Title, screens[1].Indent)
+		}
+		msg.Set(fields.ByName(vr.fromField), protoreflect.ValueOfString(screens[1].Content))
+	}
+
+	return protoreflect.ValueOfMessage(msg), nil
+}
@@ -0,0 +1,127 @@
+package textual_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/x/tx/signing/textual"
+)
+
+type msgsJSONTest struct {
+	Proto    json.RawMessage
+	Metadata map[string]*bankv1beta1.Metadata
+	Screens  []textual.Screen
+}
+
+func newCustomMessagesHandler(t *testing.T) *textual.SignModeHandler {
+	t.Helper()
+
+	tr, err := textual.NewSignModeHandler(textual.SignModeOptions{CoinMetadataQuerier: mockCoinMetadataQuerier})
+	require.NoError(t, err)
+	tr.DefineMessageRenderer((&bankv1beta1.MsgSend{}).ProtoReflect().Descriptor().FullName(), textual.NewMsgSendValueRenderer(tr))
+	tr.DefineMessageRenderer((&stakingv1beta1.MsgDelegate{}).ProtoReflect().Descriptor().FullName(), textual.NewMsgDelegateValueRenderer(tr))
+
+	return tr
+}
+
+func TestMsgsJSONTestcases(t *testing.T) {
+	raw, err := os.ReadFile("./internal/testdata/msgs.json")
+	require.NoError(t, err)
+
+	var testcases []msgsJSONTest
+	err = json.Unmarshal(raw, &testcases)
+	require.NoError(t, err)
+
+	tr := newCustomMessagesHandler(t)
+	for i, tc := range testcases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			ctx := context.Background()
+			for _, v := range tc.Metadata {
+				ctx = addMetadataToContext(ctx, v)
+			}
+
+			anyMsg := &anypb.Any{}
+			require.NoError(t, protojson.Unmarshal(tc.Proto, anyMsg))
+			msg, err := anyMsg.UnmarshalNew()
+			require.NoError(t, err)
+
+			rend, err := tr.GetMessageValueRenderer(msg.ProtoReflect().Descriptor())
+			require.NoError(t, err)
+			screens, err := rend.Format(ctx, protoreflect.ValueOfMessage(msg.ProtoReflect()))
+			require.NoError(t, err)
+			require.Equal(t, tc.Screens, screens)
+
+			val, err := rend.Parse(ctx, screens)
+			require.NoError(t, err)
+			diff := cmp.Diff(msg, val.Message().Interface(), protocmp.Transform())
+			require.Empty(t, diff)
+
+			// Messages in transactions are rendered as Anys, whose header is
+			// followed by the screens of the custom renderer.
+			anyRend := textual.NewAnyValueRenderer(tr)
+			anyScreens, err := anyRend.Format(ctx, protoreflect.ValueOfMessage(anyMsg.ProtoReflect()))
+			require.NoError(t, err)
+			require.Equal(t, anyMsg.TypeUrl, anyScreens[0].Content)
+			require.Len(t, anyScreens, len(tc.Screens)+1)
+			for i, screen := range tc.Screens {
+				screen.Indent++
+				require.Equal(t, screen, anyScreens[i+1])
+			}
+
+			val, err = anyRend.Parse(ctx, anyScreens)
+			require.NoError(t, err)
+			diff = cmp.Diff(anyMsg, val.Message().Interface(), protocmp.Transform())
+			require.Empty(t, diff)
+		})
+	}
+}
+
+func TestCustomMessageRenderers(t *testing.T) {
+	msg := &bankv1beta1.MsgSend{
+		FromAddress: "cosmos1ulav3hsenupswqfkw2y3sup5kgtqwnvqa8eyhs",
+		ToAddress:   "cosmos1ejrf4cur2wy6kfurg9f2jppp2h3afe5h6pkh5t",
+		Amount:      []*basev1beta1.Coin{{Denom: "stake", Amount: "1"}},
+	}
+	md := msg.ProtoReflect().Descriptor()
+
+	// Without registration, the default message renderer is used.
+	tr, err := textual.NewSignModeHandler(textual.SignModeOptions{CoinMetadataQuerier: EmptyCoinMetadataQuerier})
+	require.NoError(t, err)
+	rend, err := tr.GetMessageValueRenderer(md)
+	require.NoError(t, err)
+	screens, err := rend.Format(context.Background(), protoreflect.ValueOfMessage(msg.ProtoReflect()))
+	require.NoError(t, err)
+	require.Equal(t, "MsgSend object", screens[0].Content)
+
+	rend, err = newCustomMessagesHandler(t).GetMessageValueRenderer(md)
+	require.NoError(t, err)
+	screens, err = rend.Format(context.Background(), protoreflect.ValueOfMessage(msg.ProtoReflect()))
+	require.NoError(t, err)
+	require.Equal(t, "Send 1 stake to cosmos1ejrf4cur2wy6kfurg9f2jppp2h3afe5h6pkh5t", screens[0].Content)
+
+	// The renderer of a type is checked against the rendered message.
+	_, err = rend.Format(context.Background(), protoreflect.ValueOfMessage((&stakingv1beta1.MsgDelegate{}).ProtoReflect()))
+	require.ErrorContains(t, err, "bad message type")
+
+	_, err = rend.Parse(context.Background(), []textual.Screen{{Content: "Delegate 1 stake to cosmosvaloper1"}})
+	require.ErrorContains(t, err, "expected")
+
+	_, err = rend.Parse(context.Background(), []textual.Screen{
+		{Content: "Send 1 stake to cosmos1ejrf4cur2wy6kfurg9f2jppp2h3afe5h6pkh5t"},
+		{Title: "Delegator", Content: "cosmos1ulav3hsenupswqfkw2y3sup5kgtqwnvqa8eyhs", Indent: 1},
+	})
+	require.ErrorContains(t, err, "From")
+}